@@ -3417,9 +3417,10 @@ func (m *Validator) GetPower() int64 {
 
 // ValidatorUpdate is a singular update to a validator set.
 type ValidatorUpdate struct {
-	Power       int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
-	PubKeyBytes []byte `protobuf:"bytes,3,opt,name=pub_key_bytes,json=pubKeyBytes,proto3" json:"pub_key_bytes,omitempty"`
-	PubKeyType  string `protobuf:"bytes,4,opt,name=pub_key_type,json=pubKeyType,proto3" json:"pub_key_type,omitempty"`
+	Power             int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	PubKeyBytes       []byte `protobuf:"bytes,3,opt,name=pub_key_bytes,json=pubKeyBytes,proto3" json:"pub_key_bytes,omitempty"`
+	PubKeyType        string `protobuf:"bytes,4,opt,name=pub_key_type,json=pubKeyType,proto3" json:"pub_key_type,omitempty"`
+	ProofOfPossession []byte `protobuf:"bytes,5,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
 }
 
 func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
@@ -3476,6 +3477,13 @@ func (m *ValidatorUpdate) GetPubKeyType() string {
 	return ""
 }
 
+func (m *ValidatorUpdate) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
+	}
+	return nil
+}
+
 // VoteInfo contains the information about the vote.
 type VoteInfo struct {
 	Validator   Validator      `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
@@ -3840,218 +3848,218 @@ func init() {
 func init() { proto.RegisterFile("cometbft/abci/v2/types.proto", fileDescriptor_6f0a5b1025f81964) }
 
 var fileDescriptor_6f0a5b1025f81964 = []byte{
-	// 3365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6c, 0x1b, 0xd7,
-	0xb9, 0xf6, 0xf0, 0x25, 0xf2, 0xe7, 0x43, 0xa3, 0x23, 0xc9, 0xa6, 0x15, 0x47, 0x92, 0xc7, 0x71,
-	0xec, 0xd8, 0x89, 0x74, 0xad, 0xe4, 0xe6, 0x79, 0x93, 0x80, 0xa2, 0xa9, 0x48, 0xb2, 0x2c, 0x31,
-	0x43, 0x5a, 0x37, 0xf6, 0x7d, 0x4c, 0x46, 0xe4, 0xa1, 0x38, 0x31, 0x39, 0x33, 0x99, 0x39, 0x54,
-	0xa4, 0x7b, 0x57, 0x2d, 0x9a, 0xa2, 0xc8, 0x2a, 0x9b, 0x02, 0x45, 0x81, 0x02, 0x05, 0x8a, 0x6e,
-	0xbb, 0xe8, 0xbe, 0xbb, 0xa2, 0xc8, 0xaa, 0xc9, 0xb2, 0xab, 0xb4, 0x48, 0xd0, 0x4d, 0xf7, 0x05,
-	0x0a, 0x74, 0x53, 0x9c, 0xc7, 0xbc, 0xc8, 0x19, 0xc9, 0x76, 0xd2, 0x45, 0xd1, 0xee, 0x78, 0xce,
-	0xf9, 0xfe, 0x7f, 0xce, 0xf9, 0xcf, 0x39, 0xff, 0xe3, 0x3b, 0x84, 0x4b, 0x1d, 0x6b, 0x88, 0xc9,
-	0x41, 0x8f, 0xac, 0xea, 0x07, 0x1d, 0x63, 0xf5, 0x68, 0x6d, 0x95, 0x9c, 0xd8, 0xd8, 0x5d, 0xb1,
-	0x1d, 0x8b, 0x58, 0x48, 0xf6, 0x46, 0x57, 0xe8, 0xe8, 0xca, 0xd1, 0xda, 0xc2, 0xa2, 0x8f, 0xef,
-	0x38, 0x27, 0x36, 0xb1, 0x56, 0x8f, 0x6e, 0xad, 0xda, 0x8e, 0x65, 0xf5, 0xb8, 0x44, 0x68, 0x9c,
-	0xe9, 0xa1, 0x0a, 0x6d, 0xdd, 0xd1, 0x87, 0x42, 0xe3, 0xc2, 0xe5, 0xc9, 0xf1, 0x23, 0x7d, 0x60,
-	0x74, 0x75, 0x62, 0x39, 0x02, 0x32, 0x77, 0x68, 0x1d, 0x5a, 0xec, 0xe7, 0x2a, 0xfd, 0x25, 0x7a,
-	0x97, 0x0e, 0x2d, 0xeb, 0x70, 0x80, 0x57, 0x59, 0xeb, 0x60, 0xd4, 0x5b, 0x25, 0xc6, 0x10, 0xbb,
-	0x44, 0x1f, 0xda, 0xde, 0x97, 0xc7, 0x01, 0xdd, 0x91, 0xa3, 0x13, 0xc3, 0x32, 0xf9, 0xb8, 0xf2,
-	0x79, 0x01, 0xa6, 0x54, 0xfc, 0xe1, 0x08, 0xbb, 0x04, 0xbd, 0x08, 0x19, 0xdc, 0xe9, 0x5b, 0x55,
-	0x69, 0x59, 0xba, 0x5e, 0x5c, 0x7b, 0x7a, 0x65, 0x7c, 0x99, 0x2b, 0x8d, 0x4e, 0xdf, 0x12, 0xe0,
-	0xcd, 0x73, 0x2a, 0x03, 0xa3, 0x97, 0x21, 0xdb, 0x1b, 0x8c, 0xdc, 0x7e, 0x35, 0xc5, 0xa4, 0x16,
-	0x27, 0xa5, 0x36, 0xe8, 0x70, 0x20, 0xc6, 0xe1, 0xf4, 0x63, 0x86, 0xd9, 0xb3, 0xaa, 0xe9, 0xa4,
-	0x8f, 0x6d, 0x99, 0xbd, 0xf0, 0xc7, 0x28, 0x18, 0xd5, 0x01, 0x0c, 0xd3, 0x20, 0x5a, 0xa7, 0xaf,
-	0x1b, 0x66, 0x35, 0xcb, 0x44, 0x95, 0x38, 0x51, 0x83, 0xd4, 0x29, 0x24, 0x90, 0x2f, 0x18, 0x5e,
-	0x1f, 0x9d, 0xf1, 0x87, 0x23, 0xec, 0x9c, 0x54, 0x73, 0x49, 0x33, 0x7e, 0x97, 0x0e, 0x87, 0x66,
-	0xcc, 0xe0, 0xe8, 0x4d, 0xc8, 0x77, 0xfa, 0xb8, 0xf3, 0x50, 0x23, 0xc7, 0xd5, 0x3c, 0x13, 0x5d,
-	0x9e, 0x14, 0xad, 0x53, 0x44, 0xfb, 0x38, 0x10, 0x9e, 0xea, 0xf0, 0x1e, 0xf4, 0x1a, 0xe4, 0x3a,
-	0xd6, 0x70, 0x68, 0x90, 0x6a, 0x91, 0x09, 0x2f, 0xc5, 0x08, 0xb3, 0xf1, 0x40, 0x56, 0x08, 0xa0,
-	0x3d, 0xa8, 0x0c, 0x0c, 0x97, 0x68, 0xae, 0xa9, 0xdb, 0x6e, 0xdf, 0x22, 0x6e, 0xb5, 0xc4, 0x54,
-	0x3c, 0x3b, 0xa9, 0x62, 0xc7, 0x70, 0x49, 0xcb, 0x83, 0x05, 0x9a, 0xca, 0x83, 0x70, 0x3f, 0x55,
-	0x68, 0xf5, 0x7a, 0xd8, 0xf1, 0x35, 0x56, 0xcb, 0x49, 0x0a, 0xf7, 0x28, 0xce, 0x93, 0x0c, 0x29,
-	0xb4, 0xc2, 0xfd, 0xe8, 0xbf, 0x61, 0x76, 0x60, 0xe9, 0x5d, 0x5f, 0x9f, 0xd6, 0xe9, 0x8f, 0xcc,
-	0x87, 0xd5, 0x0a, 0xd3, 0x7a, 0x23, 0x66, 0x9a, 0x96, 0xde, 0xf5, 0x84, 0xeb, 0x14, 0x1a, 0x68,
-	0x9e, 0x19, 0x8c, 0x8f, 0x21, 0x0d, 0xe6, 0x74, 0xdb, 0x1e, 0x9c, 0x8c, 0xab, 0x9f, 0x66, 0xea,
-	0x6f, 0x4e, 0xaa, 0xaf, 0x51, 0x74, 0x82, 0x7e, 0xa4, 0x4f, 0x0c, 0xa2, 0x7b, 0x20, 0xdb, 0x0e,
-	0xb6, 0x75, 0x07, 0x6b, 0xb6, 0x63, 0xd9, 0x96, 0xab, 0x0f, 0xaa, 0x32, 0x53, 0x7e, 0x7d, 0x52,
-	0x79, 0x93, 0x23, 0x9b, 0x02, 0x18, 0x68, 0x9e, 0xb6, 0xa3, 0x23, 0x5c, 0xad, 0xd5, 0xc1, 0xae,
-	0x1b, 0xa8, 0x9d, 0x49, 0x56, 0xcb, 0x90, 0xb1, 0x6a, 0x23, 0x23, 0x68, 0x03, 0x8a, 0xf8, 0x98,
-	0x60, 0xb3, 0xab, 0x1d, 0x59, 0x04, 0x57, 0x11, 0xd3, 0x78, 0x25, 0xe6, 0xba, 0x32, 0xd0, 0xbe,
-	0x45, 0x70, 0xa0, 0x0c, 0xb0, 0xdf, 0x89, 0x0e, 0x60, 0xfe, 0x08, 0x3b, 0x46, 0xef, 0x84, 0xe9,
-	0xd1, 0xd8, 0x88, 0x6b, 0x58, 0x66, 0x75, 0x96, 0x69, 0x7c, 0x7e, 0x52, 0xe3, 0x3e, 0x83, 0x53,
-	0xe1, 0x86, 0x07, 0x0e, 0x54, 0xcf, 0x1e, 0x4d, 0x8e, 0xd2, 0x93, 0xd6, 0x33, 0x4c, 0x7d, 0x60,
-	0xfc, 0x1f, 0xd6, 0x0e, 0x06, 0x56, 0xe7, 0x61, 0x75, 0x2e, 0xe9, 0xa4, 0x6d, 0x08, 0xdc, 0x3a,
-	0x85, 0x85, 0x4e, 0x5a, 0x2f, 0xdc, 0xbf, 0x3e, 0x05, 0xd9, 0x23, 0x7d, 0x30, 0xc2, 0xdb, 0x99,
-	0x7c, 0x46, 0xce, 0x6e, 0x67, 0xf2, 0x53, 0x72, 0x7e, 0x3b, 0x93, 0x2f, 0xc8, 0xb0, 0x9d, 0xc9,
-	0x83, 0x5c, 0x54, 0xae, 0x41, 0x31, 0xe4, 0xa7, 0x50, 0x15, 0xa6, 0x86, 0xd8, 0x75, 0xf5, 0x43,
-	0xcc, 0xfc, 0x5a, 0x41, 0xf5, 0x9a, 0x4a, 0x05, 0x4a, 0x61, 0xd7, 0xa4, 0x7c, 0x2a, 0x41, 0x31,
-	0xe4, 0x74, 0xa8, 0xe4, 0x11, 0x76, 0x98, 0x41, 0x84, 0xa4, 0x68, 0xa2, 0x2b, 0x50, 0x66, 0x6b,
-	0xd1, 0xbc, 0x71, 0xea, 0xfb, 0x32, 0x6a, 0x89, 0x75, 0xee, 0x0b, 0xd0, 0x12, 0x14, 0xed, 0x35,
-	0xdb, 0x87, 0xa4, 0x19, 0x04, 0xec, 0x35, 0xdb, 0x03, 0x5c, 0x86, 0x12, 0x5d, 0xba, 0x8f, 0xc8,
-	0xb0, 0x8f, 0x14, 0x69, 0x9f, 0x80, 0x28, 0xbf, 0x4d, 0x81, 0x3c, 0xee, 0xcc, 0xd0, 0xab, 0x90,
-	0xa1, 0x5e, 0x5e, 0xb8, 0xe9, 0x85, 0x15, 0xee, 0xe1, 0x57, 0x3c, 0x0f, 0xbf, 0xd2, 0xf6, 0x42,
-	0xc0, 0x7a, 0xfe, 0xb3, 0x2f, 0x97, 0xce, 0x7d, 0xfa, 0xfb, 0x25, 0x49, 0x65, 0x12, 0xe8, 0x22,
-	0xf5, 0x60, 0xba, 0x61, 0x6a, 0x46, 0x97, 0x4d, 0xb9, 0x40, 0xbd, 0x93, 0x6e, 0x98, 0x5b, 0x5d,
-	0x74, 0x17, 0xe4, 0x8e, 0x65, 0xba, 0xd8, 0x74, 0x47, 0xae, 0xc6, 0x63, 0x93, 0x70, 0xcd, 0x21,
-	0xff, 0xca, 0x83, 0x20, 0x73, 0x54, 0x02, 0xda, 0x64, 0x48, 0x75, 0xba, 0x13, 0xed, 0x40, 0xef,
-	0x00, 0xf8, 0x01, 0xcc, 0xad, 0x66, 0x96, 0xd3, 0xd7, 0x8b, 0x6b, 0x97, 0x63, 0xce, 0x93, 0x87,
-	0xb9, 0x67, 0x77, 0x75, 0x82, 0xd7, 0x33, 0x74, 0xc2, 0x6a, 0x48, 0x14, 0x3d, 0x0b, 0xd3, 0xba,
-	0x6d, 0x6b, 0x2e, 0xd1, 0x09, 0xd6, 0x0e, 0x4e, 0x08, 0x76, 0x99, 0xdb, 0x2f, 0xa9, 0x65, 0xdd,
-	0xb6, 0x5b, 0xb4, 0x77, 0x9d, 0x76, 0xa2, 0xab, 0x50, 0xa1, 0x1e, 0xde, 0xd0, 0x07, 0x5a, 0x1f,
-	0x1b, 0x87, 0x7d, 0xc2, 0xbc, 0x7b, 0x5a, 0x2d, 0x8b, 0xde, 0x4d, 0xd6, 0xa9, 0x74, 0xa1, 0x14,
-	0x76, 0xee, 0x08, 0x41, 0xa6, 0xab, 0x13, 0x9d, 0xd9, 0xb2, 0xa4, 0xb2, 0xdf, 0xb4, 0xcf, 0xd6,
-	0x49, 0x5f, 0x58, 0x88, 0xfd, 0x46, 0xe7, 0x21, 0x27, 0xd4, 0xa6, 0x99, 0x5a, 0xd1, 0x42, 0x73,
-	0x90, 0xb5, 0x1d, 0xeb, 0x08, 0xb3, 0xcd, 0xcb, 0xab, 0xbc, 0xa1, 0xdc, 0x87, 0x4a, 0x34, 0x0e,
-	0xa0, 0x0a, 0xa4, 0xc8, 0xb1, 0xf8, 0x4a, 0x8a, 0x1c, 0xa3, 0x5b, 0x90, 0xa1, 0xc6, 0x64, 0xda,
-	0x2a, 0x71, 0xd1, 0x4f, 0xc8, 0xb7, 0x4f, 0x6c, 0xac, 0x32, 0xe8, 0x76, 0x26, 0x9f, 0x92, 0xd3,
-	0xca, 0x34, 0x94, 0x23, 0x51, 0x42, 0x39, 0x0f, 0x73, 0x71, 0x3e, 0x5f, 0x31, 0x60, 0x2e, 0xce,
-	0x75, 0xa3, 0x97, 0x21, 0xef, 0x3b, 0x7d, 0xef, 0x04, 0x4d, 0x7c, 0xdd, 0x17, 0xf2, 0xb1, 0xf4,
-	0xec, 0xd0, 0x8d, 0xe8, 0xeb, 0x22, 0xd4, 0x97, 0xd4, 0x29, 0xdd, 0xb6, 0x37, 0x75, 0xb7, 0xaf,
-	0xbc, 0x0f, 0xd5, 0x24, 0x7f, 0x1e, 0x32, 0x9c, 0xc4, 0x2e, 0x80, 0x67, 0xb8, 0xf3, 0x90, 0xeb,
-	0x59, 0xce, 0x50, 0x27, 0x4c, 0x59, 0x59, 0x15, 0x2d, 0x6a, 0x50, 0xee, 0xdb, 0xd3, 0xac, 0x9b,
-	0x37, 0x14, 0x0d, 0x2e, 0x26, 0xba, 0x74, 0x2a, 0x62, 0x98, 0x5d, 0xcc, 0xcd, 0x5b, 0x56, 0x79,
-	0x23, 0x50, 0xc4, 0x27, 0xcb, 0x1b, 0xf4, 0xb3, 0x2e, 0x36, 0xbb, 0xd8, 0x61, 0xfa, 0x0b, 0xaa,
-	0x68, 0x29, 0x3f, 0x4e, 0xc3, 0xf9, 0x78, 0xbf, 0x8e, 0x96, 0xa1, 0x34, 0xd4, 0x8f, 0x35, 0x72,
-	0x2c, 0x8e, 0x9f, 0xc4, 0x0e, 0x00, 0x0c, 0xf5, 0xe3, 0xf6, 0x31, 0x3f, 0x7b, 0x32, 0xa4, 0xc9,
-	0xb1, 0x5b, 0x4d, 0x2d, 0xa7, 0xaf, 0x97, 0x54, 0xfa, 0x13, 0xed, 0xc3, 0xcc, 0xc0, 0xea, 0xe8,
-	0x03, 0x6d, 0xa0, 0xbb, 0x44, 0x13, 0x61, 0x9f, 0x5f, 0xa7, 0x67, 0x92, 0xfc, 0x34, 0xee, 0xf2,
-	0x8d, 0xa5, 0x2e, 0x48, 0x5c, 0x84, 0x69, 0xa6, 0x64, 0x47, 0x77, 0x09, 0x1f, 0x42, 0x0d, 0x28,
-	0x0e, 0x0d, 0xf7, 0x00, 0xf7, 0xf5, 0x23, 0xc3, 0x72, 0xc4, 0xbd, 0x8a, 0x39, 0x3d, 0x77, 0x03,
-	0x90, 0x50, 0x15, 0x96, 0x0b, 0x6d, 0x4a, 0x36, 0x72, 0x9a, 0x3d, 0xcf, 0x92, 0x7b, 0x6c, 0xcf,
-	0xf2, 0x6f, 0x30, 0x67, 0xe2, 0x63, 0xa2, 0x05, 0x37, 0x97, 0x9f, 0x94, 0x29, 0x66, 0x7c, 0x44,
-	0xc7, 0xfc, 0xbb, 0xee, 0xd2, 0x43, 0x83, 0x9e, 0x63, 0xb1, 0xd1, 0xb6, 0x5c, 0xec, 0x68, 0x7a,
-	0xb7, 0xeb, 0x60, 0xd7, 0x65, 0x59, 0x55, 0x89, 0xc5, 0x3b, 0xd6, 0x5f, 0xe3, 0xdd, 0xca, 0x27,
-	0x6c, 0x73, 0xe2, 0xa2, 0xa3, 0x67, 0x7a, 0x29, 0x30, 0x7d, 0x1b, 0xe6, 0x84, 0x7c, 0x37, 0x62,
-	0x7d, 0x9e, 0x9e, 0x5e, 0x4a, 0x4a, 0xba, 0x42, 0x56, 0x47, 0x9e, 0x7c, 0xb2, 0xe1, 0xd3, 0x4f,
-	0x68, 0x78, 0x04, 0x19, 0x66, 0x96, 0x0c, 0x77, 0x37, 0xf4, 0xf7, 0x3f, 0xda, 0x66, 0x7c, 0x9c,
-	0x86, 0x99, 0x89, 0xc4, 0xc2, 0x5f, 0x98, 0x14, 0xbb, 0xb0, 0x54, 0xec, 0xc2, 0xd2, 0x8f, 0xbd,
-	0x30, 0xb1, 0xdb, 0x99, 0xb3, 0x77, 0x3b, 0xfb, 0x6d, 0xee, 0x76, 0xee, 0x09, 0x77, 0xfb, 0xef,
-	0xba, 0x0f, 0x9f, 0x4b, 0xb0, 0x90, 0x9c, 0x8e, 0xc5, 0x6e, 0xc8, 0x4d, 0x98, 0xf1, 0xa7, 0xe2,
-	0xab, 0xe7, 0xee, 0x51, 0xf6, 0x07, 0x84, 0xfe, 0xc4, 0x88, 0x77, 0x15, 0x2a, 0x63, 0xd9, 0x22,
-	0x3f, 0xcc, 0xe5, 0xa3, 0x48, 0xde, 0x77, 0x0b, 0xe6, 0x4d, 0xcb, 0xd4, 0x1c, 0x7b, 0x3c, 0xb7,
-	0xcc, 0x8a, 0xc5, 0x5b, 0xa6, 0x6a, 0x47, 0x66, 0xae, 0xfc, 0x32, 0x0d, 0x73, 0x71, 0x39, 0x60,
-	0xcc, 0x25, 0x57, 0x61, 0xb6, 0x8b, 0x3b, 0x46, 0xf7, 0x89, 0xef, 0xf8, 0x8c, 0x10, 0xff, 0xd7,
-	0x15, 0x9f, 0x3c, 0x5a, 0xe8, 0x06, 0xcc, 0xb8, 0x27, 0x66, 0xc7, 0x30, 0x0f, 0x35, 0x62, 0x79,
-	0xe9, 0x54, 0x81, 0xcd, 0x7c, 0x5a, 0x0c, 0xb4, 0x2d, 0x91, 0x50, 0xfd, 0x1c, 0x20, 0xaf, 0x62,
-	0xd7, 0xa6, 0xf9, 0x1f, 0xaa, 0x43, 0x01, 0x1f, 0x77, 0xb0, 0x4d, 0xbc, 0x9c, 0x39, 0xa1, 0x2c,
-	0x11, 0x10, 0x4f, 0x8e, 0x96, 0xe7, 0xbe, 0x1c, 0x7a, 0x49, 0xb0, 0x10, 0x89, 0x7c, 0x02, 0xcf,
-	0xee, 0x7d, 0x51, 0x4e, 0x43, 0xbc, 0xe2, 0xd1, 0x10, 0xe9, 0xa4, 0xe2, 0x5a, 0xe4, 0xfa, 0xbe,
-	0x9c, 0xe0, 0x21, 0x5e, 0x12, 0x3c, 0x44, 0x26, 0xe9, 0x73, 0xbc, 0x24, 0x08, 0x3e, 0xc7, 0x88,
-	0x88, 0xdb, 0x11, 0x22, 0x22, 0x97, 0xb4, 0xd4, 0x50, 0xee, 0x1e, 0x2c, 0x35, 0x60, 0x22, 0x5e,
-	0xf1, 0x98, 0x88, 0xa9, 0xa4, 0x49, 0x8b, 0x64, 0x35, 0x98, 0x34, 0xa7, 0x22, 0xde, 0x0a, 0x51,
-	0x11, 0x05, 0x26, 0x7b, 0xf9, 0x14, 0x2a, 0xc2, 0x97, 0xf6, 0xb9, 0x88, 0xd7, 0x7d, 0x2e, 0xa2,
-	0x94, 0x48, 0x64, 0x88, 0x2c, 0xd3, 0x17, 0xf6, 0xc8, 0x88, 0xe6, 0x04, 0x19, 0xc1, 0xb9, 0x83,
-	0x6b, 0x67, 0x92, 0x11, 0xbe, 0xaa, 0x31, 0x36, 0xa2, 0x39, 0xc1, 0x46, 0x54, 0x92, 0x34, 0x8e,
-	0xa5, 0xb4, 0x81, 0xc6, 0x28, 0x1d, 0xf1, 0x3f, 0xf1, 0x74, 0x44, 0x22, 0x5f, 0x10, 0x93, 0xbe,
-	0xfa, 0xaa, 0x63, 0xf8, 0x88, 0xf7, 0x13, 0xf8, 0x08, 0x39, 0xa9, 0x6e, 0x8e, 0x4b, 0x5e, 0xfd,
-	0x0f, 0xc4, 0x11, 0x12, 0xfb, 0x31, 0x84, 0x04, 0x67, 0x0e, 0x9e, 0x7b, 0x04, 0x42, 0xc2, 0x57,
-	0x3d, 0xc1, 0x48, 0xec, 0xc7, 0x30, 0x12, 0x28, 0x59, 0xef, 0x58, 0xce, 0x15, 0xd6, 0x1b, 0xa5,
-	0x24, 0xde, 0x89, 0x52, 0x12, 0xb3, 0xa7, 0xa7, 0xba, 0x3c, 0x73, 0xf0, 0xb5, 0x85, 0x39, 0x89,
-	0x4e, 0x12, 0x27, 0xc1, 0x69, 0x83, 0x17, 0x1e, 0x91, 0x93, 0xf0, 0x75, 0xc7, 0x92, 0x12, 0xcd,
-	0x09, 0x52, 0x62, 0x3e, 0xe9, 0xc0, 0x8d, 0x05, 0xa4, 0xe0, 0xc0, 0x25, 0xb2, 0x12, 0x59, 0x39,
-	0xb7, 0x9d, 0xc9, 0xe7, 0xe5, 0x02, 0xe7, 0x23, 0xb6, 0x33, 0xf9, 0xa2, 0x5c, 0x52, 0x9e, 0xa3,
-	0x59, 0xd3, 0x98, 0xdf, 0xa3, 0x35, 0x0a, 0x76, 0x1c, 0xcb, 0x11, 0xfc, 0x02, 0x6f, 0x28, 0xd7,
-	0xa1, 0x14, 0x76, 0x71, 0xa7, 0x30, 0x18, 0xd3, 0x50, 0x8e, 0x78, 0x35, 0xe5, 0xaf, 0x29, 0x28,
-	0x85, 0xfd, 0x55, 0xa4, 0xbe, 0x2d, 0x88, 0xfa, 0x36, 0xc4, 0x6b, 0xa4, 0xa2, 0xbc, 0xc6, 0x12,
-	0x14, 0x69, 0x8d, 0x37, 0x46, 0x59, 0xe8, 0xb6, 0x4f, 0x59, 0xdc, 0x80, 0x19, 0x16, 0x6f, 0x39,
-	0xfb, 0x21, 0x22, 0x43, 0x86, 0x47, 0x06, 0x3a, 0xc0, 0x8c, 0xc1, 0x23, 0x03, 0x7a, 0x01, 0x66,
-	0x43, 0x58, 0xbf, 0x76, 0xe4, 0xf1, 0x5f, 0xf6, 0xd1, 0x35, 0x5e, 0x44, 0xa2, 0xff, 0x82, 0xe9,
-	0x81, 0x6e, 0xd2, 0xe3, 0x6e, 0x58, 0x8e, 0x41, 0x0c, 0xec, 0x8a, 0xbc, 0x6b, 0xed, 0x74, 0x97,
-	0xbc, 0xb2, 0xa3, 0x9b, 0xb8, 0xe9, 0x0b, 0x35, 0x4c, 0xe2, 0x9c, 0xa8, 0x95, 0x41, 0xa4, 0x13,
-	0x5d, 0x86, 0x52, 0x17, 0xf7, 0xf4, 0xd1, 0x80, 0x68, 0x74, 0x84, 0xf9, 0xdb, 0x82, 0x5a, 0x14,
-	0x7d, 0x54, 0xc3, 0x42, 0x0d, 0x66, 0x63, 0x34, 0xd1, 0xdc, 0xe3, 0x21, 0x3e, 0x11, 0xf6, 0xa3,
-	0x3f, 0xe9, 0xa6, 0xb1, 0xad, 0x16, 0x85, 0x2b, 0x6f, 0xbc, 0x9e, 0x7a, 0x55, 0x52, 0x7e, 0x23,
-	0xc1, 0xcc, 0x84, 0xc7, 0x8f, 0x65, 0x56, 0xa4, 0x6f, 0x8b, 0x59, 0x49, 0x3d, 0x39, 0xb3, 0x12,
-	0x2e, 0xe8, 0xd3, 0xd1, 0x82, 0xfe, 0x2f, 0x12, 0x94, 0x23, 0x91, 0x87, 0x9e, 0xa3, 0x8e, 0xd5,
-	0xc5, 0xa2, 0xc4, 0x66, 0xbf, 0xa9, 0x69, 0x06, 0xd6, 0xa1, 0x28, 0xa4, 0xe9, 0x4f, 0x8a, 0xf2,
-	0x63, 0x69, 0x41, 0x44, 0x4a, 0xbf, 0x3a, 0xe7, 0xa9, 0x8f, 0xa8, 0xce, 0x85, 0x59, 0x73, 0xec,
-	0xbb, 0x51, 0xb3, 0xf2, 0x14, 0x86, 0x37, 0xd0, 0x6b, 0x50, 0x60, 0xef, 0x28, 0x9a, 0x65, 0xbb,
-	0x82, 0x74, 0x0f, 0xa5, 0x77, 0xfc, 0xb1, 0x65, 0xe5, 0xe8, 0x16, 0x75, 0x55, 0x56, 0x6f, 0xcf,
-	0x76, 0xd5, 0xbc, 0x2d, 0x7e, 0x85, 0x92, 0xae, 0x42, 0x24, 0xe9, 0xba, 0x04, 0x05, 0x3a, 0x7d,
-	0xd7, 0xd6, 0x3b, 0xb8, 0x0a, 0x6c, 0xa6, 0x41, 0x87, 0xf2, 0xeb, 0x14, 0x4c, 0x8f, 0x05, 0xce,
-	0xd8, 0xc5, 0x7b, 0x17, 0x2b, 0x15, 0x22, 0x8e, 0x1e, 0xcd, 0x20, 0x8b, 0x00, 0x87, 0xba, 0xab,
-	0x7d, 0xa4, 0x9b, 0x04, 0x77, 0x85, 0x55, 0x42, 0x3d, 0x68, 0x01, 0xf2, 0xb4, 0x35, 0x72, 0x71,
-	0x57, 0x70, 0x58, 0x7e, 0x1b, 0x6d, 0x41, 0x0e, 0x1f, 0x61, 0x93, 0xb8, 0xd5, 0x29, 0xb6, 0xf1,
-	0x17, 0x62, 0x3c, 0x2c, 0x1d, 0x5f, 0xaf, 0xd2, 0xed, 0xfe, 0xd3, 0x97, 0x4b, 0x32, 0x87, 0x3f,
-	0x6f, 0x0d, 0x0d, 0x82, 0x87, 0x36, 0x39, 0x51, 0x85, 0x82, 0xa8, 0x19, 0xf2, 0x63, 0x66, 0x40,
-	0x17, 0x60, 0x8a, 0xdd, 0x46, 0xa3, 0xcb, 0x32, 0x84, 0x82, 0x9a, 0xa3, 0xcd, 0xad, 0x2e, 0x63,
-	0x5a, 0x4b, 0x1e, 0x6d, 0x42, 0xad, 0xcd, 0xae, 0xcb, 0x89, 0x5a, 0x1e, 0xe2, 0xa1, 0x6d, 0x59,
-	0x03, 0x8d, 0xfb, 0xb0, 0x1a, 0x54, 0xa2, 0x09, 0x04, 0xba, 0x02, 0x65, 0x07, 0x13, 0xdd, 0x30,
-	0xb5, 0x48, 0x59, 0x51, 0xe2, 0x9d, 0xdc, 0x67, 0x6c, 0x67, 0xf2, 0x92, 0x9c, 0x12, 0x4c, 0xd7,
-	0xbb, 0x30, 0x1f, 0x9b, 0x3f, 0xa0, 0x57, 0xa1, 0x10, 0xe4, 0x1e, 0x12, 0xb3, 0xc3, 0x69, 0x14,
-	0x56, 0x00, 0x56, 0xf6, 0x61, 0x3e, 0x36, 0x81, 0x40, 0x6f, 0x42, 0xce, 0xc1, 0xee, 0x68, 0xc0,
-	0x59, 0xaa, 0xca, 0xda, 0xd5, 0xb3, 0x33, 0x8f, 0xd1, 0x80, 0xa8, 0x42, 0x48, 0xb9, 0x05, 0x17,
-	0x13, 0x33, 0x88, 0x80, 0x88, 0x92, 0x42, 0x44, 0x94, 0xf2, 0x0b, 0x09, 0x16, 0x92, 0xb3, 0x02,
-	0xb4, 0x3e, 0x36, 0xa1, 0x1b, 0x8f, 0x98, 0x53, 0x84, 0x66, 0x45, 0x2b, 0x35, 0x07, 0xf7, 0x30,
-	0xe9, 0xf4, 0x79, 0x7a, 0xc2, 0xbd, 0x45, 0x59, 0x2d, 0x8b, 0x5e, 0x26, 0xe3, 0x72, 0xd8, 0x07,
-	0xb8, 0x43, 0x34, 0xbe, 0xa9, 0x2e, 0x2b, 0x7d, 0x0a, 0x14, 0x46, 0x7b, 0x5b, 0xbc, 0x53, 0xb9,
-	0x09, 0x17, 0x12, 0xf2, 0x8c, 0xc9, 0xfa, 0x4c, 0x79, 0x40, 0xc1, 0xb1, 0xc9, 0x03, 0x7a, 0x1b,
-	0x72, 0x2e, 0xd1, 0xc9, 0xc8, 0x15, 0x2b, 0xbb, 0x76, 0x66, 0xde, 0xd1, 0x62, 0x70, 0x55, 0x88,
-	0x29, 0x18, 0xd0, 0x64, 0x16, 0x11, 0x53, 0x96, 0x4a, 0x71, 0x65, 0xe9, 0x75, 0x90, 0x45, 0x59,
-	0x1a, 0x00, 0xf9, 0x15, 0xae, 0xb0, 0x8a, 0x34, 0xa8, 0x46, 0x0f, 0xe0, 0xa9, 0x53, 0x32, 0x0b,
-	0x54, 0x1f, 0x5b, 0xc6, 0xcd, 0x47, 0x4a, 0x4c, 0xc6, 0x96, 0xf2, 0xab, 0x34, 0xcc, 0xc7, 0x26,
-	0x18, 0xa1, 0x8b, 0x2e, 0x7d, 0xd3, 0x8b, 0xfe, 0x26, 0x00, 0x39, 0xd6, 0xf8, 0x99, 0xf0, 0x02,
-	0x46, 0x5c, 0x55, 0x75, 0x8c, 0x3b, 0xcc, 0xe7, 0xd1, 0x23, 0x54, 0x20, 0xe2, 0x97, 0x8b, 0xda,
-	0x61, 0xd2, 0x60, 0xc4, 0x82, 0x89, 0x2b, 0x8a, 0xe3, 0x47, 0x0e, 0x3b, 0x01, 0xbb, 0xc0, 0xbb,
-	0x5d, 0xf4, 0x00, 0x2e, 0x8c, 0x05, 0x45, 0x5f, 0x77, 0xe6, 0x91, 0x63, 0xe3, 0x7c, 0x34, 0x36,
-	0x7a, 0xba, 0xc3, 0x81, 0x2d, 0x1b, 0x09, 0x6c, 0x34, 0x16, 0xb3, 0xb2, 0x99, 0xe7, 0x24, 0x5d,
-	0x3c, 0xd0, 0xbd, 0x57, 0xe0, 0x8b, 0x13, 0xc5, 0xf7, 0x6d, 0xf1, 0x50, 0xce, 0x6b, 0xef, 0x1f,
-	0xd1, 0xda, 0xbb, 0x42, 0x85, 0xd9, 0x46, 0xdd, 0xa6, 0xa2, 0xca, 0x03, 0x80, 0x80, 0x59, 0xa0,
-	0x17, 0xdd, 0xb1, 0x46, 0x66, 0x97, 0x9d, 0x88, 0xac, 0xca, 0x1b, 0xe8, 0x65, 0xc8, 0xd2, 0x23,
-	0xe8, 0x59, 0x3e, 0xc6, 0x53, 0xd1, 0x13, 0x12, 0xa2, 0x26, 0x38, 0x5c, 0xf9, 0xc0, 0x3b, 0xe6,
-	0x61, 0x5e, 0x38, 0xe1, 0x1b, 0x6f, 0x45, 0xbf, 0xa1, 0x24, 0x53, 0xcc, 0xf1, 0xdf, 0xfa, 0x7f,
-	0xc8, 0xb2, 0xd3, 0x44, 0xe3, 0x15, 0x7b, 0x96, 0x10, 0xe9, 0x22, 0xfd, 0x8d, 0xfe, 0x17, 0x40,
-	0x27, 0xc4, 0x31, 0x0e, 0x46, 0xc1, 0x17, 0x96, 0x13, 0x8e, 0x63, 0xcd, 0x03, 0xae, 0x5f, 0x12,
-	0xe7, 0x72, 0x2e, 0x90, 0x0d, 0x9d, 0xcd, 0x90, 0x46, 0x65, 0x17, 0x2a, 0x51, 0xd9, 0xb3, 0x72,
-	0xae, 0x82, 0x97, 0x1c, 0xf8, 0xa9, 0x45, 0x9a, 0x3f, 0xbe, 0xb0, 0x86, 0xf2, 0x9d, 0x14, 0x94,
-	0xc2, 0x87, 0xf9, 0x9f, 0x30, 0x7c, 0x2b, 0xdf, 0x97, 0x20, 0xef, 0xaf, 0x3f, 0xfa, 0x04, 0x13,
-	0x79, 0xbb, 0xe2, 0xe6, 0x4b, 0x85, 0xdf, 0x4d, 0xf8, 0x4b, 0x55, 0xda, 0x7f, 0xa9, 0xfa, 0x0f,
-	0x3f, 0x12, 0x25, 0x32, 0x24, 0x61, 0x6b, 0x8b, 0x83, 0xe5, 0x45, 0xc6, 0x37, 0xa0, 0xe0, 0xbb,
-	0x04, 0x5a, 0x78, 0x78, 0xcc, 0x93, 0x24, 0xee, 0xa5, 0x60, 0x9c, 0xe6, 0x20, 0x6b, 0x5b, 0x1f,
-	0x89, 0x57, 0x99, 0xb4, 0xca, 0x1b, 0x8a, 0x0b, 0xd3, 0x63, 0xfe, 0x24, 0x00, 0xa6, 0x42, 0x40,
-	0xa4, 0x40, 0xd9, 0x1e, 0x1d, 0x68, 0x0f, 0xf1, 0x89, 0x78, 0xa3, 0xe1, 0xd3, 0x2f, 0xda, 0xa3,
-	0x83, 0x3b, 0xf8, 0x84, 0x3f, 0xd2, 0x2c, 0x43, 0xc9, 0xc3, 0xb0, 0x23, 0xce, 0xf7, 0x14, 0x38,
-	0xa4, 0xcd, 0x1f, 0xd8, 0x24, 0x39, 0xa5, 0xfc, 0x50, 0x82, 0xbc, 0x77, 0x4b, 0xd0, 0xdb, 0x50,
-	0xf0, 0x5d, 0x97, 0x48, 0xda, 0x9f, 0x3a, 0xc5, 0xe9, 0x89, 0xc5, 0x07, 0x32, 0x68, 0xdd, 0x7b,
-	0x29, 0x36, 0xba, 0x5a, 0x6f, 0xa0, 0x1f, 0x8a, 0x07, 0xbf, 0xc5, 0x18, 0xef, 0xc6, 0xfc, 0xca,
-	0xd6, 0xed, 0x8d, 0x81, 0x7e, 0xa8, 0x16, 0x99, 0xd0, 0x56, 0x97, 0x36, 0x44, 0x3a, 0xf4, 0xc7,
-	0x14, 0xc8, 0xe3, 0xb7, 0xf8, 0x9b, 0xcf, 0x6f, 0x32, 0x6c, 0xa6, 0xe3, 0xc2, 0xe6, 0x2a, 0xcc,
-	0xfa, 0x08, 0xcd, 0x35, 0x0e, 0x4d, 0x9d, 0x8c, 0x1c, 0x2c, 0x38, 0x4e, 0xe4, 0x0f, 0xb5, 0xbc,
-	0x91, 0xc9, 0x75, 0x67, 0x1f, 0x7b, 0xdd, 0xc9, 0x14, 0x72, 0x2e, 0x89, 0x42, 0x46, 0x6f, 0xc0,
-	0xc2, 0x78, 0x78, 0x0f, 0x4d, 0x97, 0x57, 0x16, 0x17, 0xa2, 0x81, 0xde, 0x9f, 0xb3, 0xb0, 0xf3,
-	0xc7, 0x29, 0x28, 0x86, 0x28, 0x5e, 0xf4, 0xef, 0x21, 0x97, 0x58, 0x89, 0x0b, 0x79, 0x21, 0x70,
-	0xf0, 0x5a, 0x1b, 0xdd, 0x99, 0xd4, 0x13, 0xec, 0x4c, 0x12, 0xff, 0xee, 0x71, 0xc6, 0x99, 0xc7,
-	0xe6, 0x8c, 0x9f, 0x07, 0x44, 0x2c, 0xa2, 0x0f, 0xa8, 0x39, 0x0d, 0xf3, 0x50, 0xe3, 0x17, 0x89,
-	0x7b, 0x30, 0x99, 0x8d, 0xec, 0xb3, 0x81, 0x26, 0xbb, 0x7c, 0xdf, 0x95, 0x20, 0xef, 0xf3, 0x69,
-	0x8f, 0xfb, 0x8a, 0x7b, 0x1e, 0x72, 0x22, 0xe5, 0xe4, 0xcf, 0xb8, 0xa2, 0x15, 0x4b, 0x8e, 0x2f,
-	0x40, 0x7e, 0x88, 0x89, 0xce, 0xdc, 0x31, 0x0f, 0xd7, 0x7e, 0xfb, 0xc6, 0x01, 0x14, 0x43, 0x0f,
-	0xe1, 0xe8, 0x22, 0xcc, 0xd7, 0x37, 0x1b, 0xf5, 0x3b, 0x5a, 0xfb, 0x3d, 0xad, 0x7d, 0xbf, 0xd9,
-	0xd0, 0xee, 0xed, 0xde, 0xd9, 0xdd, 0xfb, 0xcf, 0x5d, 0xf9, 0xdc, 0xe4, 0x90, 0xda, 0x60, 0x6d,
-	0x59, 0x42, 0x17, 0x60, 0x36, 0x3a, 0xc4, 0x07, 0x52, 0x0b, 0x99, 0x1f, 0xfc, 0x6c, 0xf1, 0xdc,
-	0x8d, 0x3f, 0x4b, 0x30, 0x1b, 0x93, 0xdc, 0xa3, 0xcb, 0xf0, 0xf4, 0xde, 0xc6, 0x46, 0x43, 0xd5,
-	0x5a, 0xbb, 0xb5, 0x66, 0x6b, 0x73, 0xaf, 0xad, 0xa9, 0x8d, 0xd6, 0xbd, 0x9d, 0x76, 0xe8, 0xa3,
-	0xcb, 0x70, 0x29, 0x1e, 0x52, 0xab, 0xd7, 0x1b, 0xcd, 0xb6, 0x2c, 0xa1, 0x25, 0x78, 0x2a, 0x01,
-	0xb1, 0xbe, 0xa7, 0xb6, 0xe5, 0x54, 0xb2, 0x0a, 0xb5, 0xb1, 0xdd, 0xa8, 0xb7, 0xe5, 0x34, 0xba,
-	0x06, 0x57, 0x4e, 0x43, 0x68, 0x1b, 0x7b, 0xea, 0xdd, 0x5a, 0x5b, 0xce, 0x9c, 0x09, 0x6c, 0x35,
-	0x76, 0x6f, 0x37, 0x54, 0x39, 0x2b, 0xd6, 0xfd, 0xd3, 0x14, 0x54, 0x93, 0x6a, 0x08, 0xaa, 0xab,
-	0xd6, 0x6c, 0xee, 0xdc, 0x0f, 0x74, 0xd5, 0x37, 0xef, 0xed, 0xde, 0x99, 0x34, 0xc1, 0xb3, 0xa0,
-	0x9c, 0x06, 0xf4, 0x0d, 0x71, 0x15, 0x2e, 0x9f, 0x8a, 0x13, 0xe6, 0x38, 0x03, 0xa6, 0x36, 0xda,
-	0xea, 0x7d, 0x39, 0x8d, 0x56, 0xe0, 0xc6, 0x99, 0x30, 0x7f, 0x4c, 0xce, 0xa0, 0x55, 0xb8, 0x79,
-	0x3a, 0x9e, 0x1b, 0xc8, 0x13, 0xf0, 0x4c, 0xf4, 0x89, 0x04, 0xf3, 0xb1, 0xc5, 0x08, 0xba, 0x02,
-	0x4b, 0x4d, 0x75, 0xaf, 0xde, 0x68, 0xb5, 0xb4, 0xa6, 0xba, 0xd7, 0xdc, 0x6b, 0xd5, 0x76, 0xb4,
-	0x56, 0xbb, 0xd6, 0xbe, 0xd7, 0x0a, 0xd9, 0x46, 0x81, 0xc5, 0x24, 0x90, 0x6f, 0x97, 0x53, 0x30,
-	0xe2, 0x04, 0x78, 0xe7, 0xf4, 0x27, 0x12, 0x5c, 0x4c, 0x2c, 0x29, 0xd0, 0x75, 0x78, 0x66, 0xbf,
-	0xa1, 0x6e, 0x6d, 0xdc, 0xd7, 0xf6, 0xf7, 0xda, 0x0d, 0xad, 0xf1, 0x5e, 0xbb, 0xb1, 0xdb, 0xda,
-	0xda, 0xdb, 0x9d, 0x9c, 0xd5, 0x35, 0xb8, 0x72, 0x2a, 0xd2, 0x9f, 0xda, 0x59, 0xc0, 0xb1, 0xf9,
-	0x7d, 0x4f, 0x82, 0xe9, 0x31, 0x5f, 0x88, 0x2e, 0x41, 0xf5, 0xee, 0x56, 0x6b, 0xbd, 0xb1, 0x59,
-	0xdb, 0xdf, 0xda, 0x53, 0xc7, 0xef, 0xec, 0x15, 0x58, 0x9a, 0x18, 0xbd, 0x7d, 0xaf, 0xb9, 0xb3,
-	0x55, 0xaf, 0xb5, 0x1b, 0xec, 0xa3, 0xb2, 0x44, 0x17, 0x36, 0x01, 0xda, 0xd9, 0x7a, 0x67, 0xb3,
-	0xad, 0xd5, 0x77, 0xb6, 0x1a, 0xbb, 0x6d, 0xad, 0xd6, 0x6e, 0xd7, 0x82, 0xeb, 0xbc, 0x7e, 0xe7,
-	0xb3, 0xaf, 0x16, 0xa5, 0x2f, 0xbe, 0x5a, 0x94, 0xfe, 0xf0, 0xd5, 0xa2, 0xf4, 0xe9, 0xd7, 0x8b,
-	0xe7, 0xbe, 0xf8, 0x7a, 0xf1, 0xdc, 0xef, 0xbe, 0x5e, 0x3c, 0xf7, 0xe0, 0xd6, 0xa1, 0x41, 0xfa,
-	0xa3, 0x03, 0xea, 0x85, 0x57, 0x83, 0xff, 0xeb, 0xfa, 0x7f, 0xf4, 0xb5, 0x8d, 0xd5, 0xf1, 0x7f,
-	0xfd, 0x1e, 0xe4, 0x98, 0x5b, 0x7d, 0xf1, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x54, 0xeb,
-	0x69, 0x10, 0x2c, 0x00, 0x00,
+	// 3374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xbd, 0xf7, 0x92, 0x14, 0x45, 0xfe, 0xf9, 0xa1, 0xd5, 0x48, 0xb2, 0x69, 0xc5, 0x91, 0xe4, 0x75,
+	0x1c, 0x3b, 0x76, 0x22, 0x3d, 0x2b, 0xef, 0xe5, 0xf3, 0x25, 0x01, 0x25, 0x53, 0x91, 0x64, 0x59,
+	0x62, 0x96, 0xb4, 0x5e, 0xec, 0xf7, 0xb1, 0x59, 0x91, 0x43, 0x71, 0x63, 0x72, 0x77, 0xb3, 0x3b,
+	0x64, 0xa8, 0xd7, 0x53, 0x8b, 0xa6, 0x28, 0x72, 0xca, 0xa5, 0x40, 0x51, 0xa0, 0x40, 0x81, 0xa0,
+	0xd7, 0x1e, 0x7a, 0xef, 0xad, 0x28, 0x72, 0x6a, 0x72, 0xec, 0x29, 0x2d, 0x12, 0xf4, 0xd2, 0x7b,
+	0x81, 0x02, 0xbd, 0x14, 0xf3, 0xb1, 0x5f, 0xe4, 0xae, 0x64, 0x3b, 0xe9, 0xa1, 0x68, 0x6f, 0x9c,
+	0x99, 0xdf, 0xff, 0xbf, 0x33, 0xff, 0x99, 0xf9, 0x7f, 0xfc, 0x86, 0x70, 0xa9, 0x65, 0xf5, 0x31,
+	0x39, 0xea, 0x90, 0x35, 0xfd, 0xa8, 0x65, 0xac, 0x0d, 0xd7, 0xd7, 0xc8, 0x89, 0x8d, 0xdd, 0x55,
+	0xdb, 0xb1, 0x88, 0x85, 0x64, 0x6f, 0x74, 0x95, 0x8e, 0xae, 0x0e, 0xd7, 0x17, 0x97, 0x7c, 0x7c,
+	0xcb, 0x39, 0xb1, 0x89, 0xb5, 0x36, 0xbc, 0xb5, 0x66, 0x3b, 0x96, 0xd5, 0xe1, 0x12, 0xa1, 0x71,
+	0xa6, 0x87, 0x2a, 0xb4, 0x75, 0x47, 0xef, 0x0b, 0x8d, 0x8b, 0x97, 0x27, 0xc7, 0x87, 0x7a, 0xcf,
+	0x68, 0xeb, 0xc4, 0x72, 0x04, 0x64, 0xfe, 0xd8, 0x3a, 0xb6, 0xd8, 0xcf, 0x35, 0xfa, 0x4b, 0xf4,
+	0x2e, 0x1f, 0x5b, 0xd6, 0x71, 0x0f, 0xaf, 0xb1, 0xd6, 0xd1, 0xa0, 0xb3, 0x46, 0x8c, 0x3e, 0x76,
+	0x89, 0xde, 0xb7, 0xbd, 0x2f, 0x8f, 0x03, 0xda, 0x03, 0x47, 0x27, 0x86, 0x65, 0xf2, 0x71, 0xe5,
+	0xf3, 0x3c, 0x4c, 0xab, 0xf8, 0x83, 0x01, 0x76, 0x09, 0x7a, 0x11, 0x32, 0xb8, 0xd5, 0xb5, 0x2a,
+	0xd2, 0x8a, 0x74, 0xbd, 0xb0, 0xfe, 0xf4, 0xea, 0xf8, 0x32, 0x57, 0x6b, 0xad, 0xae, 0x25, 0xc0,
+	0xdb, 0xe7, 0x54, 0x06, 0x46, 0x2f, 0xc1, 0x54, 0xa7, 0x37, 0x70, 0xbb, 0x95, 0x14, 0x93, 0x5a,
+	0x9a, 0x94, 0xda, 0xa2, 0xc3, 0x81, 0x18, 0x87, 0xd3, 0x8f, 0x19, 0x66, 0xc7, 0xaa, 0xa4, 0x93,
+	0x3e, 0xb6, 0x63, 0x76, 0xc2, 0x1f, 0xa3, 0x60, 0xb4, 0x09, 0x60, 0x98, 0x06, 0xd1, 0x5a, 0x5d,
+	0xdd, 0x30, 0x2b, 0x53, 0x4c, 0x54, 0x89, 0x13, 0x35, 0xc8, 0x26, 0x85, 0x04, 0xf2, 0x79, 0xc3,
+	0xeb, 0xa3, 0x33, 0xfe, 0x60, 0x80, 0x9d, 0x93, 0x4a, 0x36, 0x69, 0xc6, 0xef, 0xd0, 0xe1, 0xd0,
+	0x8c, 0x19, 0x1c, 0xbd, 0x01, 0xb9, 0x56, 0x17, 0xb7, 0x1e, 0x6a, 0x64, 0x54, 0xc9, 0x31, 0xd1,
+	0x95, 0x49, 0xd1, 0x4d, 0x8a, 0x68, 0x8e, 0x02, 0xe1, 0xe9, 0x16, 0xef, 0x41, 0xaf, 0x42, 0xb6,
+	0x65, 0xf5, 0xfb, 0x06, 0xa9, 0x14, 0x98, 0xf0, 0x72, 0x8c, 0x30, 0x1b, 0x0f, 0x64, 0x85, 0x00,
+	0x3a, 0x80, 0x72, 0xcf, 0x70, 0x89, 0xe6, 0x9a, 0xba, 0xed, 0x76, 0x2d, 0xe2, 0x56, 0x8a, 0x4c,
+	0xc5, 0xb3, 0x93, 0x2a, 0xf6, 0x0c, 0x97, 0x34, 0x3c, 0x58, 0xa0, 0xa9, 0xd4, 0x0b, 0xf7, 0x53,
+	0x85, 0x56, 0xa7, 0x83, 0x1d, 0x5f, 0x63, 0xa5, 0x94, 0xa4, 0xf0, 0x80, 0xe2, 0x3c, 0xc9, 0x90,
+	0x42, 0x2b, 0xdc, 0x8f, 0xfe, 0x07, 0xe6, 0x7a, 0x96, 0xde, 0xf6, 0xf5, 0x69, 0xad, 0xee, 0xc0,
+	0x7c, 0x58, 0x29, 0x33, 0xad, 0x37, 0x62, 0xa6, 0x69, 0xe9, 0x6d, 0x4f, 0x78, 0x93, 0x42, 0x03,
+	0xcd, 0xb3, 0xbd, 0xf1, 0x31, 0xa4, 0xc1, 0xbc, 0x6e, 0xdb, 0xbd, 0x93, 0x71, 0xf5, 0x33, 0x4c,
+	0xfd, 0xcd, 0x49, 0xf5, 0x55, 0x8a, 0x4e, 0xd0, 0x8f, 0xf4, 0x89, 0x41, 0x74, 0x0f, 0x64, 0xdb,
+	0xc1, 0xb6, 0xee, 0x60, 0xcd, 0x76, 0x2c, 0xdb, 0x72, 0xf5, 0x5e, 0x45, 0x66, 0xca, 0xaf, 0x4f,
+	0x2a, 0xaf, 0x73, 0x64, 0x5d, 0x00, 0x03, 0xcd, 0x33, 0x76, 0x74, 0x84, 0xab, 0xb5, 0x5a, 0xd8,
+	0x75, 0x03, 0xb5, 0xb3, 0xc9, 0x6a, 0x19, 0x32, 0x56, 0x6d, 0x64, 0x04, 0x6d, 0x41, 0x01, 0x8f,
+	0x08, 0x36, 0xdb, 0xda, 0xd0, 0x22, 0xb8, 0x82, 0x98, 0xc6, 0x2b, 0x31, 0xd7, 0x95, 0x81, 0x0e,
+	0x2d, 0x82, 0x03, 0x65, 0x80, 0xfd, 0x4e, 0x74, 0x04, 0x0b, 0x43, 0xec, 0x18, 0x9d, 0x13, 0xa6,
+	0x47, 0x63, 0x23, 0xae, 0x61, 0x99, 0x95, 0x39, 0xa6, 0xf1, 0xf9, 0x49, 0x8d, 0x87, 0x0c, 0x4e,
+	0x85, 0x6b, 0x1e, 0x38, 0x50, 0x3d, 0x37, 0x9c, 0x1c, 0xa5, 0x27, 0xad, 0x63, 0x98, 0x7a, 0xcf,
+	0xf8, 0x7f, 0xac, 0x1d, 0xf5, 0xac, 0xd6, 0xc3, 0xca, 0x7c, 0xd2, 0x49, 0xdb, 0x12, 0xb8, 0x0d,
+	0x0a, 0x0b, 0x9d, 0xb4, 0x4e, 0xb8, 0x7f, 0x63, 0x1a, 0xa6, 0x86, 0x7a, 0x6f, 0x80, 0x77, 0x33,
+	0xb9, 0x8c, 0x3c, 0xb5, 0x9b, 0xc9, 0x4d, 0xcb, 0xb9, 0xdd, 0x4c, 0x2e, 0x2f, 0xc3, 0x6e, 0x26,
+	0x07, 0x72, 0x41, 0xb9, 0x06, 0x85, 0x90, 0x9f, 0x42, 0x15, 0x98, 0xee, 0x63, 0xd7, 0xd5, 0x8f,
+	0x31, 0xf3, 0x6b, 0x79, 0xd5, 0x6b, 0x2a, 0x65, 0x28, 0x86, 0x5d, 0x93, 0xf2, 0x89, 0x04, 0x85,
+	0x90, 0xd3, 0xa1, 0x92, 0x43, 0xec, 0x30, 0x83, 0x08, 0x49, 0xd1, 0x44, 0x57, 0xa0, 0xc4, 0xd6,
+	0xa2, 0x79, 0xe3, 0xd4, 0xf7, 0x65, 0xd4, 0x22, 0xeb, 0x3c, 0x14, 0xa0, 0x65, 0x28, 0xd8, 0xeb,
+	0xb6, 0x0f, 0x49, 0x33, 0x08, 0xd8, 0xeb, 0xb6, 0x07, 0xb8, 0x0c, 0x45, 0xba, 0x74, 0x1f, 0x91,
+	0x61, 0x1f, 0x29, 0xd0, 0x3e, 0x01, 0x51, 0x7e, 0x9b, 0x02, 0x79, 0xdc, 0x99, 0xa1, 0x57, 0x20,
+	0x43, 0xbd, 0xbc, 0x70, 0xd3, 0x8b, 0xab, 0xdc, 0xc3, 0xaf, 0x7a, 0x1e, 0x7e, 0xb5, 0xe9, 0x85,
+	0x80, 0x8d, 0xdc, 0x67, 0x5f, 0x2e, 0x9f, 0xfb, 0xe4, 0xf7, 0xcb, 0x92, 0xca, 0x24, 0xd0, 0x45,
+	0xea, 0xc1, 0x74, 0xc3, 0xd4, 0x8c, 0x36, 0x9b, 0x72, 0x9e, 0x7a, 0x27, 0xdd, 0x30, 0x77, 0xda,
+	0xe8, 0x2e, 0xc8, 0x2d, 0xcb, 0x74, 0xb1, 0xe9, 0x0e, 0x5c, 0x8d, 0xc7, 0xa6, 0x4a, 0x7a, 0xdc,
+	0xbf, 0xf2, 0x20, 0xc8, 0x1c, 0x95, 0x80, 0xd6, 0x19, 0x52, 0x9d, 0x69, 0x45, 0x3b, 0xd0, 0xdb,
+	0x00, 0x7e, 0x00, 0x73, 0x2b, 0x99, 0x95, 0xf4, 0xf5, 0xc2, 0xfa, 0xe5, 0x98, 0xf3, 0xe4, 0x61,
+	0xee, 0xd9, 0x6d, 0x9d, 0xe0, 0x8d, 0x0c, 0x9d, 0xb0, 0x1a, 0x12, 0x45, 0xcf, 0xc2, 0x8c, 0x6e,
+	0xdb, 0x9a, 0x4b, 0x74, 0x82, 0xb5, 0xa3, 0x13, 0x82, 0x5d, 0xe6, 0xf6, 0x8b, 0x6a, 0x49, 0xb7,
+	0xed, 0x06, 0xed, 0xdd, 0xa0, 0x9d, 0xe8, 0x2a, 0x94, 0xa9, 0x87, 0x37, 0xf4, 0x9e, 0xd6, 0xc5,
+	0xc6, 0x71, 0x97, 0x30, 0xef, 0x9e, 0x56, 0x4b, 0xa2, 0x77, 0x9b, 0x75, 0x2a, 0x6d, 0x28, 0x86,
+	0x9d, 0x3b, 0x42, 0x90, 0x69, 0xeb, 0x44, 0x67, 0xb6, 0x2c, 0xaa, 0xec, 0x37, 0xed, 0xb3, 0x75,
+	0xd2, 0x15, 0x16, 0x62, 0xbf, 0xd1, 0x79, 0xc8, 0x0a, 0xb5, 0x69, 0xa6, 0x56, 0xb4, 0xd0, 0x3c,
+	0x4c, 0xd9, 0x8e, 0x35, 0xc4, 0x6c, 0xf3, 0x72, 0x2a, 0x6f, 0x28, 0xf7, 0xa1, 0x1c, 0x8d, 0x03,
+	0xa8, 0x0c, 0x29, 0x32, 0x12, 0x5f, 0x49, 0x91, 0x11, 0xba, 0x05, 0x19, 0x6a, 0x4c, 0xa6, 0xad,
+	0x1c, 0x17, 0xfd, 0x84, 0x7c, 0xf3, 0xc4, 0xc6, 0x2a, 0x83, 0xee, 0x66, 0x72, 0x29, 0x39, 0xad,
+	0xcc, 0x40, 0x29, 0x12, 0x25, 0x94, 0xf3, 0x30, 0x1f, 0xe7, 0xf3, 0x15, 0x03, 0xe6, 0xe3, 0x5c,
+	0x37, 0x7a, 0x09, 0x72, 0xbe, 0xd3, 0xf7, 0x4e, 0xd0, 0xc4, 0xd7, 0x7d, 0x21, 0x1f, 0x4b, 0xcf,
+	0x0e, 0xdd, 0x88, 0xae, 0x2e, 0x42, 0x7d, 0x51, 0x9d, 0xd6, 0x6d, 0x7b, 0x5b, 0x77, 0xbb, 0xca,
+	0x7b, 0x50, 0x49, 0xf2, 0xe7, 0x21, 0xc3, 0x49, 0xec, 0x02, 0x78, 0x86, 0x3b, 0x0f, 0xd9, 0x8e,
+	0xe5, 0xf4, 0x75, 0xc2, 0x94, 0x95, 0x54, 0xd1, 0xa2, 0x06, 0xe5, 0xbe, 0x3d, 0xcd, 0xba, 0x79,
+	0x43, 0xd1, 0xe0, 0x62, 0xa2, 0x4b, 0xa7, 0x22, 0x86, 0xd9, 0xc6, 0xdc, 0xbc, 0x25, 0x95, 0x37,
+	0x02, 0x45, 0x7c, 0xb2, 0xbc, 0x41, 0x3f, 0xeb, 0x62, 0xb3, 0x8d, 0x1d, 0xa6, 0x3f, 0xaf, 0x8a,
+	0x96, 0xf2, 0x93, 0x34, 0x9c, 0x8f, 0xf7, 0xeb, 0x68, 0x05, 0x8a, 0x7d, 0x7d, 0xa4, 0x91, 0x91,
+	0x38, 0x7e, 0x12, 0x3b, 0x00, 0xd0, 0xd7, 0x47, 0xcd, 0x11, 0x3f, 0x7b, 0x32, 0xa4, 0xc9, 0xc8,
+	0xad, 0xa4, 0x56, 0xd2, 0xd7, 0x8b, 0x2a, 0xfd, 0x89, 0x0e, 0x61, 0xb6, 0x67, 0xb5, 0xf4, 0x9e,
+	0xd6, 0xd3, 0x5d, 0xa2, 0x89, 0xb0, 0xcf, 0xaf, 0xd3, 0x33, 0x49, 0x7e, 0x1a, 0xb7, 0xf9, 0xc6,
+	0x52, 0x17, 0x24, 0x2e, 0xc2, 0x0c, 0x53, 0xb2, 0xa7, 0xbb, 0x84, 0x0f, 0xa1, 0x1a, 0x14, 0xfa,
+	0x86, 0x7b, 0x84, 0xbb, 0xfa, 0xd0, 0xb0, 0x1c, 0x71, 0xaf, 0x62, 0x4e, 0xcf, 0xdd, 0x00, 0x24,
+	0x54, 0x85, 0xe5, 0x42, 0x9b, 0x32, 0x15, 0x39, 0xcd, 0x9e, 0x67, 0xc9, 0x3e, 0xb6, 0x67, 0xf9,
+	0x37, 0x98, 0x37, 0xf1, 0x88, 0x68, 0xc1, 0xcd, 0xe5, 0x27, 0x65, 0x9a, 0x19, 0x1f, 0xd1, 0x31,
+	0xff, 0xae, 0xbb, 0xf4, 0xd0, 0xa0, 0xe7, 0x58, 0x6c, 0xb4, 0x2d, 0x17, 0x3b, 0x9a, 0xde, 0x6e,
+	0x3b, 0xd8, 0x75, 0x59, 0x56, 0x55, 0x54, 0x67, 0xbc, 0xfe, 0x2a, 0xef, 0x56, 0x3e, 0x66, 0x9b,
+	0x13, 0x17, 0x1d, 0x3d, 0xd3, 0x4b, 0x81, 0xe9, 0x9b, 0x30, 0x2f, 0xe4, 0xdb, 0x11, 0xeb, 0xf3,
+	0xf4, 0xf4, 0x52, 0x52, 0xd2, 0x15, 0xb2, 0x3a, 0xf2, 0xe4, 0x93, 0x0d, 0x9f, 0x7e, 0x42, 0xc3,
+	0x23, 0xc8, 0x30, 0xb3, 0x64, 0xb8, 0xbb, 0xa1, 0xbf, 0xff, 0xd1, 0x36, 0xe3, 0xa3, 0x34, 0xcc,
+	0x4e, 0x24, 0x16, 0xfe, 0xc2, 0xa4, 0xd8, 0x85, 0xa5, 0x62, 0x17, 0x96, 0x7e, 0xec, 0x85, 0x89,
+	0xdd, 0xce, 0x9c, 0xbd, 0xdb, 0x53, 0xdf, 0xe6, 0x6e, 0x67, 0x9f, 0x70, 0xb7, 0xff, 0xae, 0xfb,
+	0xf0, 0xb9, 0x04, 0x8b, 0xc9, 0xe9, 0x58, 0xec, 0x86, 0xdc, 0x84, 0x59, 0x7f, 0x2a, 0xbe, 0x7a,
+	0xee, 0x1e, 0x65, 0x7f, 0x40, 0xe8, 0x4f, 0x8c, 0x78, 0x57, 0xa1, 0x3c, 0x96, 0x2d, 0xf2, 0xc3,
+	0x5c, 0x1a, 0x46, 0xf2, 0xbe, 0x5b, 0xb0, 0x60, 0x5a, 0xa6, 0xe6, 0xd8, 0xe3, 0xb9, 0xe5, 0x94,
+	0x58, 0xbc, 0x65, 0xaa, 0x76, 0x64, 0xe6, 0xca, 0x2f, 0xd3, 0x30, 0x1f, 0x97, 0x03, 0xc6, 0x5c,
+	0x72, 0x15, 0xe6, 0xda, 0xb8, 0x65, 0xb4, 0x9f, 0xf8, 0x8e, 0xcf, 0x0a, 0xf1, 0x7f, 0x5d, 0xf1,
+	0xc9, 0xa3, 0x85, 0x6e, 0xc0, 0xac, 0x7b, 0x62, 0xb6, 0x0c, 0xf3, 0x58, 0x23, 0x96, 0x97, 0x4e,
+	0xe5, 0xd9, 0xcc, 0x67, 0xc4, 0x40, 0xd3, 0x12, 0x09, 0xd5, 0xcf, 0x01, 0x72, 0x2a, 0x76, 0x6d,
+	0xcb, 0x74, 0x31, 0xda, 0x84, 0x3c, 0x1e, 0xb5, 0xb0, 0x4d, 0xbc, 0x9c, 0x39, 0xa1, 0x2c, 0x11,
+	0x10, 0x4f, 0x8e, 0x96, 0xe7, 0xbe, 0x1c, 0xfa, 0x77, 0xc1, 0x42, 0x24, 0xf2, 0x09, 0x3c, 0xbb,
+	0xf7, 0x45, 0x19, 0x1a, 0xbd, 0xec, 0xd1, 0x10, 0xe9, 0xa4, 0xe2, 0x5a, 0xe4, 0xfa, 0xbe, 0x1c,
+	0xc7, 0xd3, 0xcf, 0x31, 0x1e, 0x22, 0x93, 0xf4, 0x39, 0x5e, 0x12, 0x04, 0x9f, 0xa3, 0x68, 0x74,
+	0x3b, 0x42, 0x44, 0x64, 0x93, 0x96, 0x1a, 0xca, 0xdd, 0x83, 0xa5, 0x06, 0x4c, 0xc4, 0xcb, 0x1e,
+	0x13, 0x31, 0x9d, 0x34, 0x69, 0x91, 0xac, 0x06, 0x93, 0x66, 0x78, 0xf4, 0x66, 0x88, 0x8a, 0xc8,
+	0xaf, 0x48, 0xf1, 0xc9, 0xb5, 0x9f, 0x82, 0xfa, 0xd2, 0x3e, 0x17, 0xf1, 0x9a, 0xcf, 0x45, 0x14,
+	0x13, 0x89, 0x0c, 0x91, 0x65, 0xfa, 0xc2, 0x42, 0x02, 0xd5, 0x27, 0xc8, 0x08, 0xce, 0x1d, 0x5c,
+	0x3b, 0x93, 0x8c, 0xf0, 0x55, 0x8d, 0xb1, 0x11, 0xf5, 0x09, 0x36, 0xa2, 0x9c, 0xa4, 0x71, 0x2c,
+	0xa5, 0x0d, 0x34, 0x46, 0xe9, 0x88, 0xff, 0x8d, 0xa7, 0x23, 0x12, 0xf9, 0x82, 0x98, 0xf4, 0xd5,
+	0x57, 0x1d, 0xc3, 0x47, 0xbc, 0x97, 0xc0, 0x47, 0xc8, 0x49, 0x75, 0x73, 0x5c, 0xf2, 0xea, 0x7f,
+	0x20, 0x8e, 0x90, 0x38, 0x8c, 0x21, 0x24, 0x38, 0x73, 0xf0, 0xdc, 0x23, 0x10, 0x12, 0xbe, 0xea,
+	0x09, 0x46, 0xe2, 0x30, 0x86, 0x91, 0x40, 0xc9, 0x7a, 0xc7, 0x72, 0xae, 0xb0, 0xde, 0xc8, 0x10,
+	0x7a, 0x3b, 0x4a, 0x49, 0xcc, 0x9d, 0x9e, 0xea, 0xf2, 0xcc, 0xc1, 0xd7, 0x16, 0xe6, 0x24, 0x5a,
+	0x49, 0x9c, 0x04, 0xa7, 0x0d, 0x5e, 0x78, 0x44, 0x4e, 0xc2, 0xd7, 0x1d, 0x4b, 0x4a, 0xd4, 0x27,
+	0x48, 0x89, 0x85, 0xa4, 0x03, 0x37, 0x16, 0x90, 0x82, 0x03, 0x97, 0xc8, 0x4a, 0x4c, 0xc9, 0xd9,
+	0xdd, 0x4c, 0x2e, 0x27, 0xe7, 0x39, 0x1f, 0xb1, 0x9b, 0xc9, 0x15, 0xe4, 0xa2, 0xf2, 0x1c, 0xcd,
+	0x9a, 0xc6, 0xfc, 0x1e, 0xad, 0x51, 0xb0, 0xe3, 0x58, 0x8e, 0xe0, 0x17, 0x78, 0x43, 0xb9, 0x0e,
+	0xc5, 0xb0, 0x8b, 0x3b, 0x85, 0xc1, 0x98, 0x81, 0x52, 0xc4, 0xab, 0x29, 0x7f, 0x4d, 0x41, 0x31,
+	0xec, 0xaf, 0x22, 0xf5, 0x6d, 0x5e, 0xd4, 0xb7, 0x21, 0x5e, 0x23, 0x15, 0xe5, 0x35, 0x96, 0xa1,
+	0x40, 0x6b, 0xbc, 0x31, 0xca, 0x42, 0xb7, 0x7d, 0xca, 0xe2, 0x06, 0xcc, 0xb2, 0x78, 0xcb, 0xd9,
+	0x0f, 0x11, 0x19, 0x32, 0x3c, 0x32, 0xd0, 0x01, 0x66, 0x0c, 0x1e, 0x19, 0xd0, 0x0b, 0x30, 0x17,
+	0xc2, 0xfa, 0xb5, 0x23, 0x8f, 0xff, 0xb2, 0x8f, 0xae, 0xf2, 0x22, 0x12, 0xfd, 0x37, 0xcc, 0xf4,
+	0x74, 0x93, 0x1e, 0x77, 0xc3, 0x72, 0x0c, 0x62, 0x60, 0x57, 0xe4, 0x5d, 0xeb, 0xa7, 0xbb, 0xe4,
+	0xd5, 0x3d, 0xdd, 0xc4, 0x75, 0x5f, 0xa8, 0x66, 0x12, 0xe7, 0x44, 0x2d, 0xf7, 0x22, 0x9d, 0x94,
+	0x6a, 0x69, 0xe3, 0x8e, 0x3e, 0xe8, 0x11, 0x8d, 0x8e, 0x30, 0x7f, 0x9b, 0x57, 0x0b, 0xa2, 0x8f,
+	0x6a, 0x58, 0xac, 0xc2, 0x5c, 0x8c, 0x26, 0x9a, 0x7b, 0x3c, 0xc4, 0x27, 0xc2, 0x7e, 0xf4, 0x27,
+	0x9a, 0x17, 0x5b, 0x2d, 0x0a, 0x57, 0xde, 0x78, 0x2d, 0xf5, 0x8a, 0xa4, 0xfc, 0x46, 0x82, 0xd9,
+	0x09, 0x8f, 0x1f, 0xcb, 0xac, 0x48, 0xdf, 0x16, 0xb3, 0x92, 0x7a, 0x72, 0x66, 0x25, 0x5c, 0xd0,
+	0xa7, 0xa3, 0x05, 0xfd, 0x5f, 0x24, 0x28, 0x45, 0x22, 0x0f, 0x3d, 0x47, 0x2d, 0xab, 0x8d, 0x45,
+	0x89, 0xcd, 0x7e, 0x53, 0xd3, 0xf4, 0xac, 0x63, 0x51, 0x48, 0xd3, 0x9f, 0x14, 0xe5, 0xc7, 0xd2,
+	0xbc, 0x88, 0x94, 0x7e, 0x75, 0xce, 0x53, 0x1f, 0xde, 0xf0, 0xcc, 0x9a, 0x65, 0xdf, 0x8d, 0x9a,
+	0x95, 0xa7, 0x30, 0xbc, 0x81, 0x5e, 0x85, 0x3c, 0x7b, 0x47, 0xd1, 0x2c, 0xdb, 0xad, 0xe4, 0xc6,
+	0xd3, 0x3b, 0xfe, 0xd8, 0xb2, 0x3a, 0xbc, 0x45, 0x5d, 0x95, 0xd5, 0x39, 0xb0, 0x5d, 0x35, 0x67,
+	0x8b, 0x5f, 0xa1, 0xa4, 0x2b, 0x1f, 0x49, 0xba, 0x2e, 0x41, 0x9e, 0x4e, 0xdf, 0xb5, 0xf5, 0x16,
+	0xae, 0x00, 0x9b, 0x69, 0xd0, 0xa1, 0xfc, 0x3a, 0x05, 0x33, 0x63, 0x81, 0x33, 0x76, 0xf1, 0xde,
+	0xc5, 0x4a, 0x85, 0x88, 0xa3, 0x47, 0x33, 0xc8, 0x12, 0xc0, 0xb1, 0xee, 0x6a, 0x1f, 0xea, 0x26,
+	0xc1, 0x6d, 0x61, 0x95, 0x50, 0x0f, 0x5a, 0x84, 0x1c, 0x6d, 0x0d, 0x5c, 0xdc, 0x16, 0x1c, 0x96,
+	0xdf, 0x46, 0x3b, 0x90, 0xc5, 0x43, 0x6c, 0x12, 0xb7, 0x32, 0xcd, 0x36, 0xfe, 0x42, 0x8c, 0x87,
+	0xa5, 0xe3, 0x1b, 0x15, 0xba, 0xdd, 0x7f, 0xfa, 0x72, 0x59, 0xe6, 0xf0, 0xe7, 0xad, 0xbe, 0x41,
+	0x70, 0xdf, 0x26, 0x27, 0xaa, 0x50, 0x10, 0x35, 0x43, 0x6e, 0xcc, 0x0c, 0xe8, 0x02, 0x4c, 0xb3,
+	0xdb, 0x68, 0xb4, 0x59, 0x86, 0x90, 0x57, 0xb3, 0xb4, 0xb9, 0xd3, 0x66, 0x4c, 0x6b, 0xd1, 0xa3,
+	0x4d, 0xa8, 0xb5, 0xd9, 0x75, 0x39, 0x51, 0x4b, 0x7d, 0xdc, 0xb7, 0x2d, 0xab, 0xa7, 0x71, 0x1f,
+	0x56, 0x85, 0x72, 0x34, 0x81, 0xa0, 0x9c, 0xa9, 0x83, 0x09, 0x25, 0x1f, 0x23, 0x65, 0x45, 0x91,
+	0x77, 0x72, 0x9f, 0xb1, 0x9b, 0xc9, 0x49, 0x72, 0x4a, 0x30, 0x5d, 0xef, 0xc0, 0x42, 0x6c, 0xfe,
+	0x80, 0x5e, 0x81, 0x7c, 0x90, 0x7b, 0x48, 0x2b, 0xe9, 0x33, 0x28, 0xac, 0x00, 0xac, 0x1c, 0xc2,
+	0x42, 0x6c, 0x02, 0x81, 0xde, 0x80, 0xac, 0x83, 0xdd, 0x41, 0x8f, 0xb3, 0x54, 0xe5, 0xf5, 0xab,
+	0x67, 0x67, 0x1e, 0x83, 0x1e, 0x51, 0x85, 0x90, 0x72, 0x0b, 0x2e, 0x26, 0x66, 0x10, 0x01, 0x11,
+	0x25, 0x85, 0x88, 0x28, 0xe5, 0x17, 0x12, 0x2c, 0x26, 0x67, 0x05, 0x68, 0x63, 0x6c, 0x42, 0x37,
+	0x1e, 0x31, 0xa7, 0x08, 0xcd, 0x8a, 0x56, 0x6a, 0x0e, 0xee, 0x60, 0xd2, 0xea, 0xf2, 0xf4, 0x84,
+	0x7b, 0x8b, 0x92, 0x5a, 0x12, 0xbd, 0x4c, 0xc6, 0xe5, 0xb0, 0xf7, 0x71, 0x8b, 0x68, 0x7c, 0x53,
+	0x5d, 0x56, 0xfa, 0xe4, 0xd5, 0x12, 0xef, 0x6d, 0xf0, 0x4e, 0xe5, 0x26, 0x5c, 0x48, 0xc8, 0x33,
+	0x26, 0xeb, 0x33, 0xe5, 0x01, 0x05, 0xc7, 0x26, 0x0f, 0xe8, 0x2d, 0xc8, 0xba, 0x44, 0x27, 0x03,
+	0x57, 0xac, 0xec, 0xda, 0x99, 0x79, 0x47, 0x83, 0xc1, 0x55, 0x21, 0xa6, 0x60, 0x40, 0x93, 0x59,
+	0x44, 0x4c, 0x59, 0x2a, 0xc5, 0x95, 0xa5, 0xd7, 0x41, 0x16, 0x65, 0x69, 0x00, 0xe4, 0x57, 0xb8,
+	0xcc, 0x2a, 0xd2, 0xa0, 0x1a, 0x3d, 0x82, 0xa7, 0x4e, 0xc9, 0x2c, 0xd0, 0xe6, 0xd8, 0x32, 0x6e,
+	0x3e, 0x52, 0x62, 0x32, 0xb6, 0x94, 0x5f, 0xa5, 0x61, 0x21, 0x36, 0xc1, 0x08, 0x5d, 0x74, 0xe9,
+	0x9b, 0x5e, 0xf4, 0x37, 0x00, 0xc8, 0x48, 0xe3, 0x67, 0xc2, 0x0b, 0x18, 0x71, 0x55, 0xd5, 0x08,
+	0xb7, 0x9a, 0x23, 0x71, 0x84, 0xf2, 0x44, 0xfc, 0xa2, 0x0c, 0x4b, 0x88, 0x34, 0x18, 0xb0, 0x60,
+	0xe2, 0x56, 0xd2, 0x8f, 0x17, 0x76, 0xe4, 0x61, 0xb4, 0xdb, 0x45, 0x0f, 0xe0, 0xc2, 0x58, 0x50,
+	0xf4, 0x75, 0x67, 0x1e, 0x39, 0x36, 0x2e, 0x44, 0x63, 0xa3, 0xa7, 0x3b, 0x1c, 0xd8, 0xa6, 0x22,
+	0x81, 0x8d, 0xc6, 0x62, 0x56, 0x36, 0xf3, 0x9c, 0xa4, 0x8d, 0x7b, 0xba, 0xf7, 0x0a, 0x7c, 0x71,
+	0xa2, 0xf8, 0xbe, 0x2d, 0x1e, 0xca, 0x79, 0xed, 0xfd, 0x63, 0x5a, 0x7b, 0x97, 0xa9, 0x30, 0xdb,
+	0xa8, 0xdb, 0x54, 0x54, 0x79, 0x00, 0x10, 0x30, 0x0b, 0xf4, 0xa2, 0x3b, 0xd6, 0xc0, 0x6c, 0xb3,
+	0x13, 0x31, 0xa5, 0xf2, 0x06, 0x7d, 0x6d, 0xa6, 0x47, 0xd0, 0xb3, 0x7c, 0x8c, 0xa7, 0xa2, 0x27,
+	0x24, 0x44, 0x4d, 0x70, 0xb8, 0xf2, 0x3e, 0xa0, 0x49, 0x5e, 0x38, 0xe1, 0x1b, 0x6f, 0x46, 0xbf,
+	0xa1, 0x24, 0x53, 0xcc, 0xf1, 0xdf, 0xfa, 0x0e, 0x4c, 0xb1, 0xd3, 0x44, 0xe3, 0x15, 0x7b, 0x96,
+	0x10, 0xe9, 0x22, 0xfd, 0x8d, 0xfe, 0x0f, 0x40, 0x27, 0xc4, 0x31, 0x8e, 0x06, 0xc1, 0x17, 0x56,
+	0x12, 0x8e, 0x63, 0xd5, 0x03, 0x6e, 0x5c, 0x12, 0xe7, 0x72, 0x3e, 0x90, 0x0d, 0x9d, 0xcd, 0x90,
+	0x46, 0x65, 0x1f, 0xca, 0x51, 0xd9, 0xb3, 0x72, 0xae, 0xbc, 0x97, 0x1c, 0xf8, 0xa9, 0x45, 0x9a,
+	0x3f, 0xbe, 0xb0, 0x86, 0xf2, 0xdd, 0x14, 0x14, 0xc3, 0x87, 0xf9, 0x9f, 0x30, 0x7c, 0x2b, 0x3f,
+	0x90, 0x20, 0xe7, 0xaf, 0x3f, 0xfa, 0x04, 0x13, 0x79, 0xbb, 0xe2, 0xe6, 0x4b, 0x85, 0xdf, 0x4d,
+	0xf8, 0x4b, 0x55, 0xda, 0x7f, 0xa9, 0xfa, 0x4f, 0x3f, 0x12, 0x25, 0x32, 0x24, 0x61, 0x6b, 0x8b,
+	0x83, 0xe5, 0x45, 0xc6, 0xd7, 0x21, 0xef, 0xbb, 0x04, 0x5a, 0x78, 0x78, 0xcc, 0x93, 0x24, 0xee,
+	0x25, 0x6f, 0xd2, 0xa9, 0xd8, 0xd6, 0x87, 0xe2, 0x55, 0x26, 0xad, 0xf2, 0x86, 0xf2, 0xa9, 0x04,
+	0x33, 0x63, 0x0e, 0x25, 0x40, 0xa6, 0x42, 0x48, 0xa4, 0x40, 0xc9, 0x1e, 0x1c, 0x69, 0x0f, 0xf1,
+	0x89, 0x78, 0xa4, 0xe1, 0xf3, 0x2f, 0xd8, 0x83, 0xa3, 0x3b, 0xf8, 0x84, 0xbf, 0xd2, 0xac, 0x40,
+	0xd1, 0xc3, 0xb0, 0x33, 0xce, 0x37, 0x15, 0x38, 0x84, 0xbe, 0xb3, 0xa1, 0x55, 0x98, 0x13, 0xc9,
+	0x66, 0x47, 0xb3, 0x2d, 0xd7, 0xc5, 0x6e, 0x88, 0xb1, 0x9c, 0xe5, 0x89, 0x65, 0xa7, 0xee, 0x0f,
+	0xf0, 0x6c, 0x45, 0xf9, 0x91, 0x04, 0x39, 0xef, 0x5a, 0xa1, 0xb7, 0x20, 0xef, 0xfb, 0x3a, 0x91,
+	0xe5, 0x3f, 0x75, 0x8a, 0x97, 0x14, 0xd6, 0x0a, 0x64, 0xd0, 0x86, 0xf7, 0xb4, 0x6c, 0xb4, 0xb5,
+	0x4e, 0x4f, 0x3f, 0x16, 0x2f, 0x84, 0x4b, 0x31, 0xee, 0x90, 0x39, 0xa2, 0x9d, 0xdb, 0x5b, 0x3d,
+	0xfd, 0x58, 0x2d, 0x30, 0xa1, 0x9d, 0x36, 0x6d, 0x88, 0xfc, 0xe9, 0x8f, 0x29, 0x90, 0xc7, 0xaf,
+	0xfd, 0x37, 0x9f, 0xdf, 0x64, 0x9c, 0x4d, 0xc7, 0xc5, 0xd9, 0x35, 0x98, 0xf3, 0x11, 0x9a, 0x6b,
+	0x1c, 0x9b, 0x3a, 0x19, 0x38, 0x58, 0x90, 0xa2, 0xc8, 0x1f, 0x6a, 0x78, 0x23, 0x93, 0xeb, 0x9e,
+	0x7a, 0xec, 0x75, 0x27, 0x73, 0xce, 0xd9, 0x24, 0xce, 0x19, 0xbd, 0x0e, 0x8b, 0xe3, 0xf9, 0x40,
+	0x68, 0xba, 0xbc, 0x14, 0xb9, 0x10, 0xcd, 0x0c, 0xfc, 0x39, 0x0b, 0x3b, 0x7f, 0x94, 0x82, 0x42,
+	0x88, 0x13, 0x46, 0xff, 0x11, 0xf2, 0xa1, 0xe5, 0xb8, 0x18, 0x19, 0x02, 0x07, 0xcf, 0xbb, 0xd1,
+	0x9d, 0x49, 0x3d, 0xc1, 0xce, 0x24, 0x11, 0xf6, 0x1e, 0xc9, 0x9c, 0x79, 0x6c, 0x92, 0xf9, 0x79,
+	0x40, 0xc4, 0x22, 0x7a, 0x8f, 0x9a, 0x93, 0x92, 0xc1, 0xfc, 0xe2, 0x71, 0x97, 0x27, 0xb3, 0x91,
+	0x43, 0x36, 0x50, 0x67, 0xb7, 0xf5, 0x7b, 0x12, 0xe4, 0x7c, 0x02, 0xee, 0x71, 0x9f, 0x7d, 0xcf,
+	0x43, 0x56, 0xe4, 0xa8, 0xfc, 0xdd, 0x57, 0xb4, 0x62, 0xd9, 0xf4, 0x45, 0xc8, 0xf5, 0x31, 0xd1,
+	0x99, 0xff, 0xe6, 0x77, 0xd3, 0x6f, 0xdf, 0x38, 0x82, 0x42, 0xe8, 0xe5, 0x1c, 0x5d, 0x84, 0x85,
+	0xcd, 0xed, 0xda, 0xe6, 0x1d, 0xad, 0xf9, 0xae, 0xd6, 0xbc, 0x5f, 0xaf, 0x69, 0xf7, 0xf6, 0xef,
+	0xec, 0x1f, 0xfc, 0xd7, 0xbe, 0x7c, 0x6e, 0x72, 0x48, 0xad, 0xb1, 0xb6, 0x2c, 0xa1, 0x0b, 0x30,
+	0x17, 0x1d, 0xe2, 0x03, 0xa9, 0xc5, 0xcc, 0x0f, 0x3f, 0x5d, 0x3a, 0x77, 0xe3, 0xcf, 0x12, 0xcc,
+	0xc5, 0x54, 0x03, 0xe8, 0x32, 0x3c, 0x7d, 0xb0, 0xb5, 0x55, 0x53, 0xb5, 0xc6, 0x7e, 0xb5, 0xde,
+	0xd8, 0x3e, 0x68, 0x6a, 0x6a, 0xad, 0x71, 0x6f, 0xaf, 0x19, 0xfa, 0xe8, 0x0a, 0x5c, 0x8a, 0x87,
+	0x54, 0x37, 0x37, 0x6b, 0xf5, 0xa6, 0x2c, 0xa1, 0x65, 0x78, 0x2a, 0x01, 0xb1, 0x71, 0xa0, 0x36,
+	0xe5, 0x54, 0xb2, 0x0a, 0xb5, 0xb6, 0x5b, 0xdb, 0x6c, 0xca, 0x69, 0x74, 0x0d, 0xae, 0x9c, 0x86,
+	0xd0, 0xb6, 0x0e, 0xd4, 0xbb, 0xd5, 0xa6, 0x9c, 0x39, 0x13, 0xd8, 0xa8, 0xed, 0xdf, 0xae, 0xa9,
+	0xf2, 0x94, 0x58, 0xf7, 0xcf, 0x52, 0x50, 0x49, 0x2a, 0x3a, 0xa8, 0xae, 0x6a, 0xbd, 0xbe, 0x77,
+	0x3f, 0xd0, 0xb5, 0xb9, 0x7d, 0x6f, 0xff, 0xce, 0xa4, 0x09, 0x9e, 0x05, 0xe5, 0x34, 0xa0, 0x6f,
+	0x88, 0xab, 0x70, 0xf9, 0x54, 0x9c, 0x30, 0xc7, 0x19, 0x30, 0xb5, 0xd6, 0x54, 0xef, 0xcb, 0x69,
+	0xb4, 0x0a, 0x37, 0xce, 0x84, 0xf9, 0x63, 0x72, 0x06, 0xad, 0xc1, 0xcd, 0xd3, 0xf1, 0xdc, 0x40,
+	0x9e, 0x80, 0x67, 0xa2, 0x8f, 0x25, 0x58, 0x88, 0xad, 0x5e, 0xd0, 0x15, 0x58, 0xae, 0xab, 0x07,
+	0x9b, 0xb5, 0x46, 0x43, 0xab, 0xab, 0x07, 0xf5, 0x83, 0x46, 0x75, 0x4f, 0x6b, 0x34, 0xab, 0xcd,
+	0x7b, 0x8d, 0x90, 0x6d, 0x14, 0x58, 0x4a, 0x02, 0xf9, 0x76, 0x39, 0x05, 0x23, 0x4e, 0x80, 0x77,
+	0x4e, 0x7f, 0x2a, 0xc1, 0xc5, 0xc4, 0x1a, 0x04, 0x5d, 0x87, 0x67, 0x0e, 0x6b, 0xea, 0xce, 0xd6,
+	0x7d, 0xed, 0xf0, 0xa0, 0x59, 0xd3, 0x6a, 0xef, 0x36, 0x6b, 0xfb, 0x8d, 0x9d, 0x83, 0xfd, 0xc9,
+	0x59, 0x5d, 0x83, 0x2b, 0xa7, 0x22, 0xfd, 0xa9, 0x9d, 0x05, 0x1c, 0x9b, 0xdf, 0xf7, 0x25, 0x98,
+	0x19, 0xf3, 0x85, 0xe8, 0x12, 0x54, 0xee, 0xee, 0x34, 0x36, 0x6a, 0xdb, 0xd5, 0xc3, 0x9d, 0x03,
+	0x75, 0xfc, 0xce, 0x5e, 0x81, 0xe5, 0x89, 0xd1, 0xdb, 0xf7, 0xea, 0x7b, 0x3b, 0x9b, 0xd5, 0x66,
+	0x8d, 0x7d, 0x54, 0x96, 0xe8, 0xc2, 0x26, 0x40, 0x7b, 0x3b, 0x6f, 0x6f, 0x37, 0xb5, 0xcd, 0xbd,
+	0x9d, 0xda, 0x7e, 0x53, 0xab, 0x36, 0x9b, 0xd5, 0xe0, 0x3a, 0x6f, 0xdc, 0xf9, 0xec, 0xab, 0x25,
+	0xe9, 0x8b, 0xaf, 0x96, 0xa4, 0x3f, 0x7c, 0xb5, 0x24, 0x7d, 0xf2, 0xf5, 0xd2, 0xb9, 0x2f, 0xbe,
+	0x5e, 0x3a, 0xf7, 0xbb, 0xaf, 0x97, 0xce, 0x3d, 0xb8, 0x75, 0x6c, 0x90, 0xee, 0xe0, 0x88, 0x7a,
+	0xe1, 0xb5, 0xe0, 0x0f, 0xbe, 0xde, 0x0f, 0xdd, 0x36, 0xd6, 0xc6, 0xff, 0x26, 0x7c, 0x94, 0x65,
+	0x6e, 0xf5, 0xc5, 0xbf, 0x0d, 0x00, 0xb0, 0x41, 0xad, 0xc4, 0x41, 0x2c, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -6827,6 +6835,13 @@ func (m *ValidatorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofOfPossession) > 0 {
+		i -= len(m.ProofOfPossession)
+		copy(dAtA[i:], m.ProofOfPossession)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProofOfPossession)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.PubKeyType) > 0 {
 		i -= len(m.PubKeyType)
 		copy(dAtA[i:], m.PubKeyType)
@@ -8393,6 +8408,10 @@ func (m *ValidatorUpdate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.ProofOfPossession)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -15896,6 +15915,40 @@ func (m *ValidatorUpdate) Unmarshal(dAtA []byte) error {
 			}
 			m.PubKeyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfPossession = append(m.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfPossession == nil {
+				m.ProofOfPossession = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
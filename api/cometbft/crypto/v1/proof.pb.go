@@ -147,6 +147,121 @@ func (m *ValueOp) GetProof() *Proof {
 	return nil
 }
 
+// MultiProof is a Merkle proof for a subset of the leaves of a tree.
+type MultiProof struct {
+	Total      int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Indices    []int64  `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	LeafHashes [][]byte `protobuf:"bytes,3,rep,name=leaf_hashes,json=leafHashes,proto3" json:"leaf_hashes,omitempty"`
+	Aunts      [][]byte `protobuf:"bytes,4,rep,name=aunts,proto3" json:"aunts,omitempty"`
+}
+
+func (m *MultiProof) Reset()         { *m = MultiProof{} }
+func (m *MultiProof) String() string { return proto.CompactTextString(m) }
+func (*MultiProof) ProtoMessage()    {}
+func (*MultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6fc6c2b7bed957e, []int{2}
+}
+func (m *MultiProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiProof.Merge(m, src)
+}
+func (m *MultiProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiProof proto.InternalMessageInfo
+
+func (m *MultiProof) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *MultiProof) GetIndices() []int64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *MultiProof) GetLeafHashes() [][]byte {
+	if m != nil {
+		return m.LeafHashes
+	}
+	return nil
+}
+
+func (m *MultiProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
+	}
+	return nil
+}
+
+// MultiValueOp is a Merkle proof for a subset of the leaves of a tree. The
+// leaves aren't keyed, so it has no key.
+type MultiValueOp struct {
+	Proof *MultiProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MultiValueOp) Reset()         { *m = MultiValueOp{} }
+func (m *MultiValueOp) String() string { return proto.CompactTextString(m) }
+func (*MultiValueOp) ProtoMessage()    {}
+func (*MultiValueOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6fc6c2b7bed957e, []int{3}
+}
+func (m *MultiValueOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiValueOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiValueOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiValueOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiValueOp.Merge(m, src)
+}
+func (m *MultiValueOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiValueOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiValueOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiValueOp proto.InternalMessageInfo
+
+func (m *MultiValueOp) GetProof() *MultiProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // DominoOp always returns the given output.
 type DominoOp struct {
 	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -158,7 +273,7 @@ func (m *DominoOp) Reset()         { *m = DominoOp{} }
 func (m *DominoOp) String() string { return proto.CompactTextString(m) }
 func (*DominoOp) ProtoMessage()    {}
 func (*DominoOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6fc6c2b7bed957e, []int{2}
+	return fileDescriptor_d6fc6c2b7bed957e, []int{4}
 }
 func (m *DominoOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,7 +336,7 @@ func (m *ProofOp) Reset()         { *m = ProofOp{} }
 func (m *ProofOp) String() string { return proto.CompactTextString(m) }
 func (*ProofOp) ProtoMessage()    {}
 func (*ProofOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6fc6c2b7bed957e, []int{3}
+	return fileDescriptor_d6fc6c2b7bed957e, []int{5}
 }
 func (m *ProofOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +395,7 @@ func (m *ProofOps) Reset()         { *m = ProofOps{} }
 func (m *ProofOps) String() string { return proto.CompactTextString(m) }
 func (*ProofOps) ProtoMessage()    {}
 func (*ProofOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6fc6c2b7bed957e, []int{4}
+	return fileDescriptor_d6fc6c2b7bed957e, []int{6}
 }
 func (m *ProofOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,6 +434,8 @@ func (m *ProofOps) GetOps() []ProofOp {
 func init() {
 	proto.RegisterType((*Proof)(nil), "cometbft.crypto.v1.Proof")
 	proto.RegisterType((*ValueOp)(nil), "cometbft.crypto.v1.ValueOp")
+	proto.RegisterType((*MultiProof)(nil), "cometbft.crypto.v1.MultiProof")
+	proto.RegisterType((*MultiValueOp)(nil), "cometbft.crypto.v1.MultiValueOp")
 	proto.RegisterType((*DominoOp)(nil), "cometbft.crypto.v1.DominoOp")
 	proto.RegisterType((*ProofOp)(nil), "cometbft.crypto.v1.ProofOp")
 	proto.RegisterType((*ProofOps)(nil), "cometbft.crypto.v1.ProofOps")
@@ -327,30 +444,34 @@ func init() {
 func init() { proto.RegisterFile("cometbft/crypto/v1/proof.proto", fileDescriptor_d6fc6c2b7bed957e) }
 
 var fileDescriptor_d6fc6c2b7bed957e = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0x6a, 0xf2, 0x40,
-	0x14, 0x4d, 0x4c, 0xfc, 0xbb, 0xba, 0xf8, 0x18, 0xe4, 0x23, 0xad, 0x30, 0x0d, 0x59, 0x65, 0x95,
-	0x41, 0x7d, 0x80, 0x82, 0xed, 0xa2, 0x94, 0x16, 0xcb, 0x2c, 0xba, 0xe8, 0xa6, 0x8c, 0x9a, 0x98,
-	0x50, 0x75, 0x06, 0x33, 0x91, 0xfa, 0x16, 0x7d, 0x2c, 0x97, 0x2e, 0xbb, 0x2a, 0x45, 0x5f, 0xa4,
-	0xcc, 0x4c, 0x44, 0x8a, 0x74, 0x77, 0xce, 0xb9, 0x77, 0xce, 0x3d, 0x97, 0x3b, 0x80, 0x27, 0x7c,
-	0x11, 0xcb, 0x71, 0x22, 0xc9, 0x64, 0xb5, 0x11, 0x92, 0x93, 0x75, 0x8f, 0x88, 0x15, 0xe7, 0x49,
-	0x24, 0x56, 0x5c, 0x72, 0x84, 0x8e, 0xf5, 0xc8, 0xd4, 0xa3, 0x75, 0xef, 0xb2, 0x33, 0xe3, 0x33,
-	0xae, 0xcb, 0x44, 0x21, 0xd3, 0x19, 0x24, 0x50, 0x7d, 0x52, 0x0f, 0x51, 0x07, 0xaa, 0x92, 0x4b,
-	0x36, 0xf7, 0x6c, 0xdf, 0x0e, 0x1d, 0x6a, 0x88, 0x52, 0xb3, 0xe5, 0x34, 0x7e, 0xf7, 0x2a, 0x46,
-	0xd5, 0x04, 0x75, 0xa1, 0x39, 0x8f, 0x59, 0xf2, 0x9a, 0xb2, 0x3c, 0xf5, 0x1c, 0xdf, 0x0e, 0xdb,
-	0xb4, 0xa1, 0x84, 0x3b, 0x96, 0xa7, 0xea, 0x09, 0x2b, 0x96, 0x32, 0xf7, 0x5c, 0xdf, 0x09, 0xdb,
-	0xd4, 0x90, 0xe0, 0x01, 0xea, 0xcf, 0x6c, 0x5e, 0xc4, 0x23, 0x81, 0xfe, 0x81, 0xf3, 0x16, 0x6f,
-	0xf4, 0x9c, 0x36, 0x55, 0x10, 0x11, 0xa8, 0xea, 0xf4, 0x7a, 0x4a, 0xab, 0x7f, 0x11, 0x9d, 0xc7,
-	0x8f, 0x74, 0x4a, 0x6a, 0xfa, 0x82, 0x7b, 0x68, 0xdc, 0xf2, 0x45, 0xb6, 0xe4, 0xbf, 0xed, 0x9a,
-	0xc6, 0x4e, 0x87, 0x16, 0x85, 0xd4, 0x76, 0x4d, 0x6a, 0x08, 0xfa, 0x0f, 0x35, 0x5e, 0x48, 0x25,
-	0x3b, 0x5a, 0x2e, 0x59, 0x70, 0x03, 0x75, 0xed, 0x3d, 0x12, 0x08, 0x81, 0x2b, 0x37, 0x22, 0x2e,
-	0xbd, 0x34, 0x3e, 0xda, 0x57, 0x4e, 0x69, 0x11, 0xb8, 0x53, 0x26, 0x59, 0xb9, 0xb8, 0xc6, 0xc1,
-	0x35, 0x34, 0x4a, 0x93, 0x1c, 0x0d, 0xc0, 0xe1, 0x22, 0xf7, 0x6c, 0xdf, 0x09, 0x5b, 0xfd, 0xee,
-	0x9f, 0xbb, 0x8c, 0xc4, 0xd0, 0xdd, 0x7e, 0x5d, 0x59, 0x54, 0x75, 0x0f, 0x1f, 0xb7, 0x7b, 0x6c,
-	0xef, 0xf6, 0xd8, 0xfe, 0xde, 0x63, 0xfb, 0xe3, 0x80, 0xad, 0xdd, 0x01, 0x5b, 0x9f, 0x07, 0x6c,
-	0xbd, 0x0c, 0x66, 0x99, 0x4c, 0x8b, 0xb1, 0xf2, 0x21, 0xa7, 0xb3, 0x1f, 0x01, 0x13, 0x19, 0x39,
-	0xff, 0x0c, 0xe3, 0x9a, 0xbe, 0xee, 0xe0, 0x27, 0x00, 0x00, 0xff, 0xff, 0x69, 0xea, 0x58, 0xaf,
-	0x29, 0x02, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xb3, 0x71, 0xd2, 0x24, 0x93, 0x1c, 0x90, 0x55, 0x21, 0x43, 0x25, 0x77, 0xb5, 0xa7,
+	0x3d, 0xed, 0xaa, 0x0d, 0x77, 0xa4, 0xd2, 0x03, 0x42, 0x54, 0x41, 0x3e, 0x70, 0xe0, 0x82, 0x9c,
+	0xc4, 0x49, 0x56, 0xa4, 0xb1, 0x15, 0x7b, 0x2b, 0xf2, 0x16, 0x3c, 0x56, 0x8f, 0x3d, 0x72, 0x42,
+	0x28, 0x79, 0x11, 0xe4, 0xf1, 0x9a, 0x08, 0x95, 0xf6, 0x36, 0xff, 0xcc, 0x78, 0xe6, 0x9b, 0xf1,
+	0x00, 0x9f, 0xe9, 0x5b, 0xe5, 0xa6, 0x0b, 0x57, 0xce, 0xb6, 0x3b, 0xe3, 0x74, 0x79, 0x77, 0x51,
+	0x9a, 0xad, 0xd6, 0x8b, 0xc2, 0x6c, 0xb5, 0xd3, 0x94, 0xc6, 0x78, 0x11, 0xe2, 0xc5, 0xdd, 0xc5,
+	0xeb, 0xd3, 0xa5, 0x5e, 0x6a, 0x0c, 0x97, 0xde, 0x0a, 0x99, 0xd9, 0x02, 0xba, 0x9f, 0xfc, 0x43,
+	0x7a, 0x0a, 0x5d, 0xa7, 0x9d, 0x5c, 0xb3, 0x24, 0x4d, 0x72, 0x22, 0x82, 0xf0, 0xde, 0x6a, 0x33,
+	0x57, 0xdf, 0x59, 0x3b, 0x78, 0x51, 0xd0, 0x33, 0x18, 0xac, 0x95, 0x5c, 0x7c, 0x5d, 0x49, 0xbb,
+	0x62, 0x24, 0x4d, 0xf2, 0x91, 0xe8, 0x7b, 0xc7, 0x7b, 0x69, 0x57, 0xfe, 0x89, 0xac, 0x37, 0xce,
+	0xb2, 0x4e, 0x4a, 0xf2, 0x91, 0x08, 0x22, 0xfb, 0x08, 0xbd, 0xcf, 0x72, 0x5d, 0xab, 0x89, 0xa1,
+	0x2f, 0x80, 0x7c, 0x53, 0x3b, 0xec, 0x33, 0x12, 0xde, 0xa4, 0x25, 0x74, 0x91, 0x1e, 0xbb, 0x0c,
+	0x2f, 0x5f, 0x15, 0x8f, 0xf1, 0x0b, 0xa4, 0x14, 0x21, 0x2f, 0xb3, 0x00, 0x37, 0xf5, 0xda, 0x55,
+	0xcf, 0xa1, 0x33, 0xe8, 0x55, 0x9b, 0x79, 0x35, 0x53, 0x96, 0xb5, 0x53, 0x92, 0x13, 0x11, 0x25,
+	0x3d, 0x87, 0xe1, 0x5f, 0x7c, 0x65, 0x19, 0x41, 0x4e, 0x88, 0x03, 0x28, 0xfb, 0xc4, 0x08, 0xd7,
+	0x30, 0xc2, 0xa6, 0x71, 0x8e, 0x37, 0x91, 0x3a, 0x41, 0x6a, 0xfe, 0x3f, 0xea, 0x23, 0x65, 0x44,
+	0xff, 0x00, 0xfd, 0x6b, 0x7d, 0x5b, 0x6d, 0xf4, 0xbf, 0x9b, 0x18, 0x84, 0x4d, 0xe0, 0xbe, 0x4d,
+	0xed, 0x70, 0x13, 0x03, 0x11, 0x04, 0x7d, 0x09, 0x27, 0xba, 0x76, 0xde, 0x4d, 0xd0, 0xdd, 0xa8,
+	0xec, 0x1d, 0xf4, 0xb0, 0xf6, 0xc4, 0x50, 0x0a, 0x1d, 0xb7, 0x33, 0xaa, 0xa9, 0x85, 0x76, 0x2c,
+	0xdf, 0x3e, 0x2e, 0x9a, 0x42, 0x67, 0x2e, 0x9d, 0x6c, 0xfe, 0x0c, 0xed, 0xec, 0x2d, 0xf4, 0x9b,
+	0x22, 0x96, 0x8e, 0x81, 0x68, 0x63, 0x59, 0x92, 0x92, 0x7c, 0x78, 0x79, 0xf6, 0xe4, 0x37, 0x4c,
+	0xcc, 0x55, 0xe7, 0xfe, 0xd7, 0x79, 0x4b, 0xf8, 0xec, 0xab, 0x9b, 0xfb, 0x3d, 0x4f, 0x1e, 0xf6,
+	0x3c, 0xf9, 0xbd, 0xe7, 0xc9, 0x8f, 0x03, 0x6f, 0x3d, 0x1c, 0x78, 0xeb, 0xe7, 0x81, 0xb7, 0xbe,
+	0x8c, 0x97, 0x95, 0x5b, 0xd5, 0x53, 0x5f, 0xa7, 0x3c, 0x5e, 0x6c, 0x34, 0xa4, 0xa9, 0xca, 0xc7,
+	0x77, 0x3c, 0x3d, 0xc1, 0xc3, 0x1c, 0xff, 0x19, 0x00, 0x83, 0x35, 0x89, 0x48, 0xe4, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Proof) Marshal() (dAtA []byte, err error) {
@@ -444,6 +565,106 @@ func (m *ValueOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MultiProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LeafHashes) > 0 {
+		for iNdEx := len(m.LeafHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeafHashes[iNdEx])
+			copy(dAtA[i:], m.LeafHashes[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.LeafHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA3 := make([]byte, len(m.Indices)*10)
+		var j2 int
+		for _, num1 := range m.Indices {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintProof(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Total != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiValueOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiValueOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiValueOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DominoOp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -622,6 +843,50 @@ func (m *ValueOp) Size() (n int) {
 	return n
 }
 
+func (m *MultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovProof(uint64(m.Total))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovProof(uint64(e))
+		}
+		n += 1 + sovProof(uint64(l)) + l
+	}
+	if len(m.LeafHashes) > 0 {
+		for _, b := range m.LeafHashes {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiValueOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
 func (m *DominoOp) Size() (n int) {
 	if m == nil {
 		return 0
@@ -959,6 +1224,301 @@ func (m *ValueOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MultiProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHashes = append(m.LeafHashes, make([]byte, postIndex-iNdEx))
+			copy(m.LeafHashes[len(m.LeafHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiValueOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiValueOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiValueOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &MultiProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DominoOp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
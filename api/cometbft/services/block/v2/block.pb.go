@@ -206,11 +206,115 @@ func (m *GetLatestHeightResponse) GetHeight() int64 {
 	return 0
 }
 
+// GetValidatorsRequest is a request for the validator set at the specified
+// height.
+type GetValidatorsRequest struct {
+	// The height of the validator set requested. The validator set at the latest
+	// committed height is returned if 0.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetValidatorsRequest) Reset()         { *m = GetValidatorsRequest{} }
+func (m *GetValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorsRequest) ProtoMessage()    {}
+func (*GetValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4818f43c6b99905f, []int{4}
+}
+func (m *GetValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorsRequest.Merge(m, src)
+}
+func (m *GetValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorsRequest proto.InternalMessageInfo
+
+func (m *GetValidatorsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetValidatorsResponse contains the validator set at the specified height.
+type GetValidatorsResponse struct {
+	// The height of the validator set.
+	Height       int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ValidatorSet *v2.ValidatorSet `protobuf:"bytes,2,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (m *GetValidatorsResponse) Reset()         { *m = GetValidatorsResponse{} }
+func (m *GetValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorsResponse) ProtoMessage()    {}
+func (*GetValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4818f43c6b99905f, []int{5}
+}
+func (m *GetValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorsResponse.Merge(m, src)
+}
+func (m *GetValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorsResponse proto.InternalMessageInfo
+
+func (m *GetValidatorsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetValidatorsResponse) GetValidatorSet() *v2.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetByHeightRequest)(nil), "cometbft.services.block.v2.GetByHeightRequest")
 	proto.RegisterType((*GetByHeightResponse)(nil), "cometbft.services.block.v2.GetByHeightResponse")
 	proto.RegisterType((*GetLatestHeightRequest)(nil), "cometbft.services.block.v2.GetLatestHeightRequest")
 	proto.RegisterType((*GetLatestHeightResponse)(nil), "cometbft.services.block.v2.GetLatestHeightResponse")
+	proto.RegisterType((*GetValidatorsRequest)(nil), "cometbft.services.block.v2.GetValidatorsRequest")
+	proto.RegisterType((*GetValidatorsResponse)(nil), "cometbft.services.block.v2.GetValidatorsResponse")
 }
 
 func init() {
@@ -218,24 +322,28 @@ func init() {
 }
 
 var fileDescriptor_4818f43c6b99905f = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0xcf, 0x4d,
-	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0xca,
-	0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0x82, 0x30, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
-	0x60, 0xea, 0xf4, 0x60, 0xea, 0xf4, 0x20, 0xd2, 0x65, 0x46, 0x52, 0xb2, 0x70, 0x33, 0x4a, 0x2a,
-	0x0b, 0x52, 0x8b, 0x41, 0x5a, 0xc1, 0x0c, 0x88, 0x56, 0x6c, 0xd2, 0x48, 0x26, 0x2b, 0xe9, 0x70,
-	0x09, 0xb9, 0xa7, 0x96, 0x38, 0x55, 0x7a, 0xa4, 0x66, 0xa6, 0x67, 0x94, 0x04, 0xa5, 0x16, 0x96,
-	0xa6, 0x16, 0x97, 0x08, 0x89, 0x71, 0xb1, 0x65, 0x80, 0x05, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98,
-	0x83, 0xa0, 0x3c, 0xa5, 0x1a, 0x2e, 0x61, 0x14, 0xd5, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42,
-	0xa6, 0x5c, 0x1c, 0x60, 0x33, 0xe3, 0x33, 0x53, 0xc0, 0x1a, 0xb8, 0x8d, 0xa4, 0xf4, 0xe0, 0x2e,
-	0x86, 0x38, 0xa6, 0xcc, 0x48, 0xcf, 0x09, 0xa4, 0xc4, 0xd3, 0x25, 0x88, 0x1d, 0xac, 0xd6, 0x33,
-	0x45, 0x48, 0x8f, 0x8b, 0x15, 0xcc, 0x94, 0x60, 0x02, 0xeb, 0x91, 0xc0, 0xa5, 0x27, 0x08, 0xa2,
-	0x4c, 0x49, 0x82, 0x4b, 0xcc, 0x3d, 0xb5, 0xc4, 0x27, 0xb1, 0x24, 0xb5, 0xb8, 0x04, 0xc5, 0xbd,
-	0x4a, 0x86, 0x5c, 0xe2, 0x18, 0x32, 0x50, 0xb7, 0xe1, 0xf0, 0x8a, 0x53, 0xe8, 0x89, 0x47, 0x72,
-	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
-	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0x81, 0x5c,
-	0xa3, 0x0f, 0x0f, 0x3c, 0x38, 0x23, 0xb1, 0x20, 0x53, 0x1f, 0x77, 0xac, 0x25, 0xb1, 0x81, 0x83,
-	0xd5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3a, 0x44, 0x9f, 0xda, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4e, 0x3a, 0x31,
+	0x10, 0xc6, 0xd9, 0xff, 0x3f, 0xa2, 0xa9, 0x7a, 0xa9, 0x8a, 0x9b, 0x4d, 0xac, 0xba, 0x07, 0xe3,
+	0xc1, 0x74, 0xe3, 0x1a, 0x4f, 0xde, 0x08, 0x09, 0x92, 0x78, 0x5a, 0xa3, 0x07, 0x2f, 0x64, 0x17,
+	0x46, 0x68, 0x44, 0xbb, 0xd2, 0xa1, 0x09, 0x89, 0x0f, 0xe1, 0x63, 0x79, 0xe4, 0xe8, 0xd1, 0xc0,
+	0x8b, 0x18, 0x5a, 0x68, 0x40, 0x40, 0x6f, 0xd3, 0x9d, 0xdf, 0xf7, 0x75, 0xbe, 0xce, 0x92, 0x93,
+	0x86, 0x7c, 0x06, 0xcc, 0x1e, 0x31, 0x52, 0xd0, 0xd5, 0xa2, 0x01, 0x2a, 0xca, 0x3a, 0xb2, 0xf1,
+	0x14, 0xe9, 0xd8, 0x16, 0x3c, 0xef, 0x4a, 0x94, 0x34, 0x98, 0x72, 0x7c, 0xca, 0x71, 0xdb, 0xd6,
+	0x71, 0x70, 0xe0, 0x3c, 0xb0, 0x9f, 0x83, 0x1a, 0x4b, 0x4d, 0x61, 0xa5, 0xcb, 0xda, 0x33, 0xce,
+	0xc1, 0xf1, 0x62, 0x5b, 0xa7, 0x1d, 0xd1, 0x4c, 0x51, 0x76, 0x2d, 0x12, 0x9e, 0x11, 0x5a, 0x05,
+	0x2c, 0xf7, 0xaf, 0x41, 0xb4, 0xda, 0x98, 0xc0, 0x6b, 0x0f, 0x14, 0xd2, 0x12, 0x29, 0xb6, 0xcd,
+	0x07, 0xdf, 0x3b, 0xf2, 0x4e, 0xff, 0x27, 0x93, 0x53, 0xf8, 0x46, 0x76, 0xe6, 0x68, 0x95, 0xcb,
+	0x17, 0x05, 0xf4, 0x92, 0x6c, 0x98, 0x6b, 0xeb, 0xa2, 0x69, 0x04, 0x9b, 0x71, 0xc0, 0x5d, 0x28,
+	0x3b, 0xaf, 0x8e, 0x79, 0x79, 0x8c, 0xd4, 0x2a, 0xc9, 0xba, 0x61, 0x6b, 0x4d, 0xca, 0xc9, 0x9a,
+	0x29, 0xfd, 0x7f, 0x46, 0xe3, 0xaf, 0xd2, 0x24, 0x16, 0x0b, 0x7d, 0x52, 0xaa, 0x02, 0xde, 0xa4,
+	0x08, 0x0a, 0xe7, 0xe6, 0x0d, 0xcf, 0xc9, 0xfe, 0x42, 0x67, 0x32, 0xdb, 0xaa, 0x28, 0x9c, 0xec,
+	0x56, 0x01, 0xef, 0xa7, 0xcf, 0xa1, 0xfe, 0x8a, 0xde, 0x23, 0x7b, 0x3f, 0xf8, 0xdf, 0x2f, 0xa0,
+	0x15, 0xb2, 0xed, 0x1e, 0xbb, 0xae, 0x00, 0x27, 0x29, 0x0f, 0x97, 0xa4, 0x74, 0xae, 0xb7, 0x80,
+	0xc9, 0x96, 0x9e, 0x39, 0x95, 0xef, 0x3e, 0x86, 0xcc, 0x1b, 0x0c, 0x99, 0xf7, 0x35, 0x64, 0xde,
+	0xfb, 0x88, 0x15, 0x06, 0x23, 0x56, 0xf8, 0x1c, 0xb1, 0xc2, 0xc3, 0x55, 0x4b, 0x60, 0xbb, 0x97,
+	0x8d, 0xed, 0x22, 0xb7, 0x67, 0x57, 0xa4, 0xb9, 0x88, 0x56, 0xff, 0x7f, 0x59, 0xd1, 0x6c, 0xff,
+	0xe2, 0x7b, 0x00, 0x30, 0x9c, 0x2d, 0xdf, 0xa4, 0x02, 0x00, 0x00,
 }
 
 func (m *GetByHeightRequest) Marshal() (dAtA []byte, err error) {
@@ -364,6 +472,74 @@ func (m *GetLatestHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GetValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlock(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlock(v)
 	base := offset
@@ -425,6 +601,34 @@ func (m *GetLatestHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlock(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlock(uint64(m.Height))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
 func sovBlock(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -741,6 +945,180 @@ func (m *GetLatestHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &v2.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlock(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
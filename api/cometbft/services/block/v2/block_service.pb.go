@@ -30,21 +30,23 @@ func init() {
 }
 
 var fileDescriptor_25e6c37400d36016 = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
+	// 247 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xce, 0xcf, 0x4d,
 	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0xca,
 	0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0x82, 0x30, 0xe2, 0xa1, 0xe2, 0x7a, 0x05, 0x45, 0xf9, 0x25,
 	0xf9, 0x42, 0x52, 0x30, 0xf5, 0x7a, 0x30, 0xf5, 0x7a, 0x60, 0x65, 0x7a, 0x65, 0x46, 0x52, 0x6a,
-	0x84, 0xcc, 0x82, 0x98, 0x61, 0xf4, 0x87, 0x91, 0x8b, 0xc7, 0x09, 0xc4, 0x0f, 0x86, 0x28, 0x13,
+	0x84, 0xcc, 0x82, 0x98, 0x61, 0xf4, 0x89, 0x89, 0x8b, 0xc7, 0x09, 0xc4, 0x0f, 0x86, 0x28, 0x13,
 	0xca, 0xe3, 0xe2, 0x76, 0x4f, 0x2d, 0x71, 0xaa, 0xf4, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x11, 0xd2,
 	0xd3, 0xc3, 0x6d, 0x89, 0x1e, 0x92, 0xc2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x29, 0x7d,
 	0xa2, 0xd5, 0x17, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0xd5, 0x70, 0xf1, 0xbb, 0xa7, 0x96, 0xf8,
 	0x24, 0x96, 0xa4, 0x16, 0x97, 0x40, 0xed, 0x34, 0x22, 0x60, 0x06, 0xb2, 0x62, 0x98, 0xbd, 0xc6,
-	0x24, 0xe9, 0x81, 0xd8, 0x6d, 0xc0, 0xe8, 0x14, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xd6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x20, 0x63, 0xf5, 0xe1, 0x61, 0x09,
-	0x67, 0x24, 0x16, 0x64, 0xea, 0xe3, 0x0e, 0xe1, 0x24, 0x36, 0x70, 0xe0, 0x1a, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x0b, 0xfb, 0x4c, 0xa6, 0xd2, 0x01, 0x00, 0x00,
+	0x24, 0xe9, 0x81, 0xd8, 0x6d, 0xc0, 0x28, 0x54, 0xc2, 0xc5, 0xeb, 0x9e, 0x5a, 0x12, 0x96, 0x98,
+	0x93, 0x99, 0x92, 0x58, 0x92, 0x5f, 0x54, 0x2c, 0x64, 0x40, 0xc0, 0x1c, 0x84, 0x52, 0x98, 0xcd,
+	0x86, 0x24, 0xe8, 0x80, 0xd8, 0xeb, 0x14, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
+	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
+	0x0c, 0x51, 0xd6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x20, 0x23, 0xf5, 0xe1, 0x31, 0x08, 0x67,
+	0x24, 0x16, 0x64, 0xea, 0xe3, 0x8e, 0xd7, 0x24, 0x36, 0x70, 0x94, 0x1a, 0x03, 0x06, 0x00, 0xfd,
+	0x3c, 0xdd, 0x70, 0x48, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -66,6 +68,8 @@ type BlockServiceClient interface {
 	// server if an error occurs. The caller is expected to handle such
 	// disconnections and automatically reconnect.
 	GetLatestHeight(ctx context.Context, in *GetLatestHeightRequest, opts ...grpc.CallOption) (BlockService_GetLatestHeightClient, error)
+	// GetValidators retrieves the validator set at a particular height.
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error)
 }
 
 type blockServiceClient struct {
@@ -117,6 +121,15 @@ func (x *blockServiceGetLatestHeightClient) Recv() (*GetLatestHeightResponse, er
 	return m, nil
 }
 
+func (c *blockServiceClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error) {
+	out := new(GetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.block.v2.BlockService/GetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockServiceServer is the server API for BlockService service.
 type BlockServiceServer interface {
 	// GetBlock retrieves the block information at a particular height.
@@ -126,6 +139,8 @@ type BlockServiceServer interface {
 	// server if an error occurs. The caller is expected to handle such
 	// disconnections and automatically reconnect.
 	GetLatestHeight(*GetLatestHeightRequest, BlockService_GetLatestHeightServer) error
+	// GetValidators retrieves the validator set at a particular height.
+	GetValidators(context.Context, *GetValidatorsRequest) (*GetValidatorsResponse, error)
 }
 
 // UnimplementedBlockServiceServer can be embedded to have forward compatible implementations.
@@ -138,6 +153,9 @@ func (*UnimplementedBlockServiceServer) GetByHeight(ctx context.Context, req *Ge
 func (*UnimplementedBlockServiceServer) GetLatestHeight(req *GetLatestHeightRequest, srv BlockService_GetLatestHeightServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetLatestHeight not implemented")
 }
+func (*UnimplementedBlockServiceServer) GetValidators(ctx context.Context, req *GetValidatorsRequest) (*GetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+}
 
 func RegisterBlockServiceServer(s grpc1.Server, srv BlockServiceServer) {
 	s.RegisterService(&_BlockService_serviceDesc, srv)
@@ -182,6 +200,24 @@ func (x *blockServiceGetLatestHeightServer) Send(m *GetLatestHeightResponse) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _BlockService_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).GetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.block.v2.BlockService/GetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).GetValidators(ctx, req.(*GetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var BlockService_serviceDesc = _BlockService_serviceDesc
 var _BlockService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cometbft.services.block.v2.BlockService",
@@ -191,6 +227,10 @@ var _BlockService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetByHeight",
 			Handler:    _BlockService_GetByHeight_Handler,
 		},
+		{
+			MethodName: "GetValidators",
+			Handler:    _BlockService_GetValidators_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
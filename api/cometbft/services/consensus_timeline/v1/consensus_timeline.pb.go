@@ -0,0 +1,2119 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/consensus_timeline/v1/consensus_timeline.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	v2 "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetTimelineRequest is a request for the consensus timeline of a height.
+type GetTimelineRequest struct {
+	// The height of the timeline requested. If 0, the timeline of the height
+	// the node is currently deciding is returned.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetTimelineRequest) Reset()         { *m = GetTimelineRequest{} }
+func (m *GetTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTimelineRequest) ProtoMessage()    {}
+func (*GetTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ddcb9987a00ed6, []int{0}
+}
+func (m *GetTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimelineRequest.Merge(m, src)
+}
+func (m *GetTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTimelineRequest proto.InternalMessageInfo
+
+func (m *GetTimelineRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetTimelineResponse contains the consensus timeline of the requested height.
+type GetTimelineResponse struct {
+	Timeline *HeightTimeline `protobuf:"bytes,1,opt,name=timeline,proto3" json:"timeline,omitempty"`
+}
+
+func (m *GetTimelineResponse) Reset()         { *m = GetTimelineResponse{} }
+func (m *GetTimelineResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTimelineResponse) ProtoMessage()    {}
+func (*GetTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ddcb9987a00ed6, []int{1}
+}
+func (m *GetTimelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTimelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimelineResponse.Merge(m, src)
+}
+func (m *GetTimelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTimelineResponse proto.InternalMessageInfo
+
+func (m *GetTimelineResponse) GetTimeline() *HeightTimeline {
+	if m != nil {
+		return m.Timeline
+	}
+	return nil
+}
+
+// HeightTimeline records when the node reached the milestones of a height.
+// Times are taken from the local clock of the node; an unset time means the
+// milestone was not reached.
+type HeightTimeline struct {
+	Height      int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	StartTime   time.Time           `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	CommitRound int32               `protobuf:"varint,3,opt,name=commit_round,json=commitRound,proto3" json:"commit_round,omitempty"`
+	CommitTime  time.Time           `protobuf:"bytes,4,opt,name=commit_time,json=commitTime,proto3,stdtime" json:"commit_time"`
+	Rounds      []*RoundTimeline    `protobuf:"bytes,5,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	AbciCalls   []*ABCICallTimeline `protobuf:"bytes,6,rep,name=abci_calls,json=abciCalls,proto3" json:"abci_calls,omitempty"`
+}
+
+func (m *HeightTimeline) Reset()         { *m = HeightTimeline{} }
+func (m *HeightTimeline) String() string { return proto.CompactTextString(m) }
+func (*HeightTimeline) ProtoMessage()    {}
+func (*HeightTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ddcb9987a00ed6, []int{2}
+}
+func (m *HeightTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeightTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightTimeline.Merge(m, src)
+}
+func (m *HeightTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightTimeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightTimeline proto.InternalMessageInfo
+
+func (m *HeightTimeline) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HeightTimeline) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *HeightTimeline) GetCommitRound() int32 {
+	if m != nil {
+		return m.CommitRound
+	}
+	return 0
+}
+
+func (m *HeightTimeline) GetCommitTime() time.Time {
+	if m != nil {
+		return m.CommitTime
+	}
+	return time.Time{}
+}
+
+func (m *HeightTimeline) GetRounds() []*RoundTimeline {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+func (m *HeightTimeline) GetAbciCalls() []*ABCICallTimeline {
+	if m != nil {
+		return m.AbciCalls
+	}
+	return nil
+}
+
+// RoundTimeline records when the node reached the milestones of a round.
+type RoundTimeline struct {
+	Round                int32          `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	StartTime            time.Time      `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	ProposalTime         time.Time      `protobuf:"bytes,3,opt,name=proposal_time,json=proposalTime,proto3,stdtime" json:"proposal_time"`
+	ProposalCompleteTime time.Time      `protobuf:"bytes,4,opt,name=proposal_complete_time,json=proposalCompleteTime,proto3,stdtime" json:"proposal_complete_time"`
+	PrevotesAnyTime      time.Time      `protobuf:"bytes,5,opt,name=prevotes_any_time,json=prevotesAnyTime,proto3,stdtime" json:"prevotes_any_time"`
+	PrevotesMaj23Time    time.Time      `protobuf:"bytes,6,opt,name=prevotes_maj23_time,json=prevotesMaj23Time,proto3,stdtime" json:"prevotes_maj23_time"`
+	PrecommitsAnyTime    time.Time      `protobuf:"bytes,7,opt,name=precommits_any_time,json=precommitsAnyTime,proto3,stdtime" json:"precommits_any_time"`
+	PrecommitsMaj23Time  time.Time      `protobuf:"bytes,8,opt,name=precommits_maj23_time,json=precommitsMaj23Time,proto3,stdtime" json:"precommits_maj23_time"`
+	Votes                []*VoteArrival `protobuf:"bytes,9,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *RoundTimeline) Reset()         { *m = RoundTimeline{} }
+func (m *RoundTimeline) String() string { return proto.CompactTextString(m) }
+func (*RoundTimeline) ProtoMessage()    {}
+func (*RoundTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ddcb9987a00ed6, []int{3}
+}
+func (m *RoundTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoundTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundTimeline.Merge(m, src)
+}
+func (m *RoundTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundTimeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundTimeline proto.InternalMessageInfo
+
+func (m *RoundTimeline) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *RoundTimeline) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *RoundTimeline) GetProposalTime() time.Time {
+	if m != nil {
+		return m.ProposalTime
+	}
+	return time.Time{}
+}
+
+func (m *RoundTimeline) GetProposalCompleteTime() time.Time {
+	if m != nil {
+		return m.ProposalCompleteTime
+	}
+	return time.Time{}
+}
+
+func (m *RoundTimeline) GetPrevotesAnyTime() time.Time {
+	if m != nil {
+		return m.PrevotesAnyTime
+	}
+	return time.Time{}
+}
+
+func (m *RoundTimeline) GetPrevotesMaj23Time() time.Time {
+	if m != nil {
+		return m.PrevotesMaj23Time
+	}
+	return time.Time{}
+}
+
+func (m *RoundTimeline) GetPrecommitsAnyTime() time.Time {
+	if m != nil {
+		return m.PrecommitsAnyTime
+	}
+	return time.Time{}
+}
+
+func (m *RoundTimeline) GetPrecommitsMaj23Time() time.Time {
+	if m != nil {
+		return m.PrecommitsMaj23Time
+	}
+	return time.Time{}
+}
+
+func (m *RoundTimeline) GetVotes() []*VoteArrival {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+// VoteArrival records when a vote was added to the vote set of its round.
+type VoteArrival struct {
+	Type             v2.SignedMsgType `protobuf:"varint,1,opt,name=type,proto3,enum=cometbft.types.v2.SignedMsgType" json:"type,omitempty"`
+	ValidatorIndex   int32            `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	ValidatorAddress []byte           `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Time             time.Time        `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *VoteArrival) Reset()         { *m = VoteArrival{} }
+func (m *VoteArrival) String() string { return proto.CompactTextString(m) }
+func (*VoteArrival) ProtoMessage()    {}
+func (*VoteArrival) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ddcb9987a00ed6, []int{4}
+}
+func (m *VoteArrival) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteArrival) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteArrival.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteArrival) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteArrival.Merge(m, src)
+}
+func (m *VoteArrival) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteArrival) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteArrival.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteArrival proto.InternalMessageInfo
+
+func (m *VoteArrival) GetType() v2.SignedMsgType {
+	if m != nil {
+		return m.Type
+	}
+	return v2.UnknownType
+}
+
+func (m *VoteArrival) GetValidatorIndex() int32 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *VoteArrival) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *VoteArrival) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// ABCICallTimeline records how long an ABCI call to the application took.
+type ABCICallTimeline struct {
+	Method    string        `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Round     int32         `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	StartTime time.Time     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	Duration  time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *ABCICallTimeline) Reset()         { *m = ABCICallTimeline{} }
+func (m *ABCICallTimeline) String() string { return proto.CompactTextString(m) }
+func (*ABCICallTimeline) ProtoMessage()    {}
+func (*ABCICallTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ddcb9987a00ed6, []int{5}
+}
+func (m *ABCICallTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ABCICallTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ABCICallTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ABCICallTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABCICallTimeline.Merge(m, src)
+}
+func (m *ABCICallTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABCICallTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABCICallTimeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABCICallTimeline proto.InternalMessageInfo
+
+func (m *ABCICallTimeline) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ABCICallTimeline) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ABCICallTimeline) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ABCICallTimeline) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GetTimelineRequest)(nil), "cometbft.services.consensus_timeline.v1.GetTimelineRequest")
+	proto.RegisterType((*GetTimelineResponse)(nil), "cometbft.services.consensus_timeline.v1.GetTimelineResponse")
+	proto.RegisterType((*HeightTimeline)(nil), "cometbft.services.consensus_timeline.v1.HeightTimeline")
+	proto.RegisterType((*RoundTimeline)(nil), "cometbft.services.consensus_timeline.v1.RoundTimeline")
+	proto.RegisterType((*VoteArrival)(nil), "cometbft.services.consensus_timeline.v1.VoteArrival")
+	proto.RegisterType((*ABCICallTimeline)(nil), "cometbft.services.consensus_timeline.v1.ABCICallTimeline")
+}
+
+func init() {
+	proto.RegisterFile("cometbft/services/consensus_timeline/v1/consensus_timeline.proto", fileDescriptor_73ddcb9987a00ed6)
+}
+
+var fileDescriptor_73ddcb9987a00ed6 = []byte{
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xa6, 0xce, 0x2f, 0x99, 0xf4, 0xaf, 0xdb, 0x5f, 0x15, 0x2a, 0xe1, 0x86, 0x5c,
+	0x5a, 0x09, 0x64, 0xab, 0x69, 0xc5, 0x9f, 0x13, 0xa4, 0x01, 0xd1, 0x22, 0x15, 0x21, 0x37, 0x42,
+	0x55, 0x2f, 0xd1, 0xc6, 0xde, 0x3a, 0xae, 0x6c, 0xaf, 0xf1, 0x6e, 0x2c, 0x72, 0xe4, 0x0d, 0x7a,
+	0xe4, 0x45, 0x78, 0x05, 0xd4, 0x63, 0x25, 0x2e, 0x9c, 0x00, 0xb5, 0x2f, 0x82, 0xbc, 0x6b, 0x3b,
+	0x49, 0x69, 0xa5, 0x18, 0x71, 0xdb, 0x9d, 0x9d, 0xf9, 0xcc, 0x77, 0x76, 0x67, 0x77, 0xe1, 0x85,
+	0x49, 0x3c, 0xcc, 0x7a, 0xa7, 0x4c, 0xa7, 0x38, 0x8c, 0x1c, 0x13, 0x53, 0xdd, 0x24, 0x3e, 0xc5,
+	0x3e, 0x1d, 0xd0, 0x2e, 0x73, 0x3c, 0xec, 0x3a, 0x3e, 0xd6, 0xa3, 0xed, 0x5b, 0xac, 0x5a, 0x10,
+	0x12, 0x46, 0x94, 0xcd, 0x94, 0xa0, 0xa5, 0x04, 0xed, 0x16, 0xdf, 0x68, 0x7b, 0xfd, 0x7e, 0x96,
+	0x8a, 0x0d, 0x03, 0x4c, 0xf5, 0xa8, 0x29, 0x06, 0x82, 0xb3, 0xbe, 0x6a, 0x13, 0x9b, 0xf0, 0xa1,
+	0x1e, 0x8f, 0x12, 0xab, 0x6a, 0x13, 0x62, 0xbb, 0x58, 0xe7, 0xb3, 0xde, 0xe0, 0x54, 0xb7, 0x06,
+	0x21, 0x62, 0x0e, 0xf1, 0x93, 0xf5, 0x8d, 0x9b, 0xeb, 0x71, 0x46, 0xca, 0x90, 0x17, 0x08, 0x87,
+	0xc6, 0x23, 0x50, 0x5e, 0x63, 0xd6, 0x49, 0x74, 0x18, 0xf8, 0xc3, 0x00, 0x53, 0xa6, 0xac, 0x41,
+	0xa9, 0x8f, 0x1d, 0xbb, 0xcf, 0x6a, 0x52, 0x5d, 0xda, 0x2a, 0x1a, 0xc9, 0xac, 0x71, 0x06, 0x2b,
+	0x13, 0xde, 0x34, 0x88, 0x4b, 0x51, 0x8e, 0xa0, 0x9c, 0x56, 0xc2, 0x03, 0xaa, 0xcd, 0x27, 0xda,
+	0x94, 0x65, 0x6b, 0xfb, 0x9c, 0x9c, 0x21, 0x33, 0x50, 0xe3, 0x53, 0x11, 0x16, 0x26, 0x17, 0xef,
+	0x92, 0xa5, 0xb4, 0x01, 0x28, 0x43, 0x21, 0xe3, 0xe0, 0xda, 0x0c, 0x57, 0xb0, 0xae, 0x89, 0xd2,
+	0xb5, 0xb4, 0x74, 0xad, 0x93, 0x96, 0xbe, 0x57, 0xbe, 0xf8, 0xb1, 0x51, 0x38, 0xff, 0xb9, 0x21,
+	0x19, 0x15, 0x1e, 0x17, 0xaf, 0x28, 0x0f, 0x60, 0xce, 0x24, 0x9e, 0xe7, 0xb0, 0x6e, 0x48, 0x06,
+	0xbe, 0x55, 0x2b, 0xd6, 0xa5, 0x2d, 0xd9, 0xa8, 0x0a, 0x9b, 0x11, 0x9b, 0x94, 0x57, 0x90, 0x4c,
+	0x45, 0xa2, 0xd9, 0x1c, 0x89, 0x40, 0x04, 0xf2, 0x4c, 0x6f, 0xa1, 0xc4, 0x53, 0xd0, 0x9a, 0x5c,
+	0x2f, 0x6e, 0x55, 0x9b, 0x8f, 0xa7, 0xde, 0x2c, 0x2e, 0x23, 0xdb, 0xab, 0x84, 0xa2, 0x1c, 0x03,
+	0xa0, 0x9e, 0xe9, 0x74, 0x4d, 0xe4, 0xba, 0xb4, 0x56, 0xe2, 0xcc, 0x67, 0x53, 0x33, 0x5b, 0x7b,
+	0xed, 0x83, 0x36, 0x72, 0xdd, 0x0c, 0x5b, 0x89, 0x61, 0xb1, 0x85, 0x36, 0xbe, 0xc8, 0x30, 0x3f,
+	0x91, 0x53, 0x59, 0x05, 0x59, 0x6c, 0x8f, 0xc4, 0xb7, 0x47, 0x4c, 0xfe, 0xcd, 0x01, 0x1c, 0xc0,
+	0x7c, 0x10, 0x92, 0x80, 0x50, 0xe4, 0x0a, 0x4e, 0x31, 0x07, 0x67, 0x2e, 0x0d, 0xe5, 0xa8, 0x13,
+	0x58, 0xcb, 0x50, 0x26, 0xf1, 0x02, 0x17, 0x33, 0x9c, 0xff, 0xcc, 0x56, 0x53, 0x46, 0x3b, 0x41,
+	0x70, 0xf6, 0x3b, 0x58, 0x0e, 0x42, 0x1c, 0x11, 0x86, 0x69, 0x17, 0xf9, 0x43, 0x81, 0x95, 0x73,
+	0x60, 0x17, 0xd3, 0xf0, 0x96, 0x3f, 0xe4, 0xc4, 0x0e, 0xac, 0x64, 0x44, 0x0f, 0x9d, 0x35, 0x77,
+	0x04, 0xb3, 0x94, 0x83, 0x99, 0x49, 0x3a, 0x8c, 0xe3, 0xc7, 0xa8, 0xa2, 0xed, 0xc6, 0x94, 0xfe,
+	0x97, 0x93, 0x9a, 0x00, 0x52, 0xad, 0xc7, 0xf0, 0xff, 0x18, 0x75, 0x4c, 0x6d, 0x39, 0x07, 0x77,
+	0x4c, 0xd8, 0x48, 0xef, 0x1b, 0x90, 0x79, 0x05, 0xb5, 0x0a, 0x6f, 0xe0, 0xdd, 0xa9, 0x1b, 0xf8,
+	0x3d, 0x61, 0xb8, 0x15, 0x86, 0x4e, 0x84, 0x5c, 0x43, 0x20, 0x1a, 0xdf, 0x24, 0xa8, 0x8e, 0x99,
+	0x95, 0x5d, 0x98, 0x8d, 0xdf, 0x52, 0xde, 0xb4, 0x0b, 0xcd, 0xfa, 0x08, 0x2d, 0x5e, 0xd8, 0xa8,
+	0xa9, 0x1d, 0x39, 0xb6, 0x8f, 0xad, 0x43, 0x6a, 0x77, 0x86, 0x01, 0x36, 0xb8, 0xb7, 0xb2, 0x09,
+	0x8b, 0x11, 0x72, 0x1d, 0x0b, 0x31, 0x12, 0x76, 0x1d, 0xdf, 0xc2, 0x1f, 0x79, 0x6b, 0xcb, 0xc6,
+	0x42, 0x66, 0x3e, 0x88, 0xad, 0xca, 0x43, 0x58, 0x1e, 0x39, 0x22, 0xcb, 0x0a, 0x31, 0xa5, 0xbc,
+	0x7b, 0xe7, 0x8c, 0xa5, 0x6c, 0xa1, 0x25, 0xec, 0xca, 0x53, 0x98, 0xcd, 0xdd, 0x89, 0x3c, 0xa2,
+	0xf1, 0x55, 0x82, 0xa5, 0x9b, 0xb7, 0x35, 0x7e, 0x13, 0x3d, 0xcc, 0xfa, 0x44, 0xdc, 0xc8, 0x8a,
+	0x91, 0xcc, 0x46, 0x17, 0x75, 0xe6, 0xee, 0x8b, 0x5a, 0xfc, 0xbb, 0x8b, 0xfa, 0x1c, 0xca, 0xe9,
+	0x37, 0x93, 0x54, 0x71, 0xef, 0x0f, 0xc4, 0xcb, 0xc4, 0x41, 0x10, 0x3e, 0xc7, 0x84, 0x2c, 0x68,
+	0xaf, 0x77, 0x71, 0xa5, 0x4a, 0x97, 0x57, 0xaa, 0xf4, 0xeb, 0x4a, 0x95, 0xce, 0xaf, 0xd5, 0xc2,
+	0xe5, 0xb5, 0x5a, 0xf8, 0x7e, 0xad, 0x16, 0x4e, 0xf6, 0x6d, 0x87, 0xf5, 0x07, 0xbd, 0xf8, 0x80,
+	0xf4, 0xec, 0x3f, 0xcc, 0x06, 0x28, 0x70, 0xf4, 0x29, 0x3f, 0xe4, 0x5e, 0x89, 0x4b, 0xd9, 0xf9,
+	0x3d, 0x00, 0xd4, 0x03, 0xe5, 0x8c, 0xc2, 0x07, 0x00, 0x00,
+}
+
+func (m *GetTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTimelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTimelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTimelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeline != nil {
+		{
+			size, err := m.Timeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConsensusTimeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeightTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AbciCalls) > 0 {
+		for iNdEx := len(m.AbciCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AbciCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsensusTimeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Rounds) > 0 {
+		for iNdEx := len(m.Rounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsensusTimeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.CommitRound != 0 {
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(m.CommitRound))
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsensusTimeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PrecommitsMaj23Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrecommitsMaj23Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PrecommitsAnyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrecommitsAnyTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PrevotesMaj23Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrevotesMaj23Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PrevotesAnyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrevotesAnyTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ProposalCompleteTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposalCompleteTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ProposalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposalTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if m.Round != 0 {
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteArrival) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteArrival) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteArrival) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ABCICallTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABCICallTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABCICallTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintConsensusTimeline(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintConsensusTimeline(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConsensusTimeline(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConsensusTimeline(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovConsensusTimeline(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetTimelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timeline != nil {
+		l = m.Timeline.Size()
+		n += 1 + l + sovConsensusTimeline(uint64(l))
+	}
+	return n
+}
+
+func (m *HeightTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovConsensusTimeline(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	if m.CommitRound != 0 {
+		n += 1 + sovConsensusTimeline(uint64(m.CommitRound))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	if len(m.Rounds) > 0 {
+		for _, e := range m.Rounds {
+			l = e.Size()
+			n += 1 + l + sovConsensusTimeline(uint64(l))
+		}
+	}
+	if len(m.AbciCalls) > 0 {
+		for _, e := range m.AbciCalls {
+			l = e.Size()
+			n += 1 + l + sovConsensusTimeline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoundTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovConsensusTimeline(uint64(m.Round))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposalTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposalCompleteTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrevotesAnyTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrevotesMaj23Time)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrecommitsAnyTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PrecommitsMaj23Time)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovConsensusTimeline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteArrival) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovConsensusTimeline(uint64(m.Type))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovConsensusTimeline(uint64(m.ValidatorIndex))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovConsensusTimeline(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	return n
+}
+
+func (m *ABCICallTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovConsensusTimeline(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovConsensusTimeline(uint64(m.Round))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovConsensusTimeline(uint64(l))
+	return n
+}
+
+func sovConsensusTimeline(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConsensusTimeline(x uint64) (n int) {
+	return sovConsensusTimeline(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetTimelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusTimeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTimelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusTimeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTimelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusTimeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTimelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTimelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeline == nil {
+				m.Timeline = &HeightTimeline{}
+			}
+			if err := m.Timeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusTimeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusTimeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRound", wireType)
+			}
+			m.CommitRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rounds = append(m.Rounds, &RoundTimeline{})
+			if err := m.Rounds[len(m.Rounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbciCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbciCalls = append(m.AbciCalls, &ABCICallTimeline{})
+			if err := m.AbciCalls[len(m.AbciCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusTimeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusTimeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ProposalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCompleteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ProposalCompleteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevotesAnyTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PrevotesAnyTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevotesMaj23Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PrevotesMaj23Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecommitsAnyTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PrecommitsAnyTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecommitsMaj23Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PrecommitsMaj23Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &VoteArrival{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusTimeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteArrival) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusTimeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteArrival: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteArrival: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v2.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusTimeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ABCICallTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusTimeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ABCICallTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ABCICallTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusTimeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusTimeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConsensusTimeline(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConsensusTimeline
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensusTimeline
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConsensusTimeline
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConsensusTimeline
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConsensusTimeline
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConsensusTimeline        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConsensusTimeline          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConsensusTimeline = fmt.Errorf("proto: unexpected end of group")
+)
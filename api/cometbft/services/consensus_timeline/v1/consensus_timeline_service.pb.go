@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/consensus_timeline/v1/consensus_timeline_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/consensus_timeline/v1/consensus_timeline_service.proto", fileDescriptor_564b2e09e6e01b4e)
+}
+
+var fileDescriptor_564b2e09e6e01b4e = []byte{
+	// 196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xf2, 0x48, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0xce,
+	0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0x8e, 0x2f, 0xc9, 0xcc, 0x4d, 0xcd, 0xc9, 0xcc, 0x4b,
+	0xd5, 0x2f, 0x33, 0xc4, 0x22, 0x1a, 0x0f, 0xd5, 0xa1, 0x57, 0x50, 0x94, 0x5f, 0x92, 0x2f, 0xa4,
+	0x0e, 0x33, 0x49, 0x0f, 0x66, 0x92, 0x1e, 0xa6, 0x1e, 0xbd, 0x32, 0x43, 0x29, 0x07, 0xf2, 0xad,
+	0x84, 0x58, 0x65, 0xb4, 0x94, 0x91, 0x4b, 0xc2, 0x19, 0x26, 0x19, 0x02, 0x95, 0x0b, 0x86, 0x18,
+	0x26, 0xd4, 0xc1, 0xc8, 0xc5, 0xed, 0x9e, 0x5a, 0x02, 0x13, 0x16, 0xb2, 0xd6, 0x23, 0xd2, 0x61,
+	0x7a, 0x48, 0xba, 0x82, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0xa4, 0x6c, 0xc8, 0xd3, 0x5c, 0x5c,
+	0x00, 0x52, 0xe3, 0x94, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
+	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x1e,
+	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x20, 0xd3, 0xf5, 0xe1, 0xc1, 0x01, 0x67, 0x24, 0x16, 0x64,
+	0xea, 0x13, 0x19, 0x48, 0x49, 0x6c, 0xe0, 0x20, 0x31, 0x06, 0x0c, 0x00, 0xb9, 0xca, 0x38, 0x43,
+	0xc9, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ConsensusTimelineServiceClient is the client API for ConsensusTimelineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ConsensusTimelineServiceClient interface {
+	// GetTimeline returns the consensus timeline of the requested height.
+	GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error)
+}
+
+type consensusTimelineServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewConsensusTimelineServiceClient(cc grpc1.ClientConn) ConsensusTimelineServiceClient {
+	return &consensusTimelineServiceClient{cc}
+}
+
+func (c *consensusTimelineServiceClient) GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error) {
+	out := new(GetTimelineResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.consensus_timeline.v1.ConsensusTimelineService/GetTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsensusTimelineServiceServer is the server API for ConsensusTimelineService service.
+type ConsensusTimelineServiceServer interface {
+	// GetTimeline returns the consensus timeline of the requested height.
+	GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineResponse, error)
+}
+
+// UnimplementedConsensusTimelineServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedConsensusTimelineServiceServer struct {
+}
+
+func (*UnimplementedConsensusTimelineServiceServer) GetTimeline(ctx context.Context, req *GetTimelineRequest) (*GetTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeline not implemented")
+}
+
+func RegisterConsensusTimelineServiceServer(s grpc1.Server, srv ConsensusTimelineServiceServer) {
+	s.RegisterService(&_ConsensusTimelineService_serviceDesc, srv)
+}
+
+func _ConsensusTimelineService_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusTimelineServiceServer).GetTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.consensus_timeline.v1.ConsensusTimelineService/GetTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusTimelineServiceServer).GetTimeline(ctx, req.(*GetTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var ConsensusTimelineService_serviceDesc = _ConsensusTimelineService_serviceDesc
+var _ConsensusTimelineService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.consensus_timeline.v1.ConsensusTimelineService",
+	HandlerType: (*ConsensusTimelineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTimeline",
+			Handler:    _ConsensusTimelineService_GetTimeline_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cometbft/services/consensus_timeline/v1/consensus_timeline_service.proto",
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/snapshot/v1/snapshot_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/snapshot/v1/snapshot_service.proto", fileDescriptor_a5c0a931bd75d9c0)
+}
+
+var fileDescriptor_a5c0a931bd75d9c0 = []byte{
+	// 241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x49, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x2f, 0xce,
+	0x4b, 0x2c, 0x28, 0xce, 0xc8, 0x2f, 0xd1, 0x2f, 0x33, 0x84, 0xb3, 0xe3, 0xa1, 0xb2, 0x7a, 0x05,
+	0x45, 0xf9, 0x25, 0xf9, 0x42, 0xb2, 0x30, 0x5d, 0x7a, 0x30, 0x5d, 0x7a, 0x30, 0x95, 0x7a, 0x65,
+	0x86, 0x52, 0x3a, 0xc4, 0x19, 0x0a, 0x31, 0x4c, 0x4a, 0x11, 0xa1, 0xba, 0x24, 0xb1, 0x24, 0xb5,
+	0xb8, 0x32, 0x2f, 0x19, 0xa4, 0xaa, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0xa2, 0xc4, 0xe8, 0x33, 0x23,
+	0x17, 0x7f, 0x30, 0x54, 0x57, 0x30, 0xc4, 0x48, 0xa1, 0x2a, 0x2e, 0x5e, 0x9f, 0xcc, 0xe2, 0x12,
+	0x98, 0x70, 0xb1, 0x90, 0xb1, 0x1e, 0x5e, 0x57, 0xe9, 0xa1, 0xa8, 0x0e, 0x4a, 0x2d, 0x2c, 0x4d,
+	0x2d, 0x2e, 0x91, 0x32, 0x21, 0x4d, 0x53, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x50, 0x0a, 0x17,
+	0xa7, 0x7b, 0x6a, 0x89, 0x73, 0x46, 0x69, 0x5e, 0x76, 0xb1, 0x90, 0x3e, 0x01, 0x23, 0xe0, 0x2a,
+	0x61, 0x76, 0xaa, 0x20, 0x69, 0x80, 0xf9, 0x18, 0xa4, 0x10, 0xac, 0x0a, 0x66, 0x87, 0x01, 0xa3,
+	0x53, 0xc4, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
+	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xd9, 0xa5, 0x67, 0x96,
+	0x64, 0x94, 0x26, 0x81, 0xcc, 0xd1, 0x87, 0x87, 0x1e, 0x9c, 0x91, 0x58, 0x90, 0xa9, 0x8f, 0x37,
+	0x06, 0x92, 0xd8, 0xc0, 0xc1, 0x6a, 0x0c, 0x18, 0x00, 0xed, 0x24, 0x45, 0xec, 0xfe, 0x01, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SnapshotServiceClient is the client API for SnapshotService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SnapshotServiceClient interface {
+	// ListSnapshots returns the snapshots available from the application.
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// GetChunks streams the chunks of a snapshot, in order, starting from the
+	// requested chunk index. The stream ends after the last chunk. The chunks
+	// are the messages exchanged by state sync peers and stored in snapshot
+	// files.
+	GetChunks(ctx context.Context, in *GetChunksRequest, opts ...grpc.CallOption) (SnapshotService_GetChunksClient, error)
+}
+
+type snapshotServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewSnapshotServiceClient(cc grpc1.ClientConn) SnapshotServiceClient {
+	return &snapshotServiceClient{cc}
+}
+
+func (c *snapshotServiceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.snapshot.v1.SnapshotService/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotServiceClient) GetChunks(ctx context.Context, in *GetChunksRequest, opts ...grpc.CallOption) (SnapshotService_GetChunksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SnapshotService_serviceDesc.Streams[0], "/cometbft.services.snapshot.v1.SnapshotService/GetChunks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &snapshotServiceGetChunksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SnapshotService_GetChunksClient interface {
+	Recv() (*v1.ChunkResponse, error)
+	grpc.ClientStream
+}
+
+type snapshotServiceGetChunksClient struct {
+	grpc.ClientStream
+}
+
+func (x *snapshotServiceGetChunksClient) Recv() (*v1.ChunkResponse, error) {
+	m := new(v1.ChunkResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SnapshotServiceServer is the server API for SnapshotService service.
+type SnapshotServiceServer interface {
+	// ListSnapshots returns the snapshots available from the application.
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// GetChunks streams the chunks of a snapshot, in order, starting from the
+	// requested chunk index. The stream ends after the last chunk. The chunks
+	// are the messages exchanged by state sync peers and stored in snapshot
+	// files.
+	GetChunks(*GetChunksRequest, SnapshotService_GetChunksServer) error
+}
+
+// UnimplementedSnapshotServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSnapshotServiceServer struct {
+}
+
+func (*UnimplementedSnapshotServiceServer) ListSnapshots(ctx context.Context, req *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (*UnimplementedSnapshotServiceServer) GetChunks(req *GetChunksRequest, srv SnapshotService_GetChunksServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetChunks not implemented")
+}
+
+func RegisterSnapshotServiceServer(s grpc1.Server, srv SnapshotServiceServer) {
+	s.RegisterService(&_SnapshotService_serviceDesc, srv)
+}
+
+func _SnapshotService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.snapshot.v1.SnapshotService/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnapshotService_GetChunks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetChunksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnapshotServiceServer).GetChunks(m, &snapshotServiceGetChunksServer{stream})
+}
+
+type SnapshotService_GetChunksServer interface {
+	Send(*v1.ChunkResponse) error
+	grpc.ServerStream
+}
+
+type snapshotServiceGetChunksServer struct {
+	grpc.ServerStream
+}
+
+func (x *snapshotServiceGetChunksServer) Send(m *v1.ChunkResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var SnapshotService_serviceDesc = _SnapshotService_serviceDesc
+var _SnapshotService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.snapshot.v1.SnapshotService",
+	HandlerType: (*SnapshotServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _SnapshotService_ListSnapshots_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetChunks",
+			Handler:       _SnapshotService_GetChunks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cometbft/services/snapshot/v1/snapshot_service.proto",
+}
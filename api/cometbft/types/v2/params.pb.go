@@ -501,6 +501,20 @@ type FeatureParams struct {
 	//
 	// Cannot be set to heights lower or equal to the current blockchain height.
 	PbtsEnableHeight *types.Int64Value `protobuf:"bytes,2,opt,name=pbts_enable_height,json=pbtsEnableHeight,proto3" json:"pbts_enable_height,omitempty"`
+	// Height at which the commits of BLS12-381 validator sets will be aggregated.
+	//
+	// A value of 0 means aggregated commits are disabled. A value > 0 denotes
+	// the height at which aggregated commits will be (or have been) enabled. It
+	// requires PBTS to be enabled at this height.
+	//
+	// From the specified height, and for all subsequent heights, precommits
+	// are signed without a timestamp, and the commits of validator sets whose
+	// keys are all BLS12-381 keys carry one aggregate signature per block ID
+	// flag instead of one signature per validator. Validator sets with other
+	// keys keep using regular commits.
+	//
+	// Cannot be set to heights lower or equal to the current blockchain height.
+	AggregatedCommitsEnableHeight *types.Int64Value `protobuf:"bytes,3,opt,name=aggregated_commits_enable_height,json=aggregatedCommitsEnableHeight,proto3" json:"aggregated_commits_enable_height,omitempty"`
 }
 
 func (m *FeatureParams) Reset()         { *m = FeatureParams{} }
@@ -550,6 +564,13 @@ func (m *FeatureParams) GetPbtsEnableHeight() *types.Int64Value {
 	return nil
 }
 
+func (m *FeatureParams) GetAggregatedCommitsEnableHeight() *types.Int64Value {
+	if m != nil {
+		return m.AggregatedCommitsEnableHeight
+	}
+	return nil
+}
+
 // ABCIParams is deprecated and its contents moved to FeatureParams
 //
 // Deprecated: Do not use.
@@ -614,53 +635,55 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v2/params.proto", fileDescriptor_5f4e06a882ada5b9) }
 
 var fileDescriptor_5f4e06a882ada5b9 = []byte{
-	// 729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbf, 0x4e, 0xdb, 0x50,
-	0x14, 0xc6, 0x73, 0xe3, 0x00, 0xc9, 0x09, 0x21, 0xe9, 0x55, 0xa5, 0xba, 0x20, 0x1c, 0xea, 0xa1,
-	0x42, 0x42, 0xb2, 0xa5, 0x94, 0x76, 0x40, 0x42, 0x2d, 0x01, 0x0a, 0xb4, 0xa2, 0x45, 0xa6, 0x62,
-	0x60, 0xb1, 0xae, 0x93, 0x8b, 0x63, 0x11, 0xff, 0x91, 0xaf, 0x9d, 0x26, 0x6f, 0xd1, 0xa9, 0xea,
-	0xc8, 0xd8, 0xbe, 0x41, 0xfb, 0x02, 0x15, 0x23, 0x63, 0x27, 0x5a, 0x85, 0xa5, 0x8f, 0x51, 0xf9,
-	0xda, 0x4e, 0x48, 0x08, 0x85, 0xcd, 0xf6, 0xf9, 0x7e, 0x9f, 0xbf, 0x7b, 0xce, 0xb1, 0x41, 0x6a,
-	0xb8, 0x36, 0x0d, 0x8c, 0x93, 0x40, 0x0d, 0x7a, 0x1e, 0x65, 0x6a, 0xa7, 0xa6, 0x7a, 0xc4, 0x27,
-	0x36, 0x53, 0x3c, 0xdf, 0x0d, 0x5c, 0xfc, 0x20, 0xad, 0x2b, 0xbc, 0xae, 0x74, 0x6a, 0xf3, 0x0f,
-	0x4d, 0xd7, 0x74, 0x79, 0x55, 0x8d, 0xae, 0x62, 0xe1, 0xbc, 0x64, 0xba, 0xae, 0xd9, 0xa6, 0x2a,
-	0xbf, 0x33, 0xc2, 0x13, 0xb5, 0x19, 0xfa, 0x24, 0xb0, 0x5c, 0xe7, 0xb6, 0xfa, 0x47, 0x9f, 0x78,
-	0x1e, 0xf5, 0x93, 0x17, 0xc9, 0x3f, 0x04, 0x28, 0x6f, 0xba, 0x0e, 0xa3, 0x0e, 0x0b, 0xd9, 0x01,
-	0x8f, 0x80, 0x57, 0x61, 0xca, 0x68, 0xbb, 0x8d, 0x53, 0x11, 0x2d, 0xa1, 0xe5, 0x62, 0x4d, 0x52,
-	0x6e, 0x84, 0x51, 0xea, 0x51, 0x3d, 0x96, 0x6b, 0xb1, 0x18, 0xaf, 0x43, 0x9e, 0x76, 0xac, 0x26,
-	0x75, 0x1a, 0x54, 0xcc, 0x72, 0xf0, 0xc9, 0x04, 0x70, 0x3b, 0x91, 0x24, 0xec, 0x00, 0xc1, 0xaf,
-	0xa0, 0xd0, 0x21, 0x6d, 0xab, 0x49, 0x02, 0xd7, 0x17, 0x05, 0xce, 0xcb, 0x13, 0xf8, 0xa3, 0x54,
-	0x93, 0x18, 0x0c, 0x21, 0xbc, 0x06, 0x33, 0x1d, 0xea, 0x33, 0xcb, 0x75, 0xc4, 0x1c, 0xe7, 0x97,
-	0x26, 0xf1, 0xb1, 0x22, 0xa1, 0x53, 0x00, 0x3f, 0x87, 0x1c, 0x31, 0x1a, 0x96, 0x38, 0xc5, 0xc1,
-	0xc5, 0x09, 0xe0, 0x46, 0x7d, 0x73, 0x2f, 0xa6, 0xea, 0x59, 0x11, 0x69, 0x5c, 0x1e, 0x85, 0x66,
-	0x3d, 0xa7, 0xd1, 0xf2, 0x5d, 0xa7, 0x27, 0x4e, 0xdf, 0x1a, 0xfa, 0x30, 0xd5, 0xa4, 0xa1, 0x07,
-	0x50, 0x14, 0xfa, 0x84, 0x92, 0x20, 0xf4, 0xa9, 0x38, 0x73, 0x6b, 0xe8, 0xd7, 0xb1, 0x22, 0x0d,
-	0x9d, 0x00, 0xf2, 0x1e, 0x14, 0xaf, 0xcd, 0x01, 0x2f, 0x40, 0xc1, 0x26, 0x5d, 0xdd, 0xe8, 0x05,
-	0x94, 0xf1, 0xd1, 0x09, 0x5a, 0xde, 0x26, 0xdd, 0x7a, 0x74, 0x8f, 0x1f, 0xc1, 0x4c, 0x54, 0x34,
-	0x09, 0xe3, 0xc3, 0x11, 0xb4, 0x69, 0x9b, 0x74, 0x77, 0x08, 0x7b, 0x93, 0xcb, 0x0b, 0x95, 0x9c,
-	0xfc, 0x0d, 0xc1, 0xdc, 0xe8, 0x68, 0xf0, 0x0a, 0xe0, 0x88, 0x20, 0x26, 0xd5, 0x9d, 0xd0, 0xd6,
-	0xf9, 0x90, 0x53, 0xdf, 0xb2, 0x4d, 0xba, 0x1b, 0x26, 0x7d, 0x17, 0xda, 0x3c, 0x00, 0xc3, 0xfb,
-	0x50, 0x49, 0xc5, 0xe9, 0x02, 0x26, 0x4b, 0xf0, 0x58, 0x89, 0x37, 0x50, 0x49, 0x37, 0x50, 0xd9,
-	0x4a, 0x04, 0xf5, 0xfc, 0xf9, 0x65, 0x35, 0xf3, 0xe5, 0x77, 0x15, 0x69, 0x73, 0xb1, 0x5f, 0x5a,
-	0x19, 0x3d, 0x8a, 0x30, 0x7a, 0x14, 0xf9, 0x25, 0x94, 0xc7, 0xb6, 0x00, 0xcb, 0x50, 0xf2, 0x42,
-	0x43, 0x3f, 0xa5, 0x3d, 0x9d, 0x37, 0x4d, 0x44, 0x4b, 0xc2, 0x72, 0x41, 0x2b, 0x7a, 0xa1, 0xf1,
-	0x96, 0xf6, 0x3e, 0x44, 0x8f, 0xd6, 0xf2, 0xdf, 0xcf, 0xaa, 0xe8, 0xef, 0x59, 0x15, 0xc9, 0x2b,
-	0x50, 0x1a, 0x59, 0x03, 0x5c, 0x01, 0x81, 0x78, 0x1e, 0x3f, 0x5b, 0x4e, 0x8b, 0x2e, 0xaf, 0x89,
-	0x8f, 0x61, 0x76, 0x97, 0xb0, 0x16, 0x6d, 0x26, 0xda, 0xa7, 0x50, 0xe6, 0xad, 0xd0, 0xc7, 0x7b,
-	0x5d, 0xe2, 0x8f, 0xf7, 0xd3, 0x86, 0xcb, 0x50, 0x1a, 0xea, 0x86, 0x6d, 0x2f, 0xa6, 0xaa, 0x1d,
-	0xc2, 0xe4, 0xcf, 0x08, 0xca, 0x63, 0xbb, 0x81, 0xd7, 0xa1, 0xe0, 0xf9, 0xb4, 0x61, 0xf1, 0x3d,
-	0x46, 0x77, 0xb5, 0x30, 0xc7, 0xdb, 0x37, 0x24, 0xf0, 0x16, 0x94, 0x6c, 0xca, 0x18, 0x1f, 0x04,
-	0x6d, 0x93, 0xde, 0xdd, 0x53, 0x88, 0x2d, 0x66, 0x13, 0x6a, 0x2b, 0x82, 0xe4, 0x9f, 0x08, 0x4a,
-	0x23, 0x4b, 0x87, 0x9b, 0xb0, 0xd8, 0x71, 0x03, 0xaa, 0xd3, 0x6e, 0x40, 0x9d, 0xe8, 0x4d, 0x4c,
-	0xa7, 0x0e, 0x31, 0xda, 0x54, 0x6f, 0x51, 0xcb, 0x6c, 0x05, 0x49, 0xd4, 0x85, 0x1b, 0xef, 0xd9,
-	0x73, 0x82, 0x17, 0xab, 0x47, 0xa4, 0x1d, 0xd2, 0x7a, 0xee, 0xfc, 0xb2, 0x8a, 0xb4, 0xf9, 0xc8,
-	0x67, 0x7b, 0x60, 0xb3, 0xcd, 0x5d, 0x76, 0xb9, 0x09, 0x7e, 0x0f, 0xd8, 0x33, 0x82, 0x71, 0xeb,
-	0xec, 0x7d, 0xad, 0x2b, 0x11, 0x7c, 0xdd, 0x50, 0x3e, 0x04, 0x18, 0x7e, 0xb8, 0x78, 0xe3, 0x3e,
-	0x87, 0x10, 0xfe, 0x97, 0x70, 0x2d, 0x2b, 0xa2, 0xfa, 0xc1, 0xd7, 0xbe, 0x84, 0xce, 0xfb, 0x12,
-	0xba, 0xe8, 0x4b, 0xe8, 0x4f, 0x5f, 0x42, 0x9f, 0xae, 0xa4, 0xcc, 0xc5, 0x95, 0x94, 0xf9, 0x75,
-	0x25, 0x65, 0x8e, 0x6b, 0xa6, 0x15, 0xb4, 0x42, 0x23, 0xfa, 0x8c, 0xd5, 0xc1, 0x5f, 0x7e, 0x70,
-	0x41, 0x3c, 0x4b, 0xbd, 0xf1, 0xef, 0x37, 0xa6, 0xf9, 0x99, 0x9e, 0xfd, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x6d, 0x17, 0x25, 0xb9, 0x17, 0x06, 0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x4f, 0x4f, 0xdb, 0x48,
+	0x18, 0xc6, 0x33, 0x71, 0x80, 0x64, 0x42, 0x48, 0x76, 0xb4, 0xd2, 0x7a, 0x41, 0x38, 0x59, 0x1f,
+	0x56, 0x48, 0x48, 0xb6, 0x94, 0x65, 0xf7, 0x80, 0x84, 0x76, 0x09, 0xb0, 0x40, 0x2b, 0x5a, 0x64,
+	0x2a, 0x0e, 0x5c, 0xac, 0x71, 0x32, 0x38, 0x2e, 0xb1, 0xc7, 0xf2, 0x8c, 0xd3, 0xe4, 0x5b, 0xf4,
+	0x54, 0xf5, 0xc8, 0xb1, 0xbd, 0xf6, 0xd4, 0x7e, 0x03, 0x8e, 0x1c, 0x7b, 0xa2, 0x55, 0xb8, 0xf4,
+	0x63, 0x54, 0x1e, 0xdb, 0x09, 0xf9, 0x43, 0x9b, 0xdb, 0xd8, 0xef, 0xf3, 0x7b, 0xe6, 0x99, 0x77,
+	0x5e, 0xd9, 0x50, 0x69, 0x52, 0x97, 0x70, 0xeb, 0x92, 0xeb, 0xbc, 0xef, 0x13, 0xa6, 0x77, 0xeb,
+	0xba, 0x8f, 0x03, 0xec, 0x32, 0xcd, 0x0f, 0x28, 0xa7, 0xe8, 0x97, 0xb4, 0xae, 0x89, 0xba, 0xd6,
+	0xad, 0xaf, 0xfe, 0x6a, 0x53, 0x9b, 0x8a, 0xaa, 0x1e, 0xad, 0x62, 0xe1, 0xaa, 0x62, 0x53, 0x6a,
+	0x77, 0x88, 0x2e, 0x9e, 0xac, 0xf0, 0x52, 0x6f, 0x85, 0x01, 0xe6, 0x0e, 0xf5, 0x1e, 0xab, 0xbf,
+	0x0a, 0xb0, 0xef, 0x93, 0x20, 0xd9, 0x48, 0xfd, 0x24, 0xc1, 0xf2, 0x1e, 0xf5, 0x18, 0xf1, 0x58,
+	0xc8, 0x4e, 0x45, 0x04, 0xb4, 0x05, 0x17, 0xac, 0x0e, 0x6d, 0x5e, 0xc9, 0xa0, 0x06, 0x36, 0x8a,
+	0x75, 0x45, 0x9b, 0x0a, 0xa3, 0x35, 0xa2, 0x7a, 0x2c, 0x37, 0x62, 0x31, 0xda, 0x81, 0x79, 0xd2,
+	0x75, 0x5a, 0xc4, 0x6b, 0x12, 0x39, 0x2b, 0xc0, 0x3f, 0x66, 0x80, 0x07, 0x89, 0x24, 0x61, 0x87,
+	0x08, 0xfa, 0x0f, 0x16, 0xba, 0xb8, 0xe3, 0xb4, 0x30, 0xa7, 0x81, 0x2c, 0x09, 0x5e, 0x9d, 0xc1,
+	0x9f, 0xa7, 0x9a, 0xc4, 0x60, 0x04, 0xa1, 0x6d, 0xb8, 0xd4, 0x25, 0x01, 0x73, 0xa8, 0x27, 0xe7,
+	0x04, 0x5f, 0x9b, 0xc5, 0xc7, 0x8a, 0x84, 0x4e, 0x01, 0xf4, 0x37, 0xcc, 0x61, 0xab, 0xe9, 0xc8,
+	0x0b, 0x02, 0x5c, 0x9f, 0x01, 0xee, 0x36, 0xf6, 0x8e, 0x63, 0xaa, 0x91, 0x95, 0x81, 0x21, 0xe4,
+	0x51, 0x68, 0xd6, 0xf7, 0x9a, 0xed, 0x80, 0x7a, 0x7d, 0x79, 0xf1, 0xd1, 0xd0, 0x67, 0xa9, 0x26,
+	0x0d, 0x3d, 0x84, 0xa2, 0xd0, 0x97, 0x04, 0xf3, 0x30, 0x20, 0xf2, 0xd2, 0xa3, 0xa1, 0xff, 0x8f,
+	0x15, 0x69, 0xe8, 0x04, 0x50, 0x8f, 0x61, 0xf1, 0xc1, 0x3d, 0xa0, 0x35, 0x58, 0x70, 0x71, 0xcf,
+	0xb4, 0xfa, 0x9c, 0x30, 0x71, 0x75, 0x92, 0x91, 0x77, 0x71, 0xaf, 0x11, 0x3d, 0xa3, 0xdf, 0xe0,
+	0x52, 0x54, 0xb4, 0x31, 0x13, 0x97, 0x23, 0x19, 0x8b, 0x2e, 0xee, 0x1d, 0x62, 0xf6, 0x24, 0x97,
+	0x97, 0x2a, 0x39, 0xf5, 0x3d, 0x80, 0x2b, 0xe3, 0x57, 0x83, 0x36, 0x21, 0x8a, 0x08, 0x6c, 0x13,
+	0xd3, 0x0b, 0x5d, 0x53, 0x5c, 0x72, 0xea, 0x5b, 0x76, 0x71, 0x6f, 0xd7, 0x26, 0xcf, 0x42, 0x57,
+	0x04, 0x60, 0xe8, 0x04, 0x56, 0x52, 0x71, 0x3a, 0x80, 0xc9, 0x10, 0xfc, 0xae, 0xc5, 0x13, 0xa8,
+	0xa5, 0x13, 0xa8, 0xed, 0x27, 0x82, 0x46, 0xfe, 0xe6, 0xae, 0x9a, 0x79, 0xfb, 0xa5, 0x0a, 0x8c,
+	0x95, 0xd8, 0x2f, 0xad, 0x8c, 0x1f, 0x45, 0x1a, 0x3f, 0x8a, 0xfa, 0x2f, 0x2c, 0x4f, 0x4c, 0x01,
+	0x52, 0x61, 0xc9, 0x0f, 0x2d, 0xf3, 0x8a, 0xf4, 0x4d, 0xd1, 0x34, 0x19, 0xd4, 0xa4, 0x8d, 0x82,
+	0x51, 0xf4, 0x43, 0xeb, 0x29, 0xe9, 0xbf, 0x88, 0x5e, 0x6d, 0xe7, 0x3f, 0x5e, 0x57, 0xc1, 0xb7,
+	0xeb, 0x2a, 0x50, 0x37, 0x61, 0x69, 0x6c, 0x0c, 0x50, 0x05, 0x4a, 0xd8, 0xf7, 0xc5, 0xd9, 0x72,
+	0x46, 0xb4, 0x7c, 0x20, 0xbe, 0x80, 0xcb, 0x47, 0x98, 0xb5, 0x49, 0x2b, 0xd1, 0xfe, 0x09, 0xcb,
+	0xa2, 0x15, 0xe6, 0x64, 0xaf, 0x4b, 0xe2, 0xf5, 0x49, 0xda, 0x70, 0x15, 0x96, 0x46, 0xba, 0x51,
+	0xdb, 0x8b, 0xa9, 0xea, 0x10, 0x33, 0xf5, 0x0d, 0x80, 0xe5, 0x89, 0xd9, 0x40, 0x3b, 0xb0, 0xe0,
+	0x07, 0xa4, 0xe9, 0x88, 0x39, 0x06, 0x3f, 0x6b, 0x61, 0x4e, 0xb4, 0x6f, 0x44, 0xa0, 0x7d, 0x58,
+	0x72, 0x09, 0x63, 0xe2, 0x22, 0x48, 0x07, 0xf7, 0xe5, 0xec, 0x7c, 0x16, 0xcb, 0x09, 0xb5, 0x1f,
+	0x41, 0xea, 0x87, 0x2c, 0x2c, 0x8d, 0x0d, 0x1d, 0x6a, 0xc1, 0xf5, 0x2e, 0xe5, 0xc4, 0x24, 0x3d,
+	0x4e, 0xbc, 0x68, 0x27, 0x66, 0x12, 0x0f, 0x5b, 0x1d, 0x62, 0xb6, 0x89, 0x63, 0xb7, 0x79, 0x12,
+	0x75, 0x6d, 0x6a, 0x9f, 0x63, 0x8f, 0xff, 0xb3, 0x75, 0x8e, 0x3b, 0x21, 0x69, 0xe4, 0x6e, 0xee,
+	0xaa, 0xc0, 0x58, 0x8d, 0x7c, 0x0e, 0x86, 0x36, 0x07, 0xc2, 0xe5, 0x48, 0x98, 0xa0, 0xe7, 0x10,
+	0xf9, 0x16, 0x9f, 0xb4, 0xce, 0xce, 0x6b, 0x5d, 0x89, 0xe0, 0x31, 0xc3, 0x97, 0xb0, 0x86, 0x6d,
+	0x3b, 0x20, 0x36, 0xe6, 0xa4, 0x65, 0x36, 0xa9, 0xeb, 0x3a, 0x53, 0xf6, 0xd2, 0xbc, 0xf6, 0xeb,
+	0x23, 0xab, 0xbd, 0xd8, 0xe9, 0xe1, 0x5e, 0xea, 0x19, 0x84, 0xa3, 0x8f, 0x04, 0xda, 0x9d, 0xa7,
+	0x61, 0xd2, 0x8f, 0xba, 0xb1, 0x9d, 0x95, 0x41, 0xe3, 0xf4, 0xdd, 0x40, 0x01, 0x37, 0x03, 0x05,
+	0xdc, 0x0e, 0x14, 0xf0, 0x75, 0xa0, 0x80, 0xd7, 0xf7, 0x4a, 0xe6, 0xf6, 0x5e, 0xc9, 0x7c, 0xbe,
+	0x57, 0x32, 0x17, 0x75, 0xdb, 0xe1, 0xed, 0xd0, 0x8a, 0x3e, 0x19, 0xfa, 0xf0, 0x8f, 0x32, 0x5c,
+	0x60, 0xdf, 0xd1, 0xa7, 0xfe, 0x33, 0xd6, 0xa2, 0x38, 0xe0, 0x5f, 0xdf, 0x07, 0x00, 0xb9, 0xa5,
+	0x4e, 0x10, 0x83, 0x06, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -906,6 +929,9 @@ func (this *FeatureParams) Equal(that interface{}) bool {
 	if !this.PbtsEnableHeight.Equal(that1.PbtsEnableHeight) {
 		return false
 	}
+	if !this.AggregatedCommitsEnableHeight.Equal(that1.AggregatedCommitsEnableHeight) {
+		return false
+	}
 	return true
 }
 func (this *ABCIParams) Equal(that interface{}) bool {
@@ -1269,6 +1295,18 @@ func (m *FeatureParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AggregatedCommitsEnableHeight != nil {
+		{
+			size, err := m.AggregatedCommitsEnableHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PbtsEnableHeight != nil {
 		{
 			size, err := m.PbtsEnableHeight.MarshalToSizedBuffer(dAtA[:i])
@@ -1569,6 +1607,10 @@ func (m *FeatureParams) Size() (n int) {
 		l = m.PbtsEnableHeight.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.AggregatedCommitsEnableHeight != nil {
+		l = m.AggregatedCommitsEnableHeight.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -2563,6 +2605,42 @@ func (m *FeatureParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedCommitsEnableHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedCommitsEnableHeight == nil {
+				m.AggregatedCommitsEnableHeight = &types.Int64Value{}
+			}
+			if err := m.AggregatedCommitsEnableHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package v2
 import (
 	fmt "fmt"
 	v1 "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	v12 "github.com/cometbft/cometbft/api/cometbft/libs/bits/v1"
 	v11 "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -588,6 +589,9 @@ type Commit struct {
 	Round      int32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 	BlockID    BlockID     `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
 	Signatures []CommitSig `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+	// Aggregated signatures replace the signatures in the commits of BLS12-381
+	// validator sets, once aggregated commits are enabled.
+	AggregatedSignatures []AggregatedCommitSig `protobuf:"bytes,5,rep,name=aggregated_signatures,json=aggregatedSignatures,proto3" json:"aggregated_signatures"`
 }
 
 func (m *Commit) Reset()         { *m = Commit{} }
@@ -651,6 +655,13 @@ func (m *Commit) GetSignatures() []CommitSig {
 	return nil
 }
 
+func (m *Commit) GetAggregatedSignatures() []AggregatedCommitSig {
+	if m != nil {
+		return m.AggregatedSignatures
+	}
+	return nil
+}
+
 // CommitSig is a part of the Vote included in a Commit.
 type CommitSig struct {
 	BlockIdFlag      BlockIDFlag `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=cometbft.types.v2.BlockIDFlag" json:"block_id_flag,omitempty"`
@@ -720,6 +731,68 @@ func (m *CommitSig) GetSignature() []byte {
 	return nil
 }
 
+// AggregatedCommitSig is the aggregate of the signatures of the precommits
+// with the same block ID flag, by the validators in the bit array.
+type AggregatedCommitSig struct {
+	BlockIdFlag BlockIDFlag   `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=cometbft.types.v2.BlockIDFlag" json:"block_id_flag,omitempty"`
+	Validators  *v12.BitArray `protobuf:"bytes,2,opt,name=validators,proto3" json:"validators,omitempty"`
+	Signature   []byte        `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *AggregatedCommitSig) Reset()         { *m = AggregatedCommitSig{} }
+func (m *AggregatedCommitSig) String() string { return proto.CompactTextString(m) }
+func (*AggregatedCommitSig) ProtoMessage()    {}
+func (*AggregatedCommitSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b33958ab5ece188f, []int{8}
+}
+func (m *AggregatedCommitSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedCommitSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedCommitSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedCommitSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedCommitSig.Merge(m, src)
+}
+func (m *AggregatedCommitSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedCommitSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedCommitSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedCommitSig proto.InternalMessageInfo
+
+func (m *AggregatedCommitSig) GetBlockIdFlag() BlockIDFlag {
+	if m != nil {
+		return m.BlockIdFlag
+	}
+	return BlockIDFlagUnknown
+}
+
+func (m *AggregatedCommitSig) GetValidators() *v12.BitArray {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *AggregatedCommitSig) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 // ExtendedCommit is a Commit with ExtendedCommitSig.
 type ExtendedCommit struct {
 	Height             int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -732,7 +805,7 @@ func (m *ExtendedCommit) Reset()         { *m = ExtendedCommit{} }
 func (m *ExtendedCommit) String() string { return proto.CompactTextString(m) }
 func (*ExtendedCommit) ProtoMessage()    {}
 func (*ExtendedCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b33958ab5ece188f, []int{8}
+	return fileDescriptor_b33958ab5ece188f, []int{9}
 }
 func (m *ExtendedCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,7 +887,7 @@ func (m *ExtendedCommitSig) Reset()         { *m = ExtendedCommitSig{} }
 func (m *ExtendedCommitSig) String() string { return proto.CompactTextString(m) }
 func (*ExtendedCommitSig) ProtoMessage()    {}
 func (*ExtendedCommitSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b33958ab5ece188f, []int{9}
+	return fileDescriptor_b33958ab5ece188f, []int{10}
 }
 func (m *ExtendedCommitSig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +987,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b33958ab5ece188f, []int{10}
+	return fileDescriptor_b33958ab5ece188f, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1075,7 @@ func (m *SignedHeader) Reset()         { *m = SignedHeader{} }
 func (m *SignedHeader) String() string { return proto.CompactTextString(m) }
 func (*SignedHeader) ProtoMessage()    {}
 func (*SignedHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b33958ab5ece188f, []int{11}
+	return fileDescriptor_b33958ab5ece188f, []int{12}
 }
 func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,7 +1128,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b33958ab5ece188f, []int{12}
+	return fileDescriptor_b33958ab5ece188f, []int{13}
 }
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1183,7 @@ func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
 func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
 func (*BlockMeta) ProtoMessage()    {}
 func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b33958ab5ece188f, []int{13}
+	return fileDescriptor_b33958ab5ece188f, []int{14}
 }
 func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,7 +1251,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b33958ab5ece188f, []int{14}
+	return fileDescriptor_b33958ab5ece188f, []int{15}
 }
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1228,6 +1301,67 @@ func (m *TxProof) GetProof() *v1.Proof {
 	return nil
 }
 
+// TxMultiProof represents a Merkle proof of the presence of several transactions in the Merkle tree.
+type TxMultiProof struct {
+	RootHash []byte         `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	Data     [][]byte       `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Proof    *v1.MultiProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *TxMultiProof) Reset()         { *m = TxMultiProof{} }
+func (m *TxMultiProof) String() string { return proto.CompactTextString(m) }
+func (*TxMultiProof) ProtoMessage()    {}
+func (*TxMultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b33958ab5ece188f, []int{16}
+}
+func (m *TxMultiProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxMultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxMultiProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxMultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxMultiProof.Merge(m, src)
+}
+func (m *TxMultiProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxMultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxMultiProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxMultiProof proto.InternalMessageInfo
+
+func (m *TxMultiProof) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *TxMultiProof) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TxMultiProof) GetProof() *v1.MultiProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cometbft.types.v2.SignedMsgType", SignedMsgType_name, SignedMsgType_value)
 	proto.RegisterType((*PartSetHeader)(nil), "cometbft.types.v2.PartSetHeader")
@@ -1238,6 +1372,7 @@ func init() {
 	proto.RegisterType((*Vote)(nil), "cometbft.types.v2.Vote")
 	proto.RegisterType((*Commit)(nil), "cometbft.types.v2.Commit")
 	proto.RegisterType((*CommitSig)(nil), "cometbft.types.v2.CommitSig")
+	proto.RegisterType((*AggregatedCommitSig)(nil), "cometbft.types.v2.AggregatedCommitSig")
 	proto.RegisterType((*ExtendedCommit)(nil), "cometbft.types.v2.ExtendedCommit")
 	proto.RegisterType((*ExtendedCommitSig)(nil), "cometbft.types.v2.ExtendedCommitSig")
 	proto.RegisterType((*Proposal)(nil), "cometbft.types.v2.Proposal")
@@ -1245,98 +1380,106 @@ func init() {
 	proto.RegisterType((*LightBlock)(nil), "cometbft.types.v2.LightBlock")
 	proto.RegisterType((*BlockMeta)(nil), "cometbft.types.v2.BlockMeta")
 	proto.RegisterType((*TxProof)(nil), "cometbft.types.v2.TxProof")
+	proto.RegisterType((*TxMultiProof)(nil), "cometbft.types.v2.TxMultiProof")
 }
 
 func init() { proto.RegisterFile("cometbft/types/v2/types.proto", fileDescriptor_b33958ab5ece188f) }
 
 var fileDescriptor_b33958ab5ece188f = []byte{
-	// 1363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x1b, 0xff, 0x79, 0xb6, 0x13, 0x67, 0x89, 0xa8, 0xe3, 0xb6, 0x8e, 0x31, 0xff,
-	0x4c, 0x41, 0x76, 0x6b, 0x40, 0x80, 0x90, 0x90, 0xea, 0x24, 0x6d, 0x23, 0x9a, 0xc4, 0x5a, 0xbb,
-	0x45, 0xc0, 0x61, 0xb5, 0xf6, 0x4e, 0xec, 0x55, 0xed, 0x9d, 0xd5, 0xee, 0xd8, 0x24, 0xfd, 0x04,
-	0xa8, 0xa7, 0x1e, 0x39, 0x50, 0x09, 0x09, 0x0e, 0x7c, 0x01, 0xbe, 0x00, 0xe2, 0xd0, 0x63, 0x6f,
-	0x70, 0x2a, 0x28, 0xb9, 0xf0, 0x31, 0xd0, 0xbc, 0x99, 0xdd, 0xb5, 0x63, 0x87, 0x96, 0xb6, 0x02,
-	0x89, 0xdb, 0xcc, 0x7b, 0xbf, 0xf7, 0xe6, 0xed, 0x7b, 0xbf, 0x79, 0xfb, 0x06, 0x2e, 0x76, 0xe9,
-	0x90, 0xb0, 0xce, 0x01, 0xab, 0xb1, 0x23, 0x97, 0xf8, 0xb5, 0x71, 0x5d, 0x2c, 0xaa, 0xae, 0x47,
-	0x19, 0xd5, 0x56, 0x03, 0x75, 0x55, 0x48, 0xc7, 0xf5, 0x42, 0x31, 0xb4, 0xe8, 0x7a, 0x47, 0x2e,
-	0xa3, 0xb5, 0xf1, 0x95, 0x9a, 0xeb, 0x51, 0x7a, 0x20, 0x4c, 0x0a, 0xaf, 0xcc, 0x7a, 0x1c, 0x9b,
-	0x03, 0xdb, 0x32, 0x19, 0xf5, 0x24, 0x64, 0x23, 0x84, 0x8c, 0x89, 0xe7, 0xdb, 0xd4, 0xe1, 0x3e,
-	0x26, 0x8e, 0x2d, 0xac, 0xf5, 0x68, 0x8f, 0xe2, 0xb2, 0xc6, 0x57, 0x81, 0x59, 0x8f, 0xd2, 0xde,
-	0x80, 0xd4, 0x70, 0xd7, 0x19, 0x1d, 0xd4, 0x98, 0x3d, 0x24, 0x3e, 0x33, 0x87, 0xae, 0x00, 0x94,
-	0x3f, 0x82, 0x6c, 0xd3, 0xf4, 0x58, 0x8b, 0xb0, 0x1b, 0xc4, 0xb4, 0x88, 0xa7, 0xad, 0xc1, 0x12,
-	0xa3, 0xcc, 0x1c, 0xe4, 0x95, 0x92, 0x52, 0xc9, 0xea, 0x62, 0xa3, 0x69, 0xa0, 0xf6, 0x4d, 0xbf,
-	0x9f, 0x5f, 0x2c, 0x29, 0x95, 0x8c, 0x8e, 0xeb, 0xb2, 0x0d, 0x2a, 0x37, 0xe5, 0x16, 0xb6, 0x63,
-	0x91, 0xc3, 0xc0, 0x02, 0x37, 0x5c, 0xda, 0x39, 0x62, 0xc4, 0x97, 0x26, 0x62, 0xa3, 0xbd, 0x0f,
-	0x4b, 0xf8, 0xe1, 0xf9, 0x58, 0x49, 0xa9, 0xa4, 0xeb, 0xeb, 0xd5, 0x30, 0x59, 0x22, 0x33, 0xd5,
-	0xf1, 0x95, 0x6a, 0x93, 0x03, 0x1a, 0xea, 0xc3, 0xc7, 0x1b, 0x0b, 0xba, 0x40, 0x97, 0x87, 0x90,
-	0x68, 0x0c, 0x68, 0xf7, 0xce, 0xce, 0x56, 0x18, 0x89, 0x12, 0x45, 0xa2, 0xed, 0xc1, 0x8a, 0x6b,
-	0x7a, 0xcc, 0xf0, 0x09, 0x33, 0xfa, 0xf8, 0x19, 0x78, 0x6a, 0xba, 0x5e, 0xaa, 0xce, 0x14, 0xa3,
-	0x3a, 0xf5, 0xb9, 0xf2, 0x98, 0xac, 0x3b, 0x29, 0x2c, 0xff, 0xa9, 0x42, 0x5c, 0xa6, 0xe3, 0x13,
-	0x48, 0xc8, 0x84, 0xe3, 0x89, 0xe9, 0x7a, 0x31, 0x72, 0x29, 0x15, 0x3c, 0xe6, 0x4d, 0xea, 0xf8,
-	0xc4, 0xf1, 0x47, 0xbe, 0x74, 0x18, 0x18, 0x69, 0x6f, 0x40, 0xb2, 0xdb, 0x37, 0x6d, 0xc7, 0xb0,
-	0x2d, 0x8c, 0x29, 0xd5, 0x48, 0x1f, 0x3f, 0xde, 0x48, 0x6c, 0x72, 0xd9, 0xce, 0x96, 0x9e, 0x40,
-	0xe5, 0x8e, 0xa5, 0xbd, 0x0c, 0xf1, 0x3e, 0xb1, 0x7b, 0x7d, 0x86, 0x99, 0x89, 0xe9, 0x72, 0xa7,
-	0x7d, 0x08, 0x2a, 0x2f, 0x59, 0x5e, 0xc5, 0xc3, 0x0b, 0x55, 0x51, 0xcf, 0x6a, 0x50, 0xcf, 0x6a,
-	0x3b, 0xa8, 0x67, 0x23, 0xc9, 0x0f, 0xbe, 0xff, 0xfb, 0x86, 0xa2, 0xa3, 0x85, 0xb6, 0x05, 0xd9,
-	0x81, 0xe9, 0x33, 0xa3, 0xc3, 0x13, 0xc7, 0x8f, 0x5f, 0x92, 0x2e, 0x66, 0x53, 0x22, 0x73, 0x2b,
-	0x63, 0x4f, 0x73, 0x33, 0x21, 0xb2, 0xb4, 0x0a, 0xe4, 0xd0, 0x4b, 0x97, 0x0e, 0x87, 0x36, 0x33,
-	0x30, 0xf5, 0x71, 0x4c, 0xfd, 0x32, 0x97, 0x6f, 0xa2, 0xf8, 0x06, 0x2f, 0xc2, 0x79, 0x48, 0x59,
-	0x26, 0x33, 0x05, 0x24, 0x81, 0x90, 0x24, 0x17, 0xa0, 0xf2, 0x4d, 0x58, 0x09, 0x19, 0xed, 0x0b,
-	0x48, 0x52, 0x78, 0x89, 0xc4, 0x08, 0xbc, 0x0c, 0x6b, 0x0e, 0x39, 0x64, 0xc6, 0x69, 0x74, 0x0a,
-	0xd1, 0x1a, 0xd7, 0xdd, 0x9e, 0xb6, 0x78, 0x1d, 0x96, 0xbb, 0x41, 0xf6, 0x05, 0x16, 0x10, 0x9b,
-	0x0d, 0xa5, 0x08, 0x5b, 0x87, 0xa4, 0xe9, 0xba, 0x02, 0x90, 0x46, 0x40, 0xc2, 0x74, 0x5d, 0x54,
-	0x5d, 0x82, 0x55, 0xfc, 0x46, 0x8f, 0xf8, 0xa3, 0x01, 0x93, 0x4e, 0x32, 0x88, 0x59, 0xe1, 0x0a,
-	0x5d, 0xc8, 0x11, 0xfb, 0x2a, 0x64, 0xc9, 0xd8, 0xb6, 0x88, 0xd3, 0x25, 0x02, 0x97, 0x45, 0x5c,
-	0x26, 0x10, 0x22, 0xe8, 0x2d, 0xc8, 0xb9, 0x1e, 0x75, 0xa9, 0x4f, 0x3c, 0xc3, 0xb4, 0x2c, 0x8f,
-	0xf8, 0x7e, 0x7e, 0x59, 0xf8, 0x0b, 0xe4, 0x57, 0x85, 0xb8, 0x9c, 0x07, 0x75, 0xcb, 0x64, 0xa6,
-	0x96, 0x83, 0x18, 0x3b, 0xf4, 0xf3, 0x4a, 0x29, 0x56, 0xc9, 0xe8, 0x7c, 0x59, 0xfe, 0x4e, 0x05,
-	0xf5, 0x36, 0x65, 0x44, 0x7b, 0x0f, 0x54, 0x5e, 0x29, 0xe4, 0xdf, 0xf2, 0x5c, 0x4a, 0xb7, 0xec,
-	0x9e, 0x43, 0xac, 0x5d, 0xbf, 0xd7, 0x3e, 0x72, 0x89, 0x8e, 0xe8, 0x09, 0x42, 0x2d, 0x4e, 0x11,
-	0x6a, 0x0d, 0x96, 0x3c, 0x3a, 0x72, 0x2c, 0xe4, 0xd9, 0x92, 0x2e, 0x36, 0xda, 0x35, 0x48, 0x86,
-	0x3c, 0x51, 0x9f, 0xc8, 0x93, 0x15, 0xce, 0x13, 0x4e, 0x63, 0x29, 0xd0, 0x13, 0x1d, 0x49, 0x97,
-	0x06, 0xa4, 0xc2, 0x0e, 0x13, 0x12, 0xee, 0x69, 0x38, 0x1b, 0x99, 0x69, 0x6f, 0xc3, 0x6a, 0x58,
-	0xfd, 0x30, 0x7d, 0x82, 0x73, 0xb9, 0x50, 0x21, 0xf3, 0x37, 0x45, 0x2c, 0x43, 0xb4, 0xa1, 0x04,
-	0x7e, 0x58, 0x44, 0xac, 0x1d, 0xec, 0x47, 0x17, 0x20, 0xe5, 0xdb, 0x3d, 0xc7, 0x64, 0x23, 0x8f,
-	0x48, 0xee, 0x45, 0x02, 0xae, 0x25, 0x87, 0x8c, 0x38, 0x78, 0xd1, 0x05, 0xd7, 0x22, 0x81, 0x56,
-	0x83, 0x97, 0xc2, 0x8d, 0x11, 0x79, 0x11, 0x3c, 0xd3, 0x42, 0x55, 0x2b, 0x74, 0x57, 0x81, 0x9c,
-	0x43, 0x1d, 0xc3, 0x73, 0x8d, 0xc8, 0xab, 0x20, 0xdd, 0xb2, 0x43, 0x1d, 0xdd, 0xdd, 0x0e, 0x5d,
-	0x7f, 0x0c, 0x85, 0xd3, 0xc8, 0x89, 0x13, 0x04, 0x09, 0xcf, 0x4d, 0xdb, 0x84, 0xc7, 0x94, 0x7f,
-	0x56, 0x20, 0x2e, 0x6e, 0xe0, 0x44, 0xb9, 0x95, 0xf9, 0xe5, 0x5e, 0x3c, 0xab, 0xdc, 0xb1, 0xe7,
-	0x2a, 0x37, 0x84, 0xc1, 0xfa, 0x79, 0xb5, 0x14, 0xab, 0xa4, 0xeb, 0x17, 0xe6, 0x78, 0x12, 0x41,
-	0xb6, 0xec, 0x9e, 0x6c, 0x31, 0x13, 0x56, 0xe5, 0xc7, 0x0a, 0xa4, 0x42, 0xbd, 0xd6, 0x80, 0x6c,
-	0x10, 0x99, 0x71, 0x30, 0x30, 0x7b, 0x92, 0xf5, 0xc5, 0xb3, 0xc3, 0xbb, 0x36, 0x30, 0x7b, 0x7a,
-	0x5a, 0x46, 0xc4, 0x37, 0xf3, 0x09, 0xb4, 0x78, 0x06, 0x81, 0xa6, 0x18, 0x1b, 0x7b, 0x36, 0xc6,
-	0x4e, 0x71, 0x4b, 0x3d, 0xc5, 0xad, 0xf2, 0x89, 0x02, 0xcb, 0x58, 0x3c, 0x8b, 0x58, 0xff, 0x69,
-	0xb5, 0xbe, 0x94, 0x34, 0xb6, 0x88, 0x65, 0xcc, 0x94, 0xed, 0xb5, 0x39, 0x2e, 0xa7, 0xa3, 0x8e,
-	0xca, 0xa7, 0x05, 0x6e, 0x5a, 0x51, 0x19, 0xbf, 0x8d, 0xc1, 0xea, 0x0c, 0xfe, 0x7f, 0x58, 0xce,
-	0xe9, 0x56, 0xb1, 0xf4, 0x94, 0xad, 0x22, 0xfe, 0x8f, 0x5a, 0x45, 0xe2, 0x19, 0x5a, 0x45, 0xf2,
-	0xef, 0x5b, 0xc5, 0x4f, 0x8b, 0x90, 0x6c, 0xe2, 0xbf, 0xc7, 0x1c, 0xfc, 0x2b, 0x7f, 0x94, 0xf3,
-	0x90, 0x72, 0xe9, 0xc0, 0x10, 0x1a, 0x15, 0x35, 0x49, 0x97, 0x0e, 0xf4, 0x19, 0x46, 0x2f, 0xbd,
-	0xa8, 0xdf, 0x4d, 0xfc, 0x05, 0x54, 0x3b, 0x71, 0xfa, 0xf2, 0x32, 0xc8, 0x88, 0x5c, 0xc8, 0x79,
-	0xf0, 0x0a, 0x4f, 0x02, 0x4e, 0x98, 0xca, 0xe9, 0x09, 0x36, 0x8c, 0x5b, 0x40, 0x75, 0x09, 0xe4,
-	0x26, 0x62, 0x7a, 0x92, 0x43, 0xe9, 0xfa, 0x99, 0x0d, 0x52, 0x97, 0xc0, 0xf2, 0x37, 0x0a, 0xc0,
-	0x4d, 0x9e, 0x5c, 0xfc, 0x62, 0x3e, 0xca, 0xf9, 0x18, 0x84, 0x31, 0x75, 0xf6, 0xc6, 0x99, 0x85,
-	0x93, 0x11, 0x64, 0xfc, 0xc9, 0xd0, 0xb7, 0x20, 0x1b, 0xdd, 0x23, 0x9f, 0x04, 0xe1, 0xcc, 0xf3,
-	0x12, 0x8e, 0x58, 0x2d, 0xc2, 0xf4, 0xcc, 0x78, 0x62, 0x57, 0xfe, 0x45, 0x81, 0x14, 0x46, 0xb5,
-	0x4b, 0x98, 0x39, 0x55, 0x48, 0xe5, 0x39, 0x0a, 0x79, 0x11, 0x40, 0xf8, 0xf1, 0xed, 0xbb, 0x44,
-	0xf2, 0x2b, 0x85, 0x92, 0x96, 0x7d, 0x97, 0x68, 0x1f, 0x84, 0x59, 0x8f, 0x3d, 0x21, 0xeb, 0xb2,
-	0x43, 0x05, 0xb9, 0x3f, 0x07, 0x09, 0x67, 0x34, 0x34, 0xf8, 0x68, 0xa5, 0x0a, 0xd2, 0x3a, 0xa3,
-	0x61, 0xfb, 0xd0, 0x2f, 0xdf, 0x81, 0x44, 0xfb, 0x10, 0x5f, 0x1a, 0x9c, 0xa9, 0x1e, 0xa5, 0x72,
-	0xb6, 0x15, 0xcf, 0x8a, 0x24, 0x17, 0xe0, 0x28, 0xa7, 0x81, 0xca, 0x87, 0xd8, 0xe0, 0xe1, 0xc3,
-	0xd7, 0x5a, 0xed, 0x69, 0x1f, 0x31, 0xf2, 0xf9, 0x72, 0xe9, 0x57, 0x05, 0xb2, 0x53, 0x37, 0x4a,
-	0x7b, 0x07, 0xce, 0xb5, 0x76, 0xae, 0xef, 0x6d, 0x6f, 0x19, 0xbb, 0xad, 0xeb, 0x46, 0xfb, 0xf3,
-	0xe6, 0xb6, 0x71, 0x6b, 0xef, 0xd3, 0xbd, 0xfd, 0xcf, 0xf6, 0x72, 0x0b, 0x85, 0x95, 0x7b, 0x0f,
-	0x4a, 0xe9, 0x5b, 0xce, 0x1d, 0x87, 0x7e, 0xe5, 0x9c, 0x85, 0x6e, 0xea, 0xdb, 0xb7, 0xf7, 0xdb,
-	0xdb, 0x39, 0x45, 0xa0, 0x9b, 0x1e, 0x19, 0x53, 0x46, 0x10, 0x7d, 0x19, 0xd6, 0xe7, 0xa0, 0x37,
-	0xf7, 0x77, 0x77, 0x77, 0xda, 0xb9, 0xc5, 0xc2, 0xea, 0xbd, 0x07, 0xa5, 0x6c, 0xd3, 0x23, 0x82,
-	0x6a, 0x68, 0x51, 0x85, 0xfc, 0xac, 0xc5, 0x7e, 0x73, 0xbf, 0x75, 0xf5, 0x66, 0xae, 0x54, 0xc8,
-	0xdd, 0x7b, 0x50, 0xca, 0x04, 0xbd, 0x83, 0xe3, 0x0b, 0xc9, 0xaf, 0xbf, 0x2f, 0x2e, 0xfc, 0xf8,
-	0x43, 0x51, 0x69, 0xdc, 0x7c, 0x78, 0x5c, 0x54, 0x1e, 0x1d, 0x17, 0x95, 0x3f, 0x8e, 0x8b, 0xca,
-	0xfd, 0x93, 0xe2, 0xc2, 0xa3, 0x93, 0xe2, 0xc2, 0x6f, 0x27, 0xc5, 0x85, 0x2f, 0xea, 0x3d, 0x9b,
-	0xf5, 0x47, 0x1d, 0x9e, 0x9b, 0x5a, 0xf4, 0xfc, 0x0d, 0x16, 0xa6, 0x6b, 0xd7, 0x66, 0x1e, 0xbd,
-	0x9d, 0x38, 0xde, 0xd9, 0x77, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x67, 0x89, 0x32, 0x06, 0x62,
-	0x0f, 0x00, 0x00,
+	// 1473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x16, 0xcf, 0xda, 0xeb, 0x7f, 0xc7, 0x76, 0xe2, 0x6c, 0x73, 0x6f, 0x5d, 0xb7, 0x75, 0x7c, 0x7d,
+	0xef, 0x2d, 0xa1, 0x20, 0xbb, 0x35, 0x45, 0x80, 0x90, 0x40, 0x71, 0x92, 0xb6, 0x11, 0x4d, 0x62,
+	0xad, 0xdd, 0x22, 0xe0, 0x61, 0xb5, 0xf6, 0x4e, 0xd6, 0xab, 0xda, 0x3b, 0xab, 0xdd, 0xb1, 0x71,
+	0xfa, 0x09, 0x50, 0x9f, 0xfa, 0xc8, 0x03, 0x95, 0x90, 0x00, 0x89, 0x2f, 0x80, 0xc4, 0x07, 0xe0,
+	0xa1, 0x8f, 0x7d, 0x83, 0xa7, 0x82, 0x92, 0x17, 0x3e, 0x06, 0x9a, 0x3f, 0xbb, 0xeb, 0x75, 0x6c,
+	0xfa, 0x57, 0x20, 0xf1, 0x36, 0x73, 0xe6, 0x77, 0x7e, 0xe7, 0xcc, 0x39, 0xbf, 0x19, 0xcf, 0x1a,
+	0x2e, 0xf6, 0xf0, 0x10, 0x91, 0xee, 0x21, 0xa9, 0x93, 0x23, 0x07, 0x79, 0xf5, 0x71, 0x83, 0x0f,
+	0x6a, 0x8e, 0x8b, 0x09, 0x56, 0x56, 0xfd, 0xe5, 0x1a, 0xb7, 0x8e, 0x1b, 0xa5, 0x72, 0xe0, 0xd1,
+	0x73, 0x8f, 0x1c, 0x82, 0xeb, 0xe3, 0xab, 0x75, 0xc7, 0xc5, 0xf8, 0x90, 0xbb, 0x94, 0xfe, 0x13,
+	0xac, 0x0f, 0xac, 0xae, 0x57, 0xef, 0x5a, 0xc4, 0xa3, 0x90, 0x29, 0xd6, 0x29, 0x48, 0x10, 0x74,
+	0xac, 0x0f, 0x2c, 0x43, 0x27, 0xd8, 0x15, 0x90, 0xf5, 0x00, 0x32, 0x46, 0xae, 0x67, 0x61, 0x7b,
+	0x96, 0x63, 0xcd, 0xc4, 0x26, 0x66, 0xc3, 0x3a, 0x1d, 0xf9, 0x6e, 0x26, 0xc6, 0xe6, 0x00, 0xd5,
+	0xd9, 0xac, 0x3b, 0x3a, 0xac, 0x13, 0x6b, 0x88, 0x3c, 0xa2, 0x0f, 0x1d, 0x0e, 0xa8, 0xbe, 0x07,
+	0xf9, 0x96, 0xee, 0x92, 0x36, 0x22, 0x37, 0x91, 0x6e, 0x20, 0x57, 0x59, 0x83, 0x04, 0xc1, 0x44,
+	0x1f, 0x14, 0xa5, 0x8a, 0xb4, 0x91, 0x57, 0xf9, 0x44, 0x51, 0x40, 0xee, 0xeb, 0x5e, 0xbf, 0x18,
+	0xab, 0x48, 0x1b, 0x39, 0x95, 0x8d, 0xab, 0x16, 0xc8, 0xd4, 0x95, 0x7a, 0x58, 0xb6, 0x81, 0x26,
+	0xbe, 0x07, 0x9b, 0x50, 0x6b, 0xf7, 0x88, 0x20, 0x4f, 0xb8, 0xf0, 0x89, 0xf2, 0x36, 0x24, 0x58,
+	0x6d, 0x8a, 0xf1, 0x8a, 0xb4, 0x91, 0x6d, 0x9c, 0xab, 0x05, 0xf5, 0xe4, 0xc5, 0xab, 0x8d, 0xaf,
+	0xd6, 0x5a, 0x14, 0xd0, 0x94, 0x1f, 0x3d, 0x59, 0x5f, 0x52, 0x39, 0xba, 0x3a, 0x84, 0x54, 0x73,
+	0x80, 0x7b, 0x77, 0x77, 0xb7, 0x83, 0x4c, 0xa4, 0x30, 0x13, 0x65, 0x1f, 0x56, 0x1c, 0xdd, 0x25,
+	0x9a, 0x87, 0x88, 0xd6, 0x67, 0xdb, 0x60, 0x51, 0xb3, 0x8d, 0x4a, 0xed, 0x54, 0xbf, 0x6a, 0x91,
+	0xed, 0x8a, 0x30, 0x79, 0x67, 0xda, 0x58, 0xfd, 0x5d, 0x86, 0xa4, 0x28, 0xc7, 0x07, 0x90, 0x12,
+	0x05, 0x67, 0x11, 0xb3, 0x8d, 0x72, 0x48, 0x29, 0x16, 0x68, 0xce, 0x5b, 0xd8, 0xf6, 0x90, 0xed,
+	0x8d, 0x3c, 0x41, 0xe8, 0x3b, 0x29, 0x97, 0x20, 0xdd, 0xeb, 0xeb, 0x96, 0xad, 0x59, 0x06, 0xcb,
+	0x29, 0xd3, 0xcc, 0x1e, 0x3f, 0x59, 0x4f, 0x6d, 0x51, 0xdb, 0xee, 0xb6, 0x9a, 0x62, 0x8b, 0xbb,
+	0x86, 0xf2, 0x6f, 0x48, 0xf6, 0x91, 0x65, 0xf6, 0x09, 0xab, 0x4c, 0x5c, 0x15, 0x33, 0xe5, 0x5d,
+	0x90, 0x69, 0xcb, 0x8a, 0x32, 0x0b, 0x5e, 0xaa, 0xf1, 0x7e, 0xd6, 0xfc, 0x7e, 0xd6, 0x3a, 0x7e,
+	0x3f, 0x9b, 0x69, 0x1a, 0xf8, 0xc1, 0xaf, 0xeb, 0x92, 0xca, 0x3c, 0x94, 0x6d, 0xc8, 0x0f, 0x74,
+	0x8f, 0x68, 0x5d, 0x5a, 0x38, 0x1a, 0x3e, 0x21, 0x28, 0x4e, 0x97, 0x44, 0xd4, 0x56, 0xe4, 0x9e,
+	0xa5, 0x6e, 0xdc, 0x64, 0x28, 0x1b, 0x50, 0x60, 0x2c, 0x3d, 0x3c, 0x1c, 0x5a, 0x44, 0x63, 0xa5,
+	0x4f, 0xb2, 0xd2, 0x2f, 0x53, 0xfb, 0x16, 0x33, 0xdf, 0xa4, 0x4d, 0x38, 0x0f, 0x19, 0x43, 0x27,
+	0x3a, 0x87, 0xa4, 0x18, 0x24, 0x4d, 0x0d, 0x6c, 0xf1, 0x35, 0x58, 0x09, 0x14, 0xed, 0x71, 0x48,
+	0x9a, 0xb3, 0x84, 0x66, 0x06, 0xbc, 0x02, 0x6b, 0x36, 0x9a, 0x10, 0x6d, 0x16, 0x9d, 0x61, 0x68,
+	0x85, 0xae, 0xdd, 0x89, 0x7a, 0xfc, 0x1f, 0x96, 0x7b, 0x7e, 0xf5, 0x39, 0x16, 0x18, 0x36, 0x1f,
+	0x58, 0x19, 0xec, 0x1c, 0xa4, 0x75, 0xc7, 0xe1, 0x80, 0x2c, 0x03, 0xa4, 0x74, 0xc7, 0x61, 0x4b,
+	0x97, 0x61, 0x95, 0xed, 0xd1, 0x45, 0xde, 0x68, 0x40, 0x04, 0x49, 0x8e, 0x61, 0x56, 0xe8, 0x82,
+	0xca, 0xed, 0x0c, 0xfb, 0x5f, 0xc8, 0xa3, 0xb1, 0x65, 0x20, 0xbb, 0x87, 0x38, 0x2e, 0xcf, 0x70,
+	0x39, 0xdf, 0xc8, 0x40, 0xaf, 0x43, 0xc1, 0x71, 0xb1, 0x83, 0x3d, 0xe4, 0x6a, 0xba, 0x61, 0xb8,
+	0xc8, 0xf3, 0x8a, 0xcb, 0x9c, 0xcf, 0xb7, 0x6f, 0x72, 0x73, 0xb5, 0x08, 0xf2, 0xb6, 0x4e, 0x74,
+	0xa5, 0x00, 0x71, 0x32, 0xf1, 0x8a, 0x52, 0x25, 0xbe, 0x91, 0x53, 0xe9, 0xb0, 0xfa, 0xb5, 0x0c,
+	0xf2, 0x1d, 0x4c, 0x90, 0x72, 0x0d, 0x64, 0xda, 0x29, 0xa6, 0xbf, 0xe5, 0xb9, 0x92, 0x6e, 0x5b,
+	0xa6, 0x8d, 0x8c, 0x3d, 0xcf, 0xec, 0x1c, 0x39, 0x48, 0x65, 0xe8, 0x29, 0x41, 0xc5, 0x22, 0x82,
+	0x5a, 0x83, 0x84, 0x8b, 0x47, 0xb6, 0xc1, 0x74, 0x96, 0x50, 0xf9, 0x44, 0xb9, 0x0e, 0xe9, 0x40,
+	0x27, 0xf2, 0x53, 0x75, 0xb2, 0x42, 0x75, 0x42, 0x65, 0x2c, 0x0c, 0x6a, 0xaa, 0x2b, 0xe4, 0xd2,
+	0x84, 0x4c, 0x70, 0xc3, 0x14, 0x13, 0xcf, 0xa1, 0xd9, 0xd0, 0x4d, 0x79, 0x03, 0x56, 0x83, 0xee,
+	0x07, 0xe5, 0xe3, 0x9a, 0x2b, 0x04, 0x0b, 0xa2, 0x7e, 0x11, 0x61, 0x69, 0xfc, 0x1a, 0x4a, 0xb1,
+	0x8d, 0x85, 0xc2, 0xda, 0xa5, 0x56, 0xe5, 0x02, 0x64, 0x3c, 0xcb, 0xb4, 0x75, 0x32, 0x72, 0x91,
+	0xd0, 0x5e, 0x68, 0xa0, 0xab, 0x68, 0x42, 0x90, 0xcd, 0x0e, 0x3a, 0xd7, 0x5a, 0x68, 0x50, 0xea,
+	0x70, 0x26, 0x98, 0x68, 0x21, 0x0b, 0xd7, 0x99, 0x12, 0x2c, 0xb5, 0x03, 0xba, 0x0d, 0x28, 0xd8,
+	0xd8, 0xd6, 0x5c, 0x47, 0x0b, 0x59, 0xb9, 0xe8, 0x96, 0x6d, 0x6c, 0xab, 0xce, 0x4e, 0x40, 0xfd,
+	0x3e, 0x94, 0x66, 0x91, 0x53, 0x11, 0xb8, 0x08, 0xcf, 0x46, 0x7d, 0x82, 0x30, 0xd5, 0xef, 0x62,
+	0x90, 0xe4, 0x27, 0x70, 0xaa, 0xdd, 0xd2, 0xfc, 0x76, 0xc7, 0x16, 0xb5, 0x3b, 0xfe, 0x52, 0xed,
+	0x86, 0x20, 0x59, 0xaf, 0x28, 0x57, 0xe2, 0x1b, 0xd9, 0xc6, 0x85, 0x39, 0x4c, 0x3c, 0xc9, 0xb6,
+	0x65, 0x8a, 0x2b, 0x66, 0xca, 0x4b, 0xd1, 0xe1, 0x5f, 0xba, 0x69, 0xba, 0xc8, 0xd4, 0x09, 0x32,
+	0xb4, 0x29, 0xba, 0x04, 0xa3, 0xbb, 0x34, 0x87, 0x6e, 0x33, 0xc0, 0xcf, 0x12, 0xaf, 0x85, 0x54,
+	0x41, 0x99, 0xbc, 0xea, 0x13, 0x09, 0x32, 0x01, 0x52, 0x69, 0x42, 0xde, 0xdf, 0xbc, 0x76, 0x38,
+	0xd0, 0x4d, 0x71, 0xb0, 0xca, 0x8b, 0x2b, 0x70, 0x7d, 0xa0, 0x9b, 0x6a, 0x56, 0x6c, 0x9a, 0x4e,
+	0xe6, 0x6b, 0x34, 0xb6, 0x40, 0xa3, 0x91, 0x43, 0x11, 0x7f, 0xb1, 0x43, 0x11, 0x91, 0xaf, 0x3c,
+	0x23, 0xdf, 0xea, 0x8f, 0x12, 0x9c, 0x99, 0x53, 0x94, 0x57, 0xb2, 0xd5, 0x0f, 0x01, 0xc2, 0xcb,
+	0x58, 0xfc, 0xae, 0xae, 0x87, 0x04, 0xf4, 0x51, 0x53, 0xa3, 0x8f, 0x1a, 0xfa, 0x33, 0xd8, 0xb4,
+	0xc8, 0xa6, 0xeb, 0xea, 0x47, 0xea, 0x94, 0x4b, 0x34, 0xf5, 0xf8, 0x6c, 0xea, 0x27, 0x12, 0x2c,
+	0x33, 0x69, 0x1b, 0xc8, 0xf8, 0x5b, 0xb5, 0xfc, 0x99, 0x38, 0xe4, 0x46, 0x54, 0x85, 0x5c, 0xd4,
+	0xff, 0x9b, 0x43, 0x19, 0xcd, 0x3a, 0xd4, 0xa0, 0xe2, 0xd3, 0x4c, 0x29, 0xf0, 0xab, 0x38, 0xac,
+	0x9e, 0xc2, 0xff, 0x03, 0x95, 0x18, 0xbd, 0x48, 0x13, 0xcf, 0x78, 0x91, 0x26, 0x9f, 0xeb, 0x22,
+	0x4d, 0xbd, 0xc0, 0x45, 0x9a, 0xfe, 0xf3, 0x8b, 0xf4, 0x87, 0x18, 0xa4, 0x5b, 0xec, 0x97, 0x59,
+	0x1f, 0xfc, 0x25, 0xbf, 0xb7, 0xe7, 0x21, 0xe3, 0xe0, 0x81, 0xc6, 0x57, 0x64, 0xb6, 0x92, 0x76,
+	0xf0, 0x40, 0x3d, 0xa5, 0xe8, 0xc4, 0xab, 0xfa, 0x31, 0x4e, 0xbe, 0x82, 0x6e, 0xa7, 0x66, 0x0f,
+	0x2f, 0x81, 0x1c, 0xaf, 0x85, 0x78, 0x2d, 0x5f, 0xa5, 0x45, 0xa0, 0xa3, 0xa2, 0x34, 0xfb, 0xbe,
+	0x0f, 0xf2, 0xe6, 0x50, 0x35, 0xd9, 0x0f, 0x5c, 0xf8, 0xdb, 0xb2, 0x18, 0x5b, 0xe8, 0xc2, 0x4f,
+	0x8c, 0x2a, 0x80, 0xd5, 0x2f, 0x25, 0x80, 0x5b, 0xb4, 0xb8, 0x6c, 0xc7, 0xf4, 0xa1, 0xeb, 0xb1,
+	0x24, 0xb4, 0x48, 0xec, 0xf5, 0x85, 0x8d, 0x13, 0x19, 0xe4, 0xbc, 0xe9, 0xd4, 0xb7, 0x21, 0x1f,
+	0x9e, 0x23, 0x0f, 0x91, 0x62, 0x6c, 0x21, 0x4b, 0xf0, 0x00, 0x6d, 0x23, 0xa2, 0xe6, 0xc6, 0x53,
+	0xb3, 0xea, 0x4f, 0x12, 0x64, 0x58, 0x56, 0x7b, 0x88, 0xe8, 0x91, 0x46, 0x4a, 0x2f, 0xd1, 0xc8,
+	0x8b, 0x00, 0x9c, 0xc7, 0xb3, 0xee, 0x21, 0xa1, 0xaf, 0x0c, 0xb3, 0xb4, 0xad, 0x7b, 0x48, 0x79,
+	0x27, 0xa8, 0x7a, 0xfc, 0x29, 0x55, 0x17, 0x37, 0x94, 0x5f, 0xfb, 0xb3, 0x90, 0xb2, 0x47, 0x43,
+	0x8d, 0x3e, 0x3c, 0x65, 0x2e, 0x5a, 0x7b, 0x34, 0xec, 0x4c, 0xbc, 0xea, 0x5d, 0x48, 0x75, 0x26,
+	0xec, 0x3b, 0x8c, 0x2a, 0xd5, 0xc5, 0x58, 0xbc, 0xfc, 0xf9, 0x47, 0x57, 0x9a, 0x1a, 0xd8, 0x43,
+	0x57, 0x01, 0x99, 0x3e, 0xf1, 0xfd, 0xcf, 0x42, 0x3a, 0x56, 0xea, 0xcf, 0xfa, 0x89, 0xe7, 0x7f,
+	0xdc, 0x8d, 0x20, 0xd7, 0x99, 0xec, 0x8d, 0x06, 0xc4, 0x7a, 0xae, 0x88, 0xf1, 0x20, 0xe2, 0xb5,
+	0x68, 0xc4, 0xf2, 0xbc, 0x88, 0x21, 0xbf, 0x08, 0x7b, 0xf9, 0x67, 0x09, 0xf2, 0x91, 0x83, 0xac,
+	0xbc, 0x09, 0x67, 0xdb, 0xbb, 0x37, 0xf6, 0x77, 0xb6, 0xb5, 0xbd, 0xf6, 0x0d, 0xad, 0xf3, 0x49,
+	0x6b, 0x47, 0xbb, 0xbd, 0xff, 0xd1, 0xfe, 0xc1, 0xc7, 0xfb, 0x85, 0xa5, 0xd2, 0xca, 0xfd, 0x87,
+	0x95, 0xec, 0x6d, 0xfb, 0xae, 0x8d, 0x3f, 0xb7, 0x17, 0xa1, 0x5b, 0xea, 0xce, 0x9d, 0x83, 0xce,
+	0x4e, 0x41, 0xe2, 0xe8, 0x96, 0x8b, 0xc6, 0x98, 0x20, 0x86, 0xbe, 0x02, 0xe7, 0xe6, 0xa0, 0xb7,
+	0x0e, 0xf6, 0xf6, 0x76, 0x3b, 0x85, 0x58, 0x69, 0xf5, 0xfe, 0xc3, 0x4a, 0xbe, 0xe5, 0x22, 0xae,
+	0x70, 0xe6, 0x51, 0x83, 0xe2, 0x69, 0x8f, 0x83, 0xd6, 0x41, 0x7b, 0xf3, 0x56, 0xa1, 0x52, 0x2a,
+	0xdc, 0x7f, 0x58, 0xc9, 0xf9, 0x57, 0x16, 0xc5, 0x97, 0xd2, 0x5f, 0x7c, 0x53, 0x5e, 0xfa, 0xfe,
+	0xdb, 0xb2, 0xd4, 0xbc, 0xf5, 0xe8, 0xb8, 0x2c, 0x3d, 0x3e, 0x2e, 0x4b, 0xbf, 0x1d, 0x97, 0xa5,
+	0x07, 0x27, 0xe5, 0xa5, 0xc7, 0x27, 0xe5, 0xa5, 0x5f, 0x4e, 0xca, 0x4b, 0x9f, 0x36, 0x4c, 0x8b,
+	0xf4, 0x47, 0x5d, 0x5a, 0xa0, 0x7a, 0xf8, 0xb7, 0x85, 0x3f, 0xd0, 0x1d, 0xab, 0x7e, 0xea, 0x9f,
+	0x88, 0x6e, 0x92, 0x5d, 0x15, 0x6f, 0xfd, 0x31, 0x00, 0xb4, 0x85, 0x6b, 0xa8, 0x1a, 0x11, 0x00,
+	0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1740,6 +1883,20 @@ func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregatedSignatures) > 0 {
+		for iNdEx := len(m.AggregatedSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatedSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Signatures) > 0 {
 		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1827,6 +1984,53 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregatedCommitSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedCommitSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedCommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Validators != nil {
+		{
+			size, err := m.Validators.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockIdFlag != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockIdFlag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExtendedCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1939,12 +2143,12 @@ func (m *ExtendedCommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.ValidatorAddress) > 0 {
@@ -1989,12 +2193,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -2226,6 +2430,57 @@ func (m *TxProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxMultiProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxMultiProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxMultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Data[iNdEx])
+			copy(dAtA[i:], m.Data[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Data[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -2428,6 +2683,12 @@ func (m *Commit) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.AggregatedSignatures) > 0 {
+		for _, e := range m.AggregatedSignatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2453,6 +2714,26 @@ func (m *CommitSig) Size() (n int) {
 	return n
 }
 
+func (m *AggregatedCommitSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockIdFlag != 0 {
+		n += 1 + sovTypes(uint64(m.BlockIdFlag))
+	}
+	if m.Validators != nil {
+		l = m.Validators.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *ExtendedCommit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2617,6 +2898,29 @@ func (m *TxProof) Size() (n int) {
 	return n
 }
 
+func (m *TxMultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4097,17 +4401,51 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignatures = append(m.AggregatedSignatures, AggregatedCommitSig{})
+			if err := m.AggregatedSignatures[len(m.AggregatedSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -4288,6 +4626,145 @@ func (m *CommitSig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AggregatedCommitSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregatedCommitSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregatedCommitSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockIdFlag", wireType)
+			}
+			m.BlockIdFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockIdFlag |= BlockIDFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validators == nil {
+				m.Validators = &v12.BitArray{}
+			}
+			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ExtendedCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5527,6 +6004,158 @@ func (m *TxProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxMultiProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxMultiProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxMultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &v1.MultiProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
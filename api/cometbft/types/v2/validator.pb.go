@@ -129,6 +129,9 @@ type Validator struct {
 	ProposerPriority int64         `protobuf:"varint,4,opt,name=proposer_priority,json=proposerPriority,proto3" json:"proposer_priority,omitempty"`
 	PubKeyBytes      []byte        `protobuf:"bytes,5,opt,name=pub_key_bytes,json=pubKeyBytes,proto3" json:"pub_key_bytes,omitempty"`
 	PubKeyType       string        `protobuf:"bytes,6,opt,name=pub_key_type,json=pubKeyType,proto3" json:"pub_key_type,omitempty"`
+	// Proof of the possession of the private key of a BLS12-381 validator,
+	// required to aggregate its signatures.
+	ProofOfPossession []byte `protobuf:"bytes,7,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -207,6 +210,13 @@ func (m *Validator) GetPubKeyType() string {
 	return ""
 }
 
+func (m *Validator) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
+	}
+	return nil
+}
+
 // SimpleValidator is a Validator, which is serialized and hashed in consensus.
 // Address is removed because it's redundant with the pubkey.
 // Proposer priority is removed because it changes every round.
@@ -272,41 +282,43 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v2/validator.proto", fileDescriptor_f9c0da8ae3e3b0d7) }
 
 var fileDescriptor_f9c0da8ae3e3b0d7 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x8f, 0xd2, 0x40,
-	0x18, 0x66, 0x40, 0xd9, 0xdd, 0x01, 0x15, 0x26, 0xbb, 0xa6, 0x69, 0xdc, 0xa6, 0xcb, 0x09, 0x3f,
-	0xd2, 0x06, 0x4c, 0x8c, 0x31, 0x5e, 0x28, 0xeb, 0x1a, 0x02, 0x5b, 0x08, 0xb0, 0x6b, 0xe2, 0xa5,
-	0x69, 0x61, 0xc4, 0x09, 0x85, 0x99, 0xb4, 0x43, 0x37, 0xfd, 0x07, 0x86, 0x93, 0x7f, 0x80, 0x93,
-	0x1e, 0xfc, 0x01, 0xfe, 0x08, 0x8f, 0x7b, 0xd3, 0xa3, 0x81, 0x1f, 0xe1, 0xd5, 0xb4, 0xa5, 0xc0,
-	0xa6, 0x1a, 0xbd, 0xbd, 0xf3, 0xbc, 0xcf, 0x33, 0xef, 0xf3, 0xbc, 0xc9, 0x0b, 0x4f, 0x06, 0x74,
-	0x82, 0xb9, 0xf5, 0x8e, 0xab, 0xdc, 0x67, 0xd8, 0x55, 0xbd, 0xaa, 0xea, 0x99, 0x36, 0x19, 0x9a,
-	0x9c, 0x3a, 0x0a, 0x73, 0x28, 0xa7, 0xa8, 0x18, 0x53, 0x94, 0x90, 0xa2, 0x78, 0x55, 0xf1, 0x78,
-	0xa3, 0x1a, 0x38, 0x3e, 0xe3, 0x54, 0xf5, 0x2a, 0xea, 0x18, 0xfb, 0x6e, 0xa4, 0x10, 0x0f, 0x47,
-	0x74, 0x44, 0xc3, 0x52, 0x0d, 0xaa, 0x08, 0x2d, 0x7d, 0x05, 0x30, 0x7f, 0x19, 0xff, 0xdd, 0xc3,
-	0x1c, 0xbd, 0x84, 0x70, 0x33, 0xcb, 0x15, 0x80, 0x9c, 0x29, 0xe7, 0xaa, 0x0f, 0x94, 0xc4, 0x34,
-	0x65, 0x23, 0xea, 0xee, 0xf0, 0xd1, 0x73, 0xb8, 0xcf, 0x1c, 0xca, 0xa8, 0x8b, 0x1d, 0x21, 0x2d,
-	0x83, 0x7f, 0x6a, 0x37, 0x6c, 0xf4, 0x04, 0x22, 0x4e, 0xb9, 0x69, 0x1b, 0x1e, 0xe5, 0x64, 0x3a,
-	0x32, 0x18, 0xbd, 0xc2, 0x8e, 0x90, 0x91, 0x41, 0x39, 0xd3, 0x2d, 0x84, 0x9d, 0xcb, 0xb0, 0xd1,
-	0x09, 0xf0, 0xd2, 0x2f, 0x00, 0x0f, 0x36, 0xbf, 0x20, 0x01, 0xee, 0x99, 0xc3, 0xa1, 0x83, 0xdd,
-	0xc0, 0x30, 0x28, 0xe7, 0xbb, 0xf1, 0x13, 0xbd, 0x80, 0x7b, 0x6c, 0x66, 0x19, 0x63, 0xec, 0xaf,
-	0xed, 0x1c, 0x6f, 0xed, 0x44, 0x5b, 0x52, 0xbc, 0x8a, 0xd2, 0x99, 0x59, 0x36, 0x19, 0x34, 0xb1,
-	0xaf, 0xa5, 0x05, 0xd0, 0xcd, 0xb2, 0x99, 0xd5, 0xc4, 0x3e, 0x3a, 0x81, 0xf9, 0x3f, 0x78, 0xc9,
-	0x79, 0x5b, 0x1b, 0xe8, 0x31, 0x2c, 0xc6, 0x01, 0x0c, 0xe6, 0x10, 0xea, 0x10, 0xee, 0x0b, 0xb7,
-	0x22, 0xcf, 0x71, 0xa3, 0xb3, 0xc6, 0x51, 0x09, 0xde, 0x59, 0x7b, 0x31, 0x2c, 0x9f, 0x63, 0x57,
-	0xb8, 0x1d, 0x7a, 0xcd, 0x45, 0xe3, 0xb4, 0x00, 0x42, 0x32, 0xcc, 0xc7, 0x9c, 0x60, 0x5b, 0x42,
-	0x56, 0x06, 0xe5, 0x83, 0x2e, 0x8c, 0x28, 0x7d, 0x9f, 0xe1, 0x92, 0x0d, 0xef, 0xf5, 0xc8, 0x84,
-	0xd9, 0x78, 0x1b, 0xff, 0xd9, 0x36, 0x24, 0xf8, 0x8f, 0x90, 0x7f, 0x0d, 0x98, 0x4e, 0x04, 0x7c,
-	0xf4, 0x1d, 0xc0, 0x9c, 0x66, 0xd3, 0xc1, 0xb8, 0x71, 0x7a, 0x66, 0x9b, 0x23, 0x54, 0x81, 0x47,
-	0x5a, 0xab, 0x5d, 0x6f, 0x1a, 0x8d, 0x53, 0xe3, 0xac, 0x55, 0x7b, 0x6d, 0x5c, 0xe8, 0x4d, 0xbd,
-	0xfd, 0x46, 0x2f, 0xa4, 0xc4, 0xfb, 0xf3, 0x85, 0x8c, 0x76, 0xb8, 0x17, 0xd3, 0xf1, 0x94, 0x5e,
-	0x4d, 0x91, 0x0a, 0x0f, 0x6f, 0x4a, 0x6a, 0x5a, 0xef, 0x95, 0xde, 0x2f, 0x00, 0xf1, 0x68, 0xbe,
-	0x90, 0x8b, 0x3b, 0x8a, 0x9a, 0xe5, 0xe2, 0x29, 0x4f, 0x0a, 0xea, 0xed, 0xf3, 0xf3, 0x46, 0xbf,
-	0x90, 0x4e, 0x08, 0xea, 0x74, 0x32, 0x21, 0x1c, 0x3d, 0x84, 0xc5, 0x9b, 0x02, 0xbd, 0xd1, 0x2a,
-	0x64, 0x44, 0x34, 0x5f, 0xc8, 0x77, 0x77, 0xd8, 0x3a, 0xb1, 0xc5, 0xfd, 0x0f, 0x9f, 0xa4, 0xd4,
-	0x97, 0xcf, 0x12, 0xd0, 0x5a, 0xdf, 0x96, 0x12, 0xb8, 0x5e, 0x4a, 0xe0, 0xe7, 0x52, 0x02, 0x1f,
-	0x57, 0x52, 0xea, 0x7a, 0x25, 0xa5, 0x7e, 0xac, 0xa4, 0xd4, 0xdb, 0xea, 0x88, 0xf0, 0xf7, 0x33,
-	0x2b, 0xd8, 0xa1, 0xba, 0x3d, 0xa9, 0xb8, 0x30, 0x19, 0x51, 0x13, 0xe7, 0x69, 0x65, 0xc3, 0x6b,
-	0x7a, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xfc, 0xc4, 0xcf, 0xba, 0x03, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x8e, 0xd2, 0x50,
+	0x14, 0xe6, 0x82, 0x0e, 0x33, 0x17, 0x54, 0xb8, 0xce, 0x98, 0xa6, 0x71, 0x9a, 0x0e, 0x2b, 0xfc,
+	0x49, 0x1b, 0x30, 0x31, 0xc6, 0xb8, 0xa1, 0x8c, 0x63, 0x08, 0x4c, 0x21, 0xc0, 0x8c, 0x89, 0x9b,
+	0xa6, 0x85, 0x0b, 0x36, 0x94, 0xde, 0x9b, 0xf6, 0xd2, 0x49, 0xdf, 0xc0, 0xb0, 0xf2, 0x05, 0x58,
+	0x69, 0xa2, 0x0f, 0xe0, 0x43, 0xb8, 0x9c, 0x9d, 0x2e, 0x0d, 0xbc, 0x88, 0x69, 0x4b, 0x0b, 0x13,
+	0x34, 0xba, 0x3b, 0xf7, 0x7c, 0xdf, 0x77, 0xce, 0x77, 0xbf, 0xe4, 0xc0, 0x93, 0x01, 0x99, 0x62,
+	0x66, 0x8c, 0x98, 0xcc, 0x7c, 0x8a, 0x5d, 0xd9, 0xab, 0xca, 0x9e, 0x6e, 0x99, 0x43, 0x9d, 0x11,
+	0x47, 0xa2, 0x0e, 0x61, 0x04, 0x15, 0x63, 0x8a, 0x14, 0x52, 0x24, 0xaf, 0xca, 0x1f, 0x27, 0xaa,
+	0x81, 0xe3, 0x53, 0x46, 0x64, 0xaf, 0x22, 0x4f, 0xb0, 0xef, 0x46, 0x0a, 0xfe, 0x70, 0x4c, 0xc6,
+	0x24, 0x2c, 0xe5, 0xa0, 0x8a, 0xba, 0xa5, 0x6f, 0x00, 0xe6, 0x2f, 0xe3, 0xd9, 0x3d, 0xcc, 0xd0,
+	0x2b, 0x08, 0x93, 0x5d, 0x2e, 0x07, 0xc4, 0x4c, 0x39, 0x57, 0x7d, 0x28, 0xed, 0x6c, 0x93, 0x12,
+	0x51, 0x77, 0x8b, 0x8f, 0x5e, 0xc0, 0x7d, 0xea, 0x10, 0x4a, 0x5c, 0xec, 0x70, 0x69, 0x11, 0xfc,
+	0x53, 0x9b, 0xb0, 0xd1, 0x53, 0x88, 0x18, 0x61, 0xba, 0xa5, 0x79, 0x84, 0x99, 0xf6, 0x58, 0xa3,
+	0xe4, 0x0a, 0x3b, 0x5c, 0x46, 0x04, 0xe5, 0x4c, 0xb7, 0x10, 0x22, 0x97, 0x21, 0xd0, 0x09, 0xfa,
+	0xa5, 0x2f, 0x69, 0x78, 0x90, 0x4c, 0x41, 0x1c, 0xcc, 0xea, 0xc3, 0xa1, 0x83, 0xdd, 0xc0, 0x30,
+	0x28, 0xe7, 0xbb, 0xf1, 0x13, 0xbd, 0x84, 0x59, 0x3a, 0x33, 0xb4, 0x09, 0xf6, 0xd7, 0x76, 0x8e,
+	0x37, 0x76, 0xa2, 0x94, 0x24, 0xaf, 0x22, 0x75, 0x66, 0x86, 0x65, 0x0e, 0x9a, 0xd8, 0x57, 0xd2,
+	0x1c, 0xe8, 0xee, 0xd1, 0x99, 0xd1, 0xc4, 0x3e, 0x3a, 0x81, 0xf9, 0x3f, 0x78, 0xc9, 0x79, 0x1b,
+	0x1b, 0xe8, 0x09, 0x2c, 0xc6, 0x1f, 0xd0, 0xa8, 0x63, 0x12, 0xc7, 0x64, 0x3e, 0x77, 0x2b, 0xf2,
+	0x1c, 0x03, 0x9d, 0x75, 0x1f, 0x95, 0xe0, 0x9d, 0xb5, 0x17, 0xcd, 0xf0, 0x19, 0x76, 0xb9, 0xdb,
+	0xa1, 0xd7, 0x5c, 0xb4, 0x4e, 0x09, 0x5a, 0x48, 0x84, 0xf9, 0x98, 0x13, 0xa4, 0xc5, 0xed, 0x89,
+	0xa0, 0x7c, 0xd0, 0x85, 0x11, 0xa5, 0xef, 0x53, 0x8c, 0x24, 0x78, 0x9f, 0x3a, 0x84, 0x8c, 0x34,
+	0x32, 0xd2, 0x28, 0x71, 0x5d, 0xec, 0xba, 0x26, 0xb1, 0xb9, 0x6c, 0x38, 0xab, 0x18, 0x42, 0xed,
+	0x51, 0x27, 0x01, 0x4a, 0x16, 0xbc, 0xd7, 0x33, 0xa7, 0xd4, 0xc2, 0x9b, 0xb8, 0x9e, 0x6f, 0x42,
+	0x01, 0xff, 0x11, 0xca, 0x5f, 0x03, 0x49, 0xef, 0x04, 0xf2, 0xf8, 0x07, 0x80, 0x39, 0xc5, 0x22,
+	0x83, 0x49, 0xe3, 0xf4, 0xcc, 0xd2, 0xc7, 0xa8, 0x02, 0x8f, 0x94, 0x56, 0xbb, 0xde, 0xd4, 0x1a,
+	0xa7, 0xda, 0x59, 0xab, 0xf6, 0x46, 0xbb, 0x50, 0x9b, 0x6a, 0xfb, 0xad, 0x5a, 0x48, 0xf1, 0x0f,
+	0xe6, 0x0b, 0x11, 0x6d, 0x71, 0x2f, 0xec, 0x89, 0x4d, 0xae, 0x6c, 0x24, 0xc3, 0xc3, 0x9b, 0x92,
+	0x9a, 0xd2, 0x7b, 0xad, 0xf6, 0x0b, 0x80, 0x3f, 0x9a, 0x2f, 0xc4, 0xe2, 0x96, 0xa2, 0x66, 0xb8,
+	0xd8, 0x66, 0xbb, 0x82, 0x7a, 0xfb, 0xfc, 0xbc, 0xd1, 0x2f, 0xa4, 0x77, 0x04, 0x75, 0x32, 0x9d,
+	0x9a, 0x0c, 0x3d, 0x82, 0xc5, 0x9b, 0x02, 0xb5, 0xd1, 0x2a, 0x64, 0x78, 0x34, 0x5f, 0x88, 0x77,
+	0xb7, 0xd8, 0xaa, 0x69, 0xf1, 0xfb, 0x1f, 0x3e, 0x09, 0xa9, 0xaf, 0x9f, 0x05, 0xa0, 0xb4, 0xbe,
+	0x2f, 0x05, 0x70, 0xbd, 0x14, 0xc0, 0xaf, 0xa5, 0x00, 0x3e, 0xae, 0x84, 0xd4, 0xf5, 0x4a, 0x48,
+	0xfd, 0x5c, 0x09, 0xa9, 0x77, 0xd5, 0xb1, 0xc9, 0xde, 0xcf, 0x8c, 0x20, 0x43, 0x79, 0x73, 0x82,
+	0x71, 0xa1, 0x53, 0x53, 0xde, 0x39, 0x67, 0x63, 0x2f, 0xbc, 0xbe, 0x67, 0xbf, 0x07, 0x00, 0xcf,
+	0xbd, 0x18, 0x9b, 0xea, 0x03, 0x00, 0x00,
 }
 
 func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
@@ -383,6 +395,13 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofOfPossession) > 0 {
+		i -= len(m.ProofOfPossession)
+		copy(dAtA[i:], m.ProofOfPossession)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.ProofOfPossession)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.PubKeyType) > 0 {
 		i -= len(m.PubKeyType)
 		copy(dAtA[i:], m.PubKeyType)
@@ -530,6 +549,10 @@ func (m *Validator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovValidator(uint64(l))
 	}
+	l = len(m.ProofOfPossession)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
 	return n
 }
 
@@ -897,6 +920,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			}
 			m.PubKeyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfPossession = append(m.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfPossession == nil {
+				m.ProofOfPossession = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/privval"
+)
+
+var (
+	auditLogFile string
+	auditFilter  privval.AuditFilter
+	auditHeight  int64
+)
+
+// AuditLogCmd prints the entries of the audit log of the private validator.
+var AuditLogCmd = &cobra.Command{
+	Use:   "audit-log",
+	Short: "Print the votes and proposals signed, or refused to be signed, by the private validator",
+	Long: `Print the entries of the audit log of the private validator, as JSON lines, from the
+oldest to the newest. The audit log is enabled by the priv_validator_audit_log_file
+parameter of the configuration.`,
+	Example: `cometbft audit-log --height 1200
+cometbft audit-log --min-height 1000 --refused`,
+	RunE: printAuditLog,
+}
+
+func init() {
+	AuditLogCmd.Flags().StringVar(&auditLogFile, "file", "",
+		"path to the audit log (default: priv_validator_audit_log_file of the configuration)")
+	AuditLogCmd.Flags().StringVar(&auditFilter.ChainID, "chain-id", "", "only the entries of this chain")
+	AuditLogCmd.Flags().Int64Var(&auditHeight, "height", 0, "only the entries at this height")
+	AuditLogCmd.Flags().Int64Var(&auditFilter.MinHeight, "min-height", 0, "only the entries at or above this height")
+	AuditLogCmd.Flags().Int64Var(&auditFilter.MaxHeight, "max-height", 0, "only the entries at or below this height")
+	AuditLogCmd.Flags().BoolVar(&auditFilter.RefusedOnly, "refused", false, "only the refusals to sign")
+}
+
+func printAuditLog(*cobra.Command, []string) error {
+	path := auditLogFile
+	if path == "" {
+		path = config.PrivValidatorAuditLogFile()
+	}
+	if path == "" {
+		return errors.New("no audit log: set priv_validator_audit_log_file or --file")
+	}
+
+	filter := auditFilter
+	if auditHeight != 0 {
+		filter.MinHeight, filter.MaxHeight = auditHeight, auditHeight
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	var encErr error
+	err := privval.ReadAuditLog(path, filter, func(entry privval.AuditEntry) bool {
+		encErr = enc.Encode(entry)
+		return encErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	if encErr != nil {
+		return fmt.Errorf("printing audit log: %w", encErr)
+	}
+	return nil
+}
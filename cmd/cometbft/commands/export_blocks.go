@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/internal/blocksync"
+)
+
+// ExportBlocksCmd writes the blocks of the block store to a block archive
+// file, which other nodes can block sync from.
+var ExportBlocksCmd = &cobra.Command{
+	Use:     "export-blocks <file>",
+	Aliases: []string{"export_blocks"},
+	Short:   "export the blocks of the block store to a block archive file",
+	Long: `
+export-blocks writes the blocks of the block store, along with their extended
+commits when vote extensions are enabled, to a block archive file. Another node
+can then sync these blocks by setting archive_path in the [blocksync] section
+of its config.toml to the file, instead of downloading them from peers. The
+blocks are verified and executed by that node exactly as when syncing them from
+peers.
+
+The node must be stopped before running this command. The default start-height
+is 0, meaning the base height of the block store; the default end-height is 0,
+meaning the latest height of the block store.
+`,
+	Example: `
+	cometbft export-blocks blocks.bin
+	cometbft export-blocks blocks.bin --start-height 2 --end-height 10
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		bs, ss, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = bs.Close()
+			_ = ss.Close()
+		}()
+
+		if err := checkValidHeight(bs); err != nil {
+			return err
+		}
+
+		f, err := os.Create(args[0])
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriter(f)
+		if err := blocksync.WriteBlockArchive(w, bs, startHeight, endHeight); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to export blocks: %w", err)
+		}
+		if err := w.Flush(); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+
+		fmt.Printf("Exported blocks %d to %d to %s\n", startHeight, endHeight, args[0])
+		return nil
+	},
+}
+
+func init() {
+	ExportBlocksCmd.Flags().Int64Var(&startHeight, "start-height", 0, "the block height to start the export from")
+	ExportBlocksCmd.Flags().Int64Var(&endHeight, "end-height", 0, "the block height to finish the export at")
+}
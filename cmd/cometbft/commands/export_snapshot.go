@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	nm "github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/proxy"
+)
+
+var (
+	exportSnapshotHeight      uint64
+	exportSnapshotTrustHeight int64
+)
+
+func init() {
+	ExportSnapshotCmd.Flags().String(
+		"proxy_app",
+		config.ProxyApp,
+		"proxy app address, or one of: 'kvstore',"+
+			" 'persistent_kvstore' or 'noop' for local testing.")
+	ExportSnapshotCmd.Flags().String("abci", config.ABCI, "specify abci transport (socket | grpc)")
+	ExportSnapshotCmd.Flags().Uint64Var(&exportSnapshotHeight, "height", 0,
+		"height of the snapshot to export (default: the latest snapshot)")
+	ExportSnapshotCmd.Flags().Int64Var(&exportSnapshotTrustHeight, "trust-height", 0,
+		"trusted height the snapshot file will be restored with (default: the snapshot height)")
+}
+
+// ExportSnapshotCmd writes a snapshot of the application to a local snapshot
+// file, to be restored with RestoreSnapshotCmd.
+var ExportSnapshotCmd = &cobra.Command{
+	Use:     "export-snapshot <file>",
+	Aliases: []string{"export_snapshot"},
+	Short:   "export a snapshot of the application to a snapshot file",
+	Long: `
+export-snapshot writes a snapshot of the application, along with the light
+blocks and consensus parameters needed to verify it, to a file which can be
+restored with restore-snapshot.
+
+The snapshot chunks are loaded from the application running at the proxy_app
+address, through the same ListSnapshots and LoadSnapshotChunk calls as state
+sync. The light blocks are loaded from the block and state stores, which must
+contain the blocks from the lower of the snapshot and trusted heights up to two
+blocks above the higher one. The node must be stopped.
+`,
+	Example: `
+	cometbft export-snapshot snapshot.bin
+	cometbft export-snapshot snapshot.bin --height 1000 --trust-height 900
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		if exportSnapshotTrustHeight < 0 {
+			return fmt.Errorf("trust-height can't be negative, got %d", exportSnapshotTrustHeight)
+		}
+
+		if err := nm.ExportSnapshotFile(
+			config,
+			nil,
+			proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+			args[0],
+			exportSnapshotHeight,
+			exportSnapshotTrustHeight,
+		); err != nil {
+			return fmt.Errorf("failed to export snapshot file: %w", err)
+		}
+
+		fmt.Println("Snapshot exported.")
+		return nil
+	},
+}
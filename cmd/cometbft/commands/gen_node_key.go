@@ -18,13 +18,22 @@ var GenNodeKeyCmd = &cobra.Command{
 	RunE:    genNodeKey,
 }
 
+func init() {
+	GenNodeKeyCmd.Flags().BoolVar(&encryptKeys, "encrypt", false, "encrypt the node key file with a passphrase")
+}
+
 func genNodeKey(*cobra.Command, []string) error {
 	nodeKeyFile := config.NodeKeyFile()
 	if cmtos.FileExists(nodeKeyFile) {
 		return fmt.Errorf("node key at %s already exists", nodeKeyFile)
 	}
 
-	nk, err := p2p.LoadOrGenNodeKey(nodeKeyFile)
+	passphrase, err := newKeyPassphrase()
+	if err != nil {
+		return err
+	}
+
+	nk, err := p2p.GenNodeKey(nodeKeyFile, passphrase)
 	if err != nil {
 		return err
 	}
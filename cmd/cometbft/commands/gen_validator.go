@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cometbft/cometbft/crypto/armor"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	kt "github.com/cometbft/cometbft/internal/keytypes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
@@ -23,13 +24,32 @@ var GenValidatorCmd = &cobra.Command{
 
 func init() {
 	GenValidatorCmd.Flags().StringVarP(&keyType, "key-type", "k", ed25519.KeyType, fmt.Sprintf("private key type (one of %s)", kt.SupportedKeyTypesStr()))
+	GenValidatorCmd.Flags().BoolVar(&encryptKeys, "encrypt", false,
+		"print the key file of the validator, encrypted with a passphrase, instead of the validator")
 }
 
 func genValidator(*cobra.Command, []string) error {
+	passphrase, err := newKeyPassphrase()
+	if err != nil {
+		return err
+	}
+
 	pv, err := privval.GenFilePV("", "", genPrivKeyFromFlag)
 	if err != nil {
 		return fmt.Errorf("cannot generate file pv: %w", err)
 	}
+	if passphrase != "" {
+		keyJSON, err := cmtjson.MarshalIndent(pv.Key, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal private validator key: %w", err)
+		}
+		armored, err := armor.EncryptArmor(privval.KeyFileBlockType, keyJSON, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt private validator key: %w", err)
+		}
+		fmt.Print(armored)
+		return nil
+	}
 	jsbz, err := cmtjson.Marshal(pv)
 	if err != nil {
 		return fmt.Errorf("failed to marshal private validator: %w", err)
@@ -25,6 +25,7 @@ var InitFilesCmd = &cobra.Command{
 
 func init() {
 	InitFilesCmd.Flags().StringVarP(&keyType, "key-type", "k", ed25519.KeyType, fmt.Sprintf("private key type (one of %s)", kt.SupportedKeyTypesStr()))
+	InitFilesCmd.Flags().BoolVar(&encryptKeys, "encrypt", false, "encrypt the generated key files with a passphrase")
 }
 
 func initFiles(*cobra.Command, []string) error {
@@ -35,30 +36,35 @@ func initFilesWithConfig(config *cfg.Config) error {
 	// private validator
 	privValKeyFile := config.PrivValidatorKeyFile()
 	privValStateFile := config.PrivValidatorStateFile()
+	passphrase, err := newKeyPassphrase()
+	if err != nil {
+		return err
+	}
+
 	var pv *privval.FilePV
 	if cmtos.FileExists(privValKeyFile) {
 		pv = privval.LoadFilePV(privValKeyFile, privValStateFile)
 		logger.Info("Found private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
 	} else {
-		var err error
 		pv, err = privval.GenFilePV(privValKeyFile, privValStateFile, genPrivKeyFromFlag)
 		if err != nil {
 			return fmt.Errorf("can't generate file pv: %w", err)
 		}
-		pv.Save()
+		pv.Key.SaveEncrypted(passphrase)
+		pv.LastSignState.Save()
 		logger.Info("Generated private validator", "keyFile", privValKeyFile,
-			"stateFile", privValStateFile)
+			"stateFile", privValStateFile, "encrypted", passphrase != "")
 	}
 
 	nodeKeyFile := config.NodeKeyFile()
 	if cmtos.FileExists(nodeKeyFile) {
 		logger.Info("Found node key", "path", nodeKeyFile)
 	} else {
-		if _, err := p2p.LoadOrGenNodeKey(nodeKeyFile); err != nil {
+		if _, err := p2p.GenNodeKey(nodeKeyFile, passphrase); err != nil {
 			return err
 		}
-		logger.Info("Generated node key", "path", nodeKeyFile)
+		logger.Info("Generated node key", "path", nodeKeyFile, "encrypted", passphrase != "")
 	}
 
 	// genesis file
@@ -75,10 +81,15 @@ func initFilesWithConfig(config *cfg.Config) error {
 		if err != nil {
 			return fmt.Errorf("can't get pubkey: %w", err)
 		}
+		proof, err := pv.ProofOfPossession()
+		if err != nil {
+			return fmt.Errorf("can't get proof of possession: %w", err)
+		}
 		genDoc.Validators = []types.GenesisValidator{{
-			Address: pubKey.Address(),
-			PubKey:  pubKey,
-			Power:   10,
+			Address:           pubKey.Address(),
+			PubKey:            pubKey,
+			Power:             10,
+			ProofOfPossession: proof,
 		}}
 
 		if err := genDoc.SaveAs(genFile); err != nil {
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/internal/keyfile"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+)
+
+var (
+	encryptKeys bool
+	// Key files the encrypt and decrypt commands apply to.
+	validatorKeyOnly bool
+	nodeKeyOnly      bool
+)
+
+// KeyFileCmd groups the commands encrypting and decrypting the key files.
+var KeyFileCmd = &cobra.Command{
+	Use:   "key-file",
+	Short: "Encrypt or decrypt the private validator and node key files",
+	Long: fmt.Sprintf(`Encrypt or decrypt the private validator and node key files.
+
+The passphrase of the key files is read from the %s environment variable, else
+from the file named by the %s environment variable, else from the terminal.
+Encrypted key files are decrypted when loaded, using the same passphrase.`,
+		keyfile.PassphraseEnvVar, keyfile.PassphraseFileEnvVar),
+}
+
+// EncryptKeyFileCmd encrypts the key files with a passphrase.
+var EncryptKeyFileCmd = &cobra.Command{
+	Use:   "encrypt",
+	Short: "Encrypt the private validator and node key files with a passphrase",
+	RunE: func(*cobra.Command, []string) error {
+		passphrase, err := keyfile.NewPassphrase("New passphrase: ")
+		if err != nil {
+			return err
+		}
+		return saveKeyFiles(passphrase)
+	},
+}
+
+// DecryptKeyFileCmd decrypts the key files, saving them in plaintext.
+var DecryptKeyFileCmd = &cobra.Command{
+	Use:   "decrypt",
+	Short: "Decrypt the private validator and node key files, saving them in plaintext",
+	RunE: func(*cobra.Command, []string) error {
+		return saveKeyFiles("")
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{EncryptKeyFileCmd, DecryptKeyFileCmd} {
+		cmd.Flags().BoolVar(&validatorKeyOnly, "validator-key", false, "only the private validator key file")
+		cmd.Flags().BoolVar(&nodeKeyOnly, "node-key", false, "only the node key file")
+		cmd.MarkFlagsMutuallyExclusive("validator-key", "node-key")
+	}
+	KeyFileCmd.AddCommand(EncryptKeyFileCmd, DecryptKeyFileCmd)
+}
+
+// newKeyPassphrase returns the passphrase to encrypt new key files with, if
+// the --encrypt flag is set, and an empty passphrase otherwise.
+func newKeyPassphrase() (string, error) {
+	if !encryptKeys {
+		return "", nil
+	}
+	return keyfile.NewPassphrase("Passphrase of the key files: ")
+}
+
+// saveKeyFiles loads the key files and saves them again, encrypted with the
+// passphrase, or in plaintext if the passphrase is empty.
+func saveKeyFiles(passphrase string) error {
+	saved := false
+	if !nodeKeyOnly {
+		keyFile := config.PrivValidatorKeyFile()
+		if cmtos.FileExists(keyFile) {
+			pv := privval.LoadFilePVEmptyState(keyFile, config.PrivValidatorStateFile())
+			pv.Key.SaveEncrypted(passphrase)
+			logger.Info("Saved private validator key file", "path", keyFile, "encrypted", passphrase != "")
+			saved = true
+		} else if validatorKeyOnly {
+			return fmt.Errorf("private validator key file %s does not exist", keyFile)
+		}
+	}
+
+	if !validatorKeyOnly {
+		nodeKeyFile := config.NodeKeyFile()
+		if cmtos.FileExists(nodeKeyFile) {
+			nk, err := p2p.LoadNodeKey(nodeKeyFile)
+			if err != nil {
+				return fmt.Errorf("loading node key: %w", err)
+			}
+			if err := nk.SaveAsEncrypted(nodeKeyFile, passphrase); err != nil {
+				return err
+			}
+			logger.Info("Saved node key file", "path", nodeKeyFile, "encrypted", passphrase != "")
+			saved = true
+		} else if nodeKeyOnly {
+			return fmt.Errorf("node key file %s does not exist", nodeKeyFile)
+		}
+	}
+
+	if !saved {
+		return errors.New("no key file found")
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -68,6 +69,9 @@ var (
 
 	verbose bool
 
+	cacheSize      int
+	prometheusAddr string
+
 	primaryKey   = []byte("primary")
 	witnessesKey = []byte("witnesses")
 )
@@ -97,6 +101,12 @@ func init() {
 	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
 		"sequential verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
+	LightCmd.Flags().IntVar(&cacheSize, "cache-size", 1000,
+		"number of verified light blocks, blocks and block results each to cache. 0 disables caching",
+	)
+	LightCmd.Flags().StringVar(&prometheusAddr, "prometheus-laddr", "",
+		"serve the Prometheus metrics of the light client on the given address. Empty disables them",
+	)
 }
 
 func runProxy(_ *cobra.Command, args []string) error {
@@ -171,6 +181,21 @@ func runProxy(_ *cobra.Command, args []string) error {
 		options = append(options, light.SkippingVerification(trustLevel))
 	}
 
+	if prometheusAddr != "" {
+		options = append(options, light.WithMetrics(light.PrometheusMetrics("cometbft", "chain_id", chainID)))
+		srv := &http.Server{
+			Addr:              prometheusAddr,
+			Handler:           promhttp.Handler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+				logger.Error("Prometheus HTTP server ListenAndServe", "err", err)
+			}
+		}()
+		defer srv.Close()
+	}
+
 	var c *light.Client
 	if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
 		c, err = light.NewHTTPClient(
@@ -214,7 +239,8 @@ func runProxy(_ *cobra.Command, args []string) error {
 		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
-	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger, lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()))
+	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger,
+		lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()), lrpc.CacheSize(cacheSize))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,307 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cfg "github.com/cometbft/cometbft/config"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/internal/tempfile"
+)
+
+// migrationProgressFile is the name of the file, in the output directory,
+// recording how far the migration of each database went.
+const migrationProgressFile = "migrate-db-progress.json"
+
+// migratedDBs lists the databases created through config.DefaultDBProvider.
+var migratedDBs = []string{"blockstore", "state", "tx_index", "evidence", "light"}
+
+var (
+	migrateToBackend string
+	migrateOutDir    string
+	migrateBatchSize int
+)
+
+func init() {
+	MigrateDBCmd.Flags().StringVar(&migrateToBackend, "to", "", "database backend to migrate to (e.g. pebbledb)")
+	MigrateDBCmd.Flags().StringVar(&migrateOutDir, "out-dir", "",
+		"directory to write the migrated databases to (default: <db_dir>/migrated-<backend>)")
+	MigrateDBCmd.Flags().IntVar(&migrateBatchSize, "batch-size", 10000, "number of keys written per batch")
+}
+
+// MigrateDBCmd copies the databases of the node to another database backend.
+var MigrateDBCmd = &cobra.Command{
+	Use:     "migrate-db",
+	Aliases: []string{"migrate_db"},
+	Short:   "copy the node databases to another database backend",
+	Long: `
+migrate-db copies every database of the node (blockstore, state, tx_index,
+evidence and light, when present) from the configured db_backend to the backend
+given by --to, key by key. Databases are written to a separate directory; once
+a database has been copied, it is verified against the source.
+
+Progress is recorded after every batch, so an interrupted migration resumes
+where it stopped when the command is run again with the same arguments.
+
+The node must be stopped while migrating. Once the migration is complete, move
+the migrated databases to the db_dir and set db_backend to the new backend in
+config.toml.
+	`,
+	Example: `
+	cometbft migrate-db --to pebbledb
+	cometbft migrate-db --to pebbledb --out-dir /mnt/new-data
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		outDir := migrateOutDir
+		if outDir == "" {
+			outDir = filepath.Join(config.DBDir(), "migrated-"+migrateToBackend)
+		}
+
+		if err := migrateDBs(config, dbm.BackendType(migrateToBackend), outDir, migrateBatchSize); err != nil {
+			return fmt.Errorf("failed to migrate databases: %w", err)
+		}
+
+		fmt.Printf("Migrated databases written to %v.\n", outDir)
+		fmt.Printf("Move them to %v and set db_backend = %q in config.toml to use them.\n",
+			config.DBDir(), migrateToBackend)
+		return nil
+	},
+}
+
+// migrationProgress is persisted to the output directory to resume an
+// interrupted migration.
+type migrationProgress struct {
+	From string                          `json:"from"`
+	To   string                          `json:"to"`
+	DBs  map[string]*dbMigrationProgress `json:"dbs"`
+}
+
+type dbMigrationProgress struct {
+	// LastKey is the last key written to the destination database.
+	LastKey []byte `json:"last_key,omitempty"`
+	Copied  int64  `json:"copied"`
+	Done    bool   `json:"done"`
+}
+
+func loadMigrationProgress(path string, from, to dbm.BackendType) (*migrationProgress, error) {
+	progress := &migrationProgress{
+		From: string(from),
+		To:   string(to),
+		DBs:  make(map[string]*dbMigrationProgress),
+	}
+	if !cmtos.FileExists(path) {
+		return progress, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, progress); err != nil {
+		return nil, fmt.Errorf("failed to parse %v: %w", path, err)
+	}
+	if progress.From != string(from) || progress.To != string(to) {
+		return nil, fmt.Errorf("%v records a migration from %s to %s, not from %s to %s",
+			path, progress.From, progress.To, from, to)
+	}
+	return progress, nil
+}
+
+func (p *migrationProgress) save(path string) error {
+	bz, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(path, bz, 0o600)
+}
+
+// migrateDBs copies all the existing databases of the node to the given
+// backend in outDir.
+func migrateDBs(config *cfg.Config, to dbm.BackendType, outDir string, batchSize int) error {
+	from := dbm.BackendType(config.DBBackend)
+	switch {
+	case to == "":
+		return errors.New("the target backend must be set with --to")
+	case to == from:
+		return fmt.Errorf("databases already use the %s backend", to)
+	case to == dbm.MemDBBackend:
+		return errors.New("cannot migrate to the in-memory backend")
+	case batchSize <= 0:
+		return errors.New("batch size must be positive")
+	}
+	if filepath.Clean(outDir) == filepath.Clean(config.DBDir()) {
+		return errors.New("the output directory must be different from the db_dir")
+	}
+	if err := cmtos.EnsureDir(outDir, 0o700); err != nil {
+		return err
+	}
+
+	progressPath := filepath.Join(outDir, migrationProgressFile)
+	progress, err := loadMigrationProgress(progressPath, from, to)
+	if err != nil {
+		return err
+	}
+	save := func() error { return progress.save(progressPath) }
+
+	for _, name := range migratedDBs {
+		if !cfg.DBExists(name, from, config.DBDir()) {
+			fmt.Printf("%s: not found, skipping\n", name)
+			continue
+		}
+		dbProgress, ok := progress.DBs[name]
+		if !ok {
+			dbProgress = &dbMigrationProgress{}
+			progress.DBs[name] = dbProgress
+		}
+		if dbProgress.Done {
+			fmt.Printf("%s: already migrated (%d keys)\n", name, dbProgress.Copied)
+			continue
+		}
+
+		if err := migrateNamedDB(name, from, config.DBDir(), to, outDir, dbProgress, batchSize, save); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func migrateNamedDB(
+	name string,
+	from dbm.BackendType, fromDir string,
+	to dbm.BackendType, toDir string,
+	progress *dbMigrationProgress,
+	batchSize int,
+	save func() error,
+) error {
+	src, err := dbm.NewDB(name, from, fromDir)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := dbm.NewDB(name, to, toDir)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if progress.LastKey != nil {
+		fmt.Printf("%s: resuming after %d keys\n", name, progress.Copied)
+	} else {
+		fmt.Printf("%s: copying\n", name)
+	}
+	if err := copyDB(src, dst, progress, batchSize, save); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: verifying %d keys\n", name, progress.Copied)
+	if err := verifyDBCopy(src, dst); err != nil {
+		return err
+	}
+
+	progress.Done = true
+	return save()
+}
+
+// copyDB copies all the keys of src after progress.LastKey to dst, in
+// batches of batchSize keys. progress is updated and saved after each batch.
+func copyDB(src, dst dbm.DB, progress *dbMigrationProgress, batchSize int, save func() error) error {
+	it, err := src.Iterator(progress.LastKey, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := dst.NewBatch()
+	defer func() { batch.Close() }()
+
+	var (
+		pending int
+		lastKey []byte
+	)
+	flush := func() error {
+		if err := batch.WriteSync(); err != nil {
+			return err
+		}
+		if err := batch.Close(); err != nil {
+			return err
+		}
+		batch = dst.NewBatch()
+
+		progress.LastKey = lastKey
+		progress.Copied += int64(pending)
+		pending = 0
+		return save()
+	}
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		// The iterator starts at the last key written by a previous run.
+		if progress.LastKey != nil && bytes.Equal(key, progress.LastKey) {
+			continue
+		}
+
+		// Iterator keys and values may be reused on the next call to Next.
+		lastKey = append([]byte{}, key...)
+		if err := batch.Set(lastKey, append([]byte{}, it.Value()...)); err != nil {
+			return err
+		}
+		pending++
+
+		if pending >= batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if pending > 0 {
+		return flush()
+	}
+	return nil
+}
+
+// verifyDBCopy checks that src and dst contain exactly the same keys and values.
+func verifyDBCopy(src, dst dbm.DB) error {
+	srcIt, err := src.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer srcIt.Close()
+
+	dstIt, err := dst.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer dstIt.Close()
+
+	for ; srcIt.Valid(); srcIt.Next() {
+		if !dstIt.Valid() {
+			return fmt.Errorf("verification failed: key %X missing in the migrated database", srcIt.Key())
+		}
+		if !bytes.Equal(srcIt.Key(), dstIt.Key()) {
+			return fmt.Errorf("verification failed: expected key %X, found %X", srcIt.Key(), dstIt.Key())
+		}
+		if !bytes.Equal(srcIt.Value(), dstIt.Value()) {
+			return fmt.Errorf("verification failed: value mismatch for key %X", srcIt.Key())
+		}
+		dstIt.Next()
+	}
+	if dstIt.Valid() {
+		return fmt.Errorf("verification failed: unexpected key %X in the migrated database", dstIt.Key())
+	}
+
+	if err := srcIt.Error(); err != nil {
+		return err
+	}
+	return dstIt.Error()
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/evidence"
+	dbs "github.com/cometbft/cometbft/light/store/db"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+// keyLayoutMigrations lists the databases using a versioned key layout and
+// the function migrating each of them from the v1 to the v2 layout.
+var keyLayoutMigrations = []struct {
+	name    string
+	migrate func(db dbm.DB, progress func(migrated int64)) (int64, error)
+}{
+	{"blockstore", store.MigrateKeyLayoutToV2},
+	{"state", sm.MigrateKeyLayoutToV2},
+	{"evidence", evidence.MigrateKeyLayoutToV2},
+	{"light", dbs.MigrateKeyLayoutToV2},
+}
+
+// MigrateDBKeyLayoutCmd rewrites the databases of the node from the v1 to the
+// v2 key layout.
+var MigrateDBKeyLayoutCmd = &cobra.Command{
+	Use:     "migrate-db-key-layout",
+	Aliases: []string{"migrate_db_key_layout"},
+	Short:   "rewrite the node databases from the v1 to the v2 key layout",
+	Long: `
+migrate-db-key-layout rewrites the keys of the blockstore, state, evidence and
+light databases, when present, from the v1 to the v2 key layout, in place.
+
+Keys are rewritten in batches and the new layout is recorded once a database
+has been fully migrated, so an interrupted migration resumes where it stopped
+when the command is run again. Databases already using the v2 layout are left
+untouched.
+
+The node must be stopped while migrating. Once the migration is complete, set
+experimental_db_key_layout = "v2" in the [storage] section of config.toml.
+	`,
+	Example: `
+	cometbft migrate-db-key-layout
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if err := migrateDBKeyLayout(config); err != nil {
+			return fmt.Errorf("failed to migrate the database key layout: %w", err)
+		}
+
+		fmt.Println(`Migration complete. Set experimental_db_key_layout = "v2" in config.toml.`)
+		return nil
+	},
+}
+
+// migrateDBKeyLayout migrates all the existing databases of the node to the
+// v2 key layout.
+func migrateDBKeyLayout(config *cfg.Config) error {
+	backend := dbm.BackendType(config.DBBackend)
+	if backend == dbm.MemDBBackend {
+		return errors.New("cannot migrate in-memory databases")
+	}
+
+	for _, m := range keyLayoutMigrations {
+		if !cfg.DBExists(m.name, backend, config.DBDir()) {
+			fmt.Printf("%s: not found, skipping\n", m.name)
+			continue
+		}
+
+		db, err := dbm.NewDB(m.name, backend, config.DBDir())
+		if err != nil {
+			return fmt.Errorf("%s: %w", m.name, err)
+		}
+		migrated, err := m.migrate(db, func(migrated int64) {
+			fmt.Printf("%s: %d keys rewritten so far\n", m.name, migrated)
+		})
+		if closeErr := db.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", m.name, err)
+		}
+		fmt.Printf("%s: %d keys rewritten\n", m.name, migrated)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+)
+
+func TestMigrateDBs(t *testing.T) {
+	cfg := cmtcfg.TestConfig()
+	cfg.SetRoot(t.TempDir())
+	cfg.DBBackend = string(dbm.GoLevelDBBackend)
+	outDir := filepath.Join(t.TempDir(), "out")
+
+	const numKeys = 25
+	for _, name := range []string{"blockstore", "state"} {
+		db, err := dbm.NewDB(name, dbm.GoLevelDBBackend, cfg.DBDir())
+		require.NoError(t, err)
+		for i := 0; i < numKeys; i++ {
+			require.NoError(t, db.Set([]byte(fmt.Sprintf("%s-%03d", name, i)), []byte{byte(i)}))
+		}
+		require.NoError(t, db.Close())
+	}
+
+	err := migrateDBs(cfg, dbm.GoLevelDBBackend, outDir, 10)
+	require.Error(t, err)
+	err = migrateDBs(cfg, dbm.PebbleDBBackend, cfg.DBDir(), 10)
+	require.Error(t, err)
+
+	// Interrupt the migration of the blockstore after the first batch.
+	src, err := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, cfg.DBDir())
+	require.NoError(t, err)
+	dst, err := dbm.NewDB("blockstore", dbm.PebbleDBBackend, outDir)
+	require.NoError(t, err)
+	progress := &dbMigrationProgress{}
+	errInterrupted := errors.New("interrupted")
+	err = copyDB(src, dst, progress, 10, func() error { return errInterrupted })
+	require.ErrorIs(t, err, errInterrupted)
+	require.EqualValues(t, 10, progress.Copied)
+	require.NoError(t, src.Close())
+	require.NoError(t, dst.Close())
+
+	p := &migrationProgress{
+		From: string(dbm.GoLevelDBBackend),
+		To:   string(dbm.PebbleDBBackend),
+		DBs:  map[string]*dbMigrationProgress{"blockstore": progress},
+	}
+	require.NoError(t, p.save(filepath.Join(outDir, migrationProgressFile)))
+
+	// A migration to another backend cannot reuse the progress.
+	err = migrateDBs(cfg, dbm.BadgerDBBackend, outDir, 10)
+	require.Error(t, err)
+
+	require.NoError(t, migrateDBs(cfg, dbm.PebbleDBBackend, outDir, 10))
+
+	p, err = loadMigrationProgress(filepath.Join(outDir, migrationProgressFile), dbm.GoLevelDBBackend, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	require.Len(t, p.DBs, 2)
+	for name, dbProgress := range p.DBs {
+		require.True(t, dbProgress.Done, name)
+		require.EqualValues(t, numKeys, dbProgress.Copied, name)
+	}
+	require.False(t, cmtcfg.DBExists("tx_index", dbm.PebbleDBBackend, outDir))
+
+	for _, name := range []string{"blockstore", "state"} {
+		db, err := dbm.NewDB(name, dbm.PebbleDBBackend, outDir)
+		require.NoError(t, err)
+		bz, err := db.Get([]byte(fmt.Sprintf("%s-%03d", name, numKeys-1)))
+		require.NoError(t, err)
+		require.Equal(t, []byte{numKeys - 1}, bz)
+		require.NoError(t, db.Close())
+	}
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	nm "github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/proxy"
+)
+
+func init() {
+	RestoreSnapshotCmd.Flags().String(
+		"proxy_app",
+		config.ProxyApp,
+		"proxy app address, or one of: 'kvstore',"+
+			" 'persistent_kvstore' or 'noop' for local testing.")
+	RestoreSnapshotCmd.Flags().String("abci", config.ABCI, "specify abci transport (socket | grpc)")
+}
+
+// RestoreSnapshotCmd restores the application and bootstraps the node stores
+// from a local snapshot file.
+var RestoreSnapshotCmd = &cobra.Command{
+	Use:     "restore-snapshot <file>",
+	Aliases: []string{"restore_snapshot"},
+	Short:   "restore the application and the node stores from a snapshot file",
+	Long: `
+restore-snapshot feeds the snapshot in the given file to the application, through
+the same OfferSnapshot and ApplySnapshotChunk calls as state sync, and bootstraps
+the block and state stores with the restored state. No peer or RPC server is
+needed.
+
+The light blocks in the file are verified from the trusted header given by
+trust_height, trust_hash and trust_period in the [statesync] section of
+config.toml, and the app hash of the restored application is checked against
+them. The block and state stores must be empty, and the application must be
+running at the proxy_app address.
+
+Once the snapshot is restored, start the node as usual: it will block sync from
+the height of the snapshot.
+`,
+	Example: `
+	cometbft restore-snapshot snapshot.bin
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		ssConfig := *config.StateSync
+		ssConfig.Enable = false
+		ssConfig.SnapshotFile = args[0]
+		if err := ssConfig.ValidateBasic(); err != nil {
+			return fmt.Errorf("error in [statesync] section: %w", err)
+		}
+
+		if err := nm.BootstrapStateFromSnapshotFile(
+			config,
+			nil,
+			nm.DefaultGenesisDocProviderFunc(config),
+			proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+			args[0],
+		); err != nil {
+			return fmt.Errorf("failed to restore snapshot file: %w", err)
+		}
+
+		fmt.Println("Snapshot restored.")
+		return nil
+	},
+}
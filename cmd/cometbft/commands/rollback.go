@@ -1,22 +1,37 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	dbm "github.com/cometbft/cometbft-db"
 	cfg "github.com/cometbft/cometbft/config"
-	"github.com/cometbft/cometbft/internal/os"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
 )
 
-var removeBlock = false
+var (
+	removeBlock    = false
+	rollbackHeight int64
+	dryRun         = false
+	forceRollback  = false
+)
 
 func init() {
 	RollbackStateCmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	RollbackStateCmd.Flags().Int64Var(&rollbackHeight, "to-height", 0,
+		"roll back state to the given height instead of by one height")
+	RollbackStateCmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only check that the rollback is possible, without modifying any data (requires --to-height)")
+	RollbackStateCmd.Flags().BoolVar(&forceRollback, "force", false,
+		"roll back even if the priv validator has signed at heights that will be decided again")
 }
 
 var RollbackStateCmd = &cobra.Command{
@@ -30,8 +45,33 @@ The application should also roll back to height n - 1. If the --hard flag is not
 no blocks will be removed so upon restarting CometBFT the transactions in block n will be
 re-executed against the application. Using --hard will also remove block n. This can
 be done multiple times.
+
+Using --to-height h rolls back the state to any height h below n in one go. All
+blocks above h + 1 and the ABCI responses, validator sets and consensus params
+above h are removed; block h + 1 is kept and re-executed upon restart, unless
+--hard is used. The application should roll back to height h as well. The state
+is written before anything is removed, so an interrupted rollback can be
+completed by running the same command again. Use --dry-run to check that the
+data needed for the rollback has not been pruned without modifying anything.
+
+If the local priv validator has signed at heights that will have to be decided
+again, the rollback is refused unless --force is used: the signer will not sign
+at those heights again until the chain has moved past them.
 `,
+	Example: `
+	cometbft rollback
+	cometbft rollback --hard
+	cometbft rollback --to-height 100 --dry-run
+	cometbft rollback --to-height 100
+	`,
 	RunE: func(_ *cobra.Command, _ []string) error {
+		if rollbackHeight > 0 {
+			return rollbackToHeight(rollbackHeight)
+		}
+		if dryRun {
+			return errors.New("--dry-run requires --to-height")
+		}
+
 		height, hash, err := RollbackState(config, removeBlock)
 		if err != nil {
 			return fmt.Errorf("failed to rollback state: %w", err)
@@ -65,10 +105,87 @@ func RollbackState(config *cfg.Config, removeBlock bool) (int64, []byte, error)
 	return state.Rollback(blockStore, stateStore, removeBlock)
 }
 
+func rollbackToHeight(targetHeight int64) error {
+	height, hash, err := RollbackStateToHeight(config, targetHeight, removeBlock, dryRun, forceRollback)
+	if err != nil {
+		return fmt.Errorf("failed to rollback state: %w", err)
+	}
+
+	switch {
+	case dryRun:
+		fmt.Printf("Dry run: state can be rolled back to height %d and hash %X\n", height, hash)
+	case removeBlock:
+		fmt.Printf("Rolled back both state and blocks to height %d and hash %X\n", height, hash)
+	default:
+		fmt.Printf("Rolled back state to height %d and hash %X, blocks to height %d\n", height, hash, height+1)
+	}
+	return nil
+}
+
+// RollbackStateToHeight overwrites the current state with the state at the
+// given height and removes the blocks above it (see state.RollbackToHeight).
+// Unless force is true, it refuses to roll back past heights signed by the
+// local priv validator.
+// Returns the latest state height and app hash alongside an error if there was one.
+func RollbackStateToHeight(config *cfg.Config, height int64, removeBlock, dryRun, force bool) (int64, []byte, error) {
+	if !force {
+		if err := checkPrivValidatorSignedHeight(config, height, removeBlock); err != nil {
+			return -1, nil, err
+		}
+	}
+
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return -1, nil, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	return state.RollbackToHeight(blockStore, stateStore, height, removeBlock, dryRun)
+}
+
+// checkPrivValidatorSignedHeight returns an error if the local priv validator
+// has signed at a height above the one at which consensus resumes after
+// rolling back to the given height. Such heights have to be decided again, and
+// the signer refuses to sign them to protect against double signing.
+func checkPrivValidatorSignedHeight(config *cfg.Config, height int64, removeBlock bool) error {
+	if config.PrivValidatorListenAddr != "" {
+		fmt.Println("Using a remote signer: make sure it has not signed above the rollback height")
+		return nil
+	}
+	stateFile := config.PrivValidatorStateFile()
+	if !cmtos.FileExists(stateFile) {
+		return nil
+	}
+
+	bz, err := os.ReadFile(stateFile)
+	if err != nil {
+		return fmt.Errorf("failed to read priv validator state: %w", err)
+	}
+	var lss privval.FilePVLastSignState
+	if err := cmtjson.Unmarshal(bz, &lss); err != nil {
+		return fmt.Errorf("failed to parse priv validator state %v: %w", stateFile, err)
+	}
+
+	// Consensus resumes at the first height not left in the block store.
+	resumeHeight := height + 2
+	if removeBlock {
+		resumeHeight = height + 1
+	}
+	if lss.Height > resumeHeight {
+		return fmt.Errorf("priv validator has signed at height %d, above height %d at which consensus resumes; "+
+			"it will not sign again until the chain passes height %d (use --force to roll back anyway)",
+			lss.Height, resumeHeight, lss.Height)
+	}
+	return nil
+}
+
 func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
 	dbType := dbm.BackendType(config.DBBackend)
 
-	if !os.FileExists(filepath.Join(config.DBDir(), "blockstore.db")) {
+	if !cmtos.FileExists(filepath.Join(config.DBDir(), "blockstore.db")) {
 		return nil, nil, fmt.Errorf("no blockstore found in %v", config.DBDir())
 	}
 
@@ -79,7 +196,7 @@ func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store,
 	}
 	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
 
-	if !os.FileExists(filepath.Join(config.DBDir(), "state.db")) {
+	if !cmtos.FileExists(filepath.Join(config.DBDir(), "state.db")) {
 		return nil, nil, fmt.Errorf("no statestore found in %v", config.DBDir())
 	}
 
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/privval"
+)
+
+func TestCheckPrivValidatorSignedHeight(t *testing.T) {
+	cfg := cmtcfg.TestConfig()
+	cfg.SetRoot(t.TempDir())
+	cmtcfg.EnsureRoot(cfg.RootDir)
+
+	// no priv validator state
+	require.NoError(t, checkPrivValidatorSignedHeight(cfg, 1, false))
+
+	pv, err := privval.GenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(), nil)
+	require.NoError(t, err)
+	pv.LastSignState.Height = 10
+	pv.Save()
+
+	testCases := []struct {
+		height      int64
+		removeBlock bool
+		expErr      bool
+	}{
+		{8, false, false},
+		{7, false, true},
+		{9, true, false},
+		{8, true, true},
+	}
+	for _, tc := range testCases {
+		err := checkPrivValidatorSignedHeight(cfg, tc.height, tc.removeBlock)
+		if tc.expErr {
+			require.Error(t, err, tc)
+		} else {
+			require.NoError(t, err, tc)
+		}
+	}
+}
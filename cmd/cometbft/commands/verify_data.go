@@ -0,0 +1,389 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/internal/progressbar"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Names of the checks performed by verify-data. They are used in the report
+// to point at the exact kind of inconsistency found at the first bad height.
+const (
+	checkBlock           = "block"
+	checkBlockParts      = "block-parts"
+	checkHeaderLinkage   = "header-linkage"
+	checkLastCommit      = "last-commit"
+	checkValidators      = "validators"
+	checkConsensusParams = "consensus-params"
+	checkLastResults     = "last-results"
+	checkSeenCommit      = "seen-commit"
+	checkState           = "state"
+)
+
+// ErrDataCorrupted is returned when verify-data found an inconsistency.
+var ErrDataCorrupted = errors.New("chain data is inconsistent")
+
+// VerifyDataCmd checks the integrity of the block store and the state store.
+var VerifyDataCmd = &cobra.Command{
+	Use:     "verify-data",
+	Aliases: []string{"verify_data"},
+	Short:   "verify the integrity of the block store and state store",
+	Long: `
+verify-data is an offline tool that walks the block store and the state store
+and checks that they are consistent with each other. For every height it
+verifies that:
+
+  * the block and its parts can be loaded and hash to the stored block ID;
+  * the header points at the block ID of the previous height;
+  * the LastCommit carries +2/3 valid signatures from the stored validator set;
+  * the validators and consensus params hashes match the stored ones;
+  * LastResultsHash and AppHash match the stored ABCI responses.
+
+Finally, the latest seen commit and the persisted state are checked against
+the top of the block store. The command stops at the first inconsistency and
+reports the height and the check that failed.
+
+The node must be stopped before running this command. The default start-height
+is 0, meaning the base height of the block store; the default end-height is 0,
+meaning the latest height of the block store.
+	`,
+	Example: `
+	cometbft verify-data
+	cometbft verify-data --start-height 2 --end-height 10
+	`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		bs, ss, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = bs.Close()
+			_ = ss.Close()
+		}()
+
+		if err := checkValidHeight(bs); err != nil {
+			return err
+		}
+
+		report := verifyData(cmd.Context(), dataVerificationArgs{
+			startHeight: startHeight,
+			endHeight:   endHeight,
+			blockStore:  bs,
+			stateStore:  ss,
+		})
+		fmt.Println(report)
+
+		if report.Err != nil {
+			return ErrDataCorrupted
+		}
+		return nil
+	},
+}
+
+func init() {
+	VerifyDataCmd.Flags().Int64Var(&startHeight, "start-height", 0, "the block height to start verification from")
+	VerifyDataCmd.Flags().Int64Var(&endHeight, "end-height", 0, "the block height to finish verification at")
+}
+
+type dataVerificationArgs struct {
+	startHeight int64
+	endHeight   int64
+	blockStore  sm.BlockStore
+	stateStore  sm.Store
+}
+
+// dataVerificationReport summarizes the outcome of verifyData.
+type dataVerificationReport struct {
+	StartHeight int64
+	EndHeight   int64
+
+	// VerifiedHeights is the number of heights that passed all checks.
+	VerifiedHeights int64
+	// SkippedResults lists the heights for which the ABCI responses were not
+	// available (pruned or discarded), so LastResultsHash and AppHash of the
+	// next block could not be checked.
+	SkippedResults []int64
+
+	// FirstBadHeight, Check and Err describe the first inconsistency found.
+	// Err is nil if the data is consistent.
+	FirstBadHeight int64
+	Check          string
+	Err            error
+}
+
+func (r dataVerificationReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "verified heights %d-%d: %d ok\n", r.StartHeight, r.EndHeight, r.VerifiedHeights)
+	if n := len(r.SkippedResults); n > 0 {
+		fmt.Fprintf(&sb, "ABCI responses unavailable for %d heights (first %d, last %d); results not checked\n",
+			n, r.SkippedResults[0], r.SkippedResults[n-1])
+	}
+	if r.Err != nil {
+		fmt.Fprintf(&sb, "FIRST BAD HEIGHT: %d\ncheck: %s\nerror: %v", r.FirstBadHeight, r.Check, r.Err)
+	} else {
+		sb.WriteString("no inconsistencies found")
+	}
+	return sb.String()
+}
+
+// verificationError associates an error with the check that produced it.
+type verificationError struct {
+	check string
+	err   error
+}
+
+func (e verificationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.check, e.err)
+}
+
+func failCheck(check string, format string, args ...any) error {
+	return verificationError{check: check, err: fmt.Errorf(format, args...)}
+}
+
+// verifyData walks the heights [startHeight, endHeight] of the block store
+// and checks them against each other and against the state store. It stops at
+// the first inconsistency.
+func verifyData(ctx context.Context, args dataVerificationArgs) dataVerificationReport {
+	report := dataVerificationReport{
+		StartHeight: args.startHeight,
+		EndHeight:   args.endHeight,
+	}
+
+	fail := func(height int64, err error) dataVerificationReport {
+		report.FirstBadHeight = height
+		report.Err = err
+		var vErr verificationError
+		if errors.As(err, &vErr) {
+			report.Check = vErr.check
+			report.Err = vErr.err
+		}
+		return report
+	}
+
+	state, err := args.stateStore.Load()
+	if err != nil {
+		return fail(args.startHeight, verificationError{check: checkState, err: err})
+	}
+	if state.IsEmpty() {
+		return fail(args.startHeight, failCheck(checkState, "no state found"))
+	}
+
+	var bar progressbar.Bar
+	bar.NewOption(args.startHeight-1, args.endHeight)
+	defer bar.Finish()
+
+	var prevMeta *types.BlockMeta
+	if args.startHeight > args.blockStore.Base() {
+		prevMeta = args.blockStore.LoadBlockMeta(args.startHeight - 1)
+	}
+	for height := args.startHeight; height <= args.endHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return fail(height, fmt.Errorf("verification terminated: %w", err))
+		}
+
+		meta, skipped, err := verifyHeight(args, state, prevMeta, height)
+		if skipped {
+			report.SkippedResults = append(report.SkippedResults, height-1)
+		}
+		if err != nil {
+			return fail(height, err)
+		}
+		report.VerifiedHeights++
+		prevMeta = meta
+		bar.Play(height)
+	}
+
+	if err := verifyTop(args, state); err != nil {
+		return fail(args.endHeight, err)
+	}
+
+	return report
+}
+
+// verifyHeight runs all the per-height checks. The stores panic when they
+// encounter data they cannot decode, so panics are reported as corruption.
+// skippedResults is true if the ABCI responses of the previous height were
+// not available.
+func verifyHeight(
+	args dataVerificationArgs,
+	state sm.State,
+	prevMeta *types.BlockMeta,
+	height int64,
+) (meta *types.BlockMeta, skippedResults bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = failCheck(checkBlock, "failed to decode stored data: %v", r)
+		}
+	}()
+
+	block, meta := args.blockStore.LoadBlock(height)
+	if block == nil || meta == nil {
+		return nil, false, failCheck(checkBlock, "block not found in the block store")
+	}
+	if block.Height != height {
+		return nil, false, failCheck(checkBlock, "stored block has height %d", block.Height)
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return nil, false, failCheck(checkBlock, "invalid block: %w", err)
+	}
+	if !bytes.Equal(block.Hash(), meta.BlockID.Hash) {
+		return nil, false, failCheck(checkBlock, "block hash %X does not match block meta hash %X",
+			block.Hash(), meta.BlockID.Hash)
+	}
+
+	if err := verifyBlockParts(args.blockStore, meta); err != nil {
+		return nil, false, err
+	}
+
+	// The previous height is needed to check the last commit and the results.
+	// It is only available above the base of the block store.
+	if height == state.InitialHeight {
+		if block.LastCommit.Size() != 0 {
+			return nil, false, failCheck(checkLastCommit, "initial block has a non-empty last commit")
+		}
+	} else if prevMeta != nil {
+		if !block.LastBlockID.Equals(prevMeta.BlockID) {
+			return nil, false, failCheck(checkHeaderLinkage, "last block ID %v does not match stored block ID %v at height %d",
+				block.LastBlockID, prevMeta.BlockID, height-1)
+		}
+
+		lastVals, err := args.stateStore.LoadValidators(height - 1)
+		if err != nil {
+			return nil, false, failCheck(checkLastCommit, "failed to load validators at height %d: %w", height-1, err)
+		}
+		if err := lastVals.VerifyCommit(state.ChainID, block.LastBlockID, height-1, block.LastCommit); err != nil {
+			return nil, false, failCheck(checkLastCommit, "invalid last commit: %w", err)
+		}
+		if commit := args.blockStore.LoadBlockCommit(height - 1); commit != nil &&
+			!bytes.Equal(commit.Hash(), block.LastCommitHash) {
+			return nil, false, failCheck(checkLastCommit, "stored commit for height %d does not match last commit hash", height-1)
+		}
+
+		skippedResults, err = verifyLastResults(args.stateStore, block)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	vals, err := args.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, false, failCheck(checkValidators, "failed to load validators: %w", err)
+	}
+	if !bytes.Equal(vals.Hash(), block.ValidatorsHash) {
+		return nil, false, failCheck(checkValidators, "validators hash %X does not match stored validators hash %X",
+			block.ValidatorsHash, vals.Hash())
+	}
+	if nextVals, err := args.stateStore.LoadValidators(height + 1); err == nil &&
+		!bytes.Equal(nextVals.Hash(), block.NextValidatorsHash) {
+		return nil, false, failCheck(checkValidators, "next validators hash %X does not match stored validators hash %X",
+			block.NextValidatorsHash, nextVals.Hash())
+	}
+
+	params, err := args.stateStore.LoadConsensusParams(height)
+	if err != nil {
+		return nil, false, failCheck(checkConsensusParams, "failed to load consensus params: %w", err)
+	}
+	if !bytes.Equal(params.Hash(), block.ConsensusHash) {
+		return nil, false, failCheck(checkConsensusParams, "consensus hash %X does not match stored params hash %X",
+			block.ConsensusHash, params.Hash())
+	}
+
+	return meta, skippedResults, nil
+}
+
+// verifyBlockParts checks that every stored part is present and proven
+// against the part set header of the block ID.
+func verifyBlockParts(bs sm.BlockStore, meta *types.BlockMeta) error {
+	psh := meta.BlockID.PartSetHeader
+	partSet := types.NewPartSetFromHeader(psh)
+	for i := 0; i < int(psh.Total); i++ {
+		part := bs.LoadBlockPart(meta.Header.Height, i)
+		if part == nil {
+			return failCheck(checkBlockParts, "part %d of %d not found", i, psh.Total)
+		}
+		if err := part.ValidateBasic(); err != nil {
+			return failCheck(checkBlockParts, "invalid part %d: %w", i, err)
+		}
+		if added, err := partSet.AddPart(part); err != nil || !added {
+			return failCheck(checkBlockParts, "part %d does not match part set hash %X: %v", i, psh.Hash, err)
+		}
+	}
+	return nil
+}
+
+// verifyLastResults checks LastResultsHash and AppHash of the block against
+// the ABCI responses stored for the previous height. It returns true if the
+// responses are not available.
+func verifyLastResults(ss sm.Store, block *types.Block) (bool, error) {
+	resp, err := ss.LoadFinalizeBlockResponse(block.Height - 1)
+	if err != nil {
+		var errNoResp sm.ErrNoABCIResponsesForHeight
+		if errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted) || errors.As(err, &errNoResp) {
+			return true, nil
+		}
+		return false, failCheck(checkLastResults, "failed to load ABCI responses for height %d: %w", block.Height-1, err)
+	}
+
+	if hash := sm.TxResultsHash(resp.TxResults); !bytes.Equal(hash, block.LastResultsHash) {
+		return false, failCheck(checkLastResults, "last results hash %X does not match hash %X of stored responses",
+			block.LastResultsHash, hash)
+	}
+	// Responses migrated from the legacy format do not carry an app hash.
+	if resp.AppHash != nil && !bytes.Equal(resp.AppHash, block.AppHash) {
+		return false, failCheck(checkLastResults, "app hash %X does not match app hash %X of stored responses",
+			block.AppHash, resp.AppHash)
+	}
+	return false, nil
+}
+
+// verifyTop checks the seen commit of the latest block and that the persisted
+// state matches the top of the block store.
+func verifyTop(args dataVerificationArgs, state sm.State) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = failCheck(checkSeenCommit, "failed to decode stored data: %v", r)
+		}
+	}()
+
+	height := args.blockStore.Height()
+	if args.endHeight != height {
+		return nil
+	}
+
+	meta := args.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return failCheck(checkBlock, "block meta not found")
+	}
+	if seenCommit := args.blockStore.LoadSeenCommit(height); seenCommit != nil {
+		vals, err := args.stateStore.LoadValidators(height)
+		if err != nil {
+			return failCheck(checkSeenCommit, "failed to load validators: %w", err)
+		}
+		if err := vals.VerifyCommit(state.ChainID, meta.BlockID, height, seenCommit); err != nil {
+			return failCheck(checkSeenCommit, "invalid seen commit: %w", err)
+		}
+	}
+
+	// Persistence of blocks and state is not atomic, so the block store may
+	// be one height ahead of the state.
+	switch state.LastBlockHeight {
+	case height:
+		if !state.LastBlockID.Equals(meta.BlockID) {
+			return failCheck(checkState, "state last block ID %v does not match stored block ID %v",
+				state.LastBlockID, meta.BlockID)
+		}
+	case height - 1:
+	default:
+		return failCheck(checkState, "state height %d is not equal or one below the block store height %d",
+			state.LastBlockHeight, height)
+	}
+	return nil
+}
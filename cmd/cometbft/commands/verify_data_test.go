@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// makeTestChain creates a block store and a state store containing a valid
+// chain of numBlocks blocks signed by a single validator.
+func makeTestChain(t *testing.T, numBlocks int64) (*store.BlockStore, sm.Store) {
+	t.Helper()
+
+	vals, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	genDoc := test.GenesisDoc(time.Now(), vals.Validators, types.DefaultConsensusParams(), test.DefaultTestChainID)
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	bs := store.NewBlockStore(dbm.NewMemDB())
+	ss := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	require.NoError(t, ss.Save(state))
+
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= numBlocks; height++ {
+		block := state.MakeBlock(height, test.MakeNTxs(height, 2), lastCommit, nil, vals.Validators[0].Address)
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+		commit, err := test.MakeCommit(blockID, height, 0, state.Validators, privVals, state.ChainID, block.Time.Add(time.Second))
+		require.NoError(t, err)
+		bs.SaveBlock(block, parts, commit)
+
+		resp := &abcitypes.FinalizeBlockResponse{
+			TxResults: []*abcitypes.ExecTxResult{{Code: 0}, {Code: 1}},
+			AppHash:   []byte{byte(height)},
+		}
+		require.NoError(t, ss.SaveFinalizeBlockResponse(height, resp))
+
+		state.LastBlockHeight = height
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastValidators = state.Validators.Copy()
+		state.Validators = state.NextValidators.Copy()
+		state.NextValidators = state.NextValidators.CopyIncrementProposerPriority(1)
+		state.LastResultsHash = sm.TxResultsHash(resp.TxResults)
+		state.AppHash = resp.AppHash
+		require.NoError(t, ss.Save(state))
+
+		lastCommit = commit
+	}
+
+	return bs, ss
+}
+
+func TestVerifyData(t *testing.T) {
+	const numBlocks int64 = 5
+	bs, ss := makeTestChain(t, numBlocks)
+
+	args := dataVerificationArgs{
+		startHeight: 1,
+		endHeight:   numBlocks,
+		blockStore:  bs,
+		stateStore:  ss,
+	}
+	report := verifyData(context.Background(), args)
+	require.NoError(t, report.Err)
+	require.Equal(t, numBlocks, report.VerifiedHeights)
+	require.Empty(t, report.SkippedResults)
+
+	// Overwrite the ABCI responses of height 2 so that they no longer match
+	// LastResultsHash of block 3.
+	require.NoError(t, ss.SaveFinalizeBlockResponse(2, &abcitypes.FinalizeBlockResponse{
+		TxResults: []*abcitypes.ExecTxResult{{Code: 2}},
+		AppHash:   []byte{2},
+	}))
+
+	report = verifyData(context.Background(), args)
+	require.Error(t, report.Err)
+	require.Equal(t, int64(3), report.FirstBadHeight)
+	require.Equal(t, checkLastResults, report.Check)
+	require.Equal(t, int64(2), report.VerifiedHeights)
+
+	// Starting after the bad height succeeds.
+	args.startHeight = 4
+	report = verifyData(context.Background(), args)
+	require.NoError(t, report.Err)
+	require.Equal(t, int64(2), report.VerifiedHeights)
+}
+
+func TestVerifyDataStateMismatch(t *testing.T) {
+	const numBlocks int64 = 3
+	bs, ss := makeTestChain(t, numBlocks)
+
+	state, err := ss.Load()
+	require.NoError(t, err)
+	state.LastBlockID = test.MakeBlockID()
+	require.NoError(t, ss.Save(state))
+
+	report := verifyData(context.Background(), dataVerificationArgs{
+		startHeight: 1,
+		endHeight:   numBlocks,
+		blockStore:  bs,
+		stateStore:  ss,
+	})
+	require.Error(t, report.Err)
+	require.Equal(t, numBlocks, report.FirstBadHeight)
+	require.Equal(t, checkState, report.Check)
+}
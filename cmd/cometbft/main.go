@@ -25,8 +25,16 @@ func main() {
 		cmd.TestnetFilesCmd,
 		cmd.ShowNodeIDCmd,
 		cmd.GenNodeKeyCmd,
+		cmd.KeyFileCmd,
+		cmd.AuditLogCmd,
 		cmd.VersionCmd,
 		cmd.RollbackStateCmd,
+		cmd.VerifyDataCmd,
+		cmd.MigrateDBCmd,
+		cmd.MigrateDBKeyLayoutCmd,
+		cmd.ExportSnapshotCmd,
+		cmd.RestoreSnapshotCmd,
+		cmd.ExportBlocksCmd,
 		cmd.CompactGoLevelDBCmd,
 		cmd.InspectCmd,
 		debug.DebugCmd,
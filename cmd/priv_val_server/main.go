@@ -1,23 +1,37 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"net"
 	"os"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	cmtnet "github.com/cometbft/cometbft/internal/net"
 	cmtos "github.com/cometbft/cometbft/internal/os"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/privval"
 )
 
 func main() {
 	var (
-		addr             = flag.String("addr", ":26659", "Address of client to connect to")
+		addr = flag.String("addr", ":26659",
+			"Address of client to connect to, or address to serve the gRPC signing service on with the grpc:// scheme")
 		chainID          = flag.String("chain-id", "mychain", "chain id")
 		privValKeyPath   = flag.String("priv-key", "", "priv val key file path")
 		privValStatePath = flag.String("priv-state", "", "priv val state file path")
+		auditLogPath     = flag.String("audit-log", "", "audit log file path (disabled if empty)")
+		sharedState      = flag.Bool("shared-state", false,
+			"share the priv val state file with the other signers of the key, for failover")
+		grpcCertFile = flag.String("grpc-cert", "", "certificate file of the gRPC signing service, for mutual TLS")
+		grpcKeyFile  = flag.String("grpc-key", "", "key file of the gRPC signing service, for mutual TLS")
+		grpcRootCA   = flag.String("grpc-root-ca", "", "root CA file verifying the certificate of the node, for mutual TLS")
+		grpcInsecure = flag.Bool("grpc-insecure", false,
+			"serve the gRPC signing service without transport security, only on a trusted network")
 
 		logger = log.NewLogger(
 			os.Stdout,
@@ -34,23 +48,50 @@ func main() {
 	)
 
 	pv := privval.LoadFilePV(*privValKeyPath, *privValStatePath)
+	if *sharedState {
+		if err := pv.ShareLastSignState(); err != nil {
+			logger.Error("Failed to share the priv val state", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	var auditLog *privval.AuditLog
+	if *auditLogPath != "" {
+		var err error
+		auditLog, err = privval.OpenAuditLog(*auditLogPath, logger)
+		if err != nil {
+			logger.Error("Failed to open audit log", "err", err)
+			os.Exit(1)
+		}
+		pv.SetAuditLog(auditLog)
+	}
 
-	var dialer privval.SocketDialer
+	var ss service.Service
 	protocol, address := cmtnet.ProtocolAndAddress(*addr)
 	switch protocol {
 	case "unix":
-		dialer = privval.DialUnixFn(address)
+		ss = privval.NewSignerServer(privval.NewSignerDialerEndpoint(logger, privval.DialUnixFn(address)), *chainID, pv)
 	case "tcp":
 		connTimeout := 3 * time.Second // TODO
-		dialer = privval.DialTCPFn(address, connTimeout, ed25519.GenPrivKey())
+		dialer := privval.DialTCPFn(address, connTimeout, ed25519.GenPrivKey())
+		ss = privval.NewSignerServer(privval.NewSignerDialerEndpoint(logger, dialer), *chainID, pv)
+	case "grpc":
+		opts, err := grpcServerOptions(*grpcCertFile, *grpcKeyFile, *grpcRootCA, *grpcInsecure, logger)
+		if err != nil {
+			logger.Error("Invalid gRPC signing service options", "err", err)
+			os.Exit(1)
+		}
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			logger.Error("Failed to listen", "addr", address, "err", err)
+			os.Exit(1)
+		}
+		ss = privval.NewGRPCSignerServer(listener, *chainID, pv, logger, opts...)
 	default:
 		logger.Error("Unknown protocol", "protocol", protocol)
 		os.Exit(1)
 	}
 
-	sd := privval.NewSignerDialerEndpoint(logger, dialer)
-	ss := privval.NewSignerServer(sd, *chainID, pv)
-
 	err := ss.Start()
 	if err != nil {
 		panic(err)
@@ -62,8 +103,28 @@ func main() {
 		if err != nil {
 			panic(err)
 		}
+		if err := auditLog.Close(); err != nil {
+			logger.Error("Failed to close audit log", "err", err)
+		}
 	})
 
 	// Run forever.
 	select {}
 }
+
+// grpcServerOptions returns the options of the gRPC signing service: mutual
+// TLS with the given files, unless insecure is set and no file is.
+func grpcServerOptions(certFile, keyFile, rootCAFile string, insecure bool, logger log.Logger) ([]grpc.ServerOption, error) {
+	if certFile == "" && keyFile == "" && rootCAFile == "" {
+		if !insecure {
+			return nil, errors.New("no TLS files set, and -grpc-insecure is not set")
+		}
+		logger.Info("Serving the gRPC signing service without transport security")
+		return nil, nil
+	}
+	creds, err := privval.GRPCTLSCredentials(certFile, keyFile, rootCAFile, true)
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.ServerOption{grpc.Creds(creds)}, nil
+}
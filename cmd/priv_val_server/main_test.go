@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtnet "github.com/cometbft/cometbft/internal/net"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// signerProcessEnv is set when the test binary is run as a signer process.
+const signerProcessEnv = "PRIV_VAL_SERVER_TEST_SIGNER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(signerProcessEnv) != "" {
+		main()
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// startSigner runs the priv_val_server in another process, serving the gRPC
+// signing service on a free port, and returns the process and its address.
+func startSigner(t *testing.T, chainID, keyFile, stateFile string) (*exec.Cmd, string) {
+	t.Helper()
+	port, err := cmtnet.GetFreePort()
+	require.NoError(t, err)
+	addr := fmt.Sprintf("grpc://127.0.0.1:%d", port)
+
+	cmd := exec.Command(os.Args[0],
+		"-addr", addr,
+		"-chain-id", chainID,
+		"-priv-key", keyFile,
+		"-priv-state", stateFile,
+		"-shared-state",
+		"-grpc-insecure",
+	)
+	cmd.Env = append(os.Environ(), signerProcessEnv+"=1")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+	return cmd, addr
+}
+
+func newPrevote(address types.Address, height int64) *types.Vote {
+	hash := cmtrand.Bytes(tmhash.Size)
+	return &types.Vote{
+		Type:             types.PrevoteType,
+		Height:           height,
+		BlockID:          types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Total: 1, Hash: hash}},
+		Timestamp:        cmttime.Now(),
+		ValidatorAddress: address,
+	}
+}
+
+// TestSharedStateFailover runs two signer processes of the same key, sharing
+// their last sign state, and checks that a node failing over from one to the
+// other cannot get conflicting votes signed.
+func TestSharedStateFailover(t *testing.T) {
+	const chainID = "test-chain"
+	dir := t.TempDir()
+	keyFile, stateFile := filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json")
+	pv, err := privval.GenFilePV(keyFile, stateFile, nil)
+	require.NoError(t, err)
+	pv.Save()
+
+	signers := make([]types.PrivValidator, 2)
+	cmds := make([]*exec.Cmd, 2)
+	for i := range signers {
+		var addr string
+		cmds[i], addr = startSigner(t, chainID, keyFile, stateFile)
+		sc, err := privval.NewGRPCSignerClient(addr, chainID,
+			privval.GRPCInsecure(), privval.GRPCRequestTimeout(time.Second))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = sc.Close() })
+		require.Eventually(t, func() bool { return sc.Ping() == nil }, 10*time.Second, 50*time.Millisecond)
+		signers[i] = sc
+	}
+	fc, err := privval.NewFailoverSignerClient(log.NewNopLogger(), signers...)
+	require.NoError(t, err)
+
+	pubKey, err := fc.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.PubKey, pubKey)
+
+	vote := newPrevote(pv.Key.Address, 1).ToProto()
+	require.NoError(t, fc.SignVote(chainID, vote, false))
+	require.Equal(t, 0, fc.Current())
+
+	// The first signer crashes: the node fails over to the second one, which
+	// refuses to sign a vote conflicting with the one signed by the first.
+	require.NoError(t, cmds[0].Process.Kill())
+	_ = cmds[0].Wait()
+
+	conflicting := newPrevote(pv.Key.Address, 1).ToProto()
+	require.Error(t, fc.SignVote(chainID, conflicting, false))
+
+	// It returns the signature of the first signer for the same vote, and
+	// signs the next heights.
+	again := *vote
+	again.Signature = nil
+	require.NoError(t, fc.SignVote(chainID, &again, false))
+	require.Equal(t, vote.Signature, again.Signature)
+	require.Equal(t, 1, fc.Current())
+
+	require.NoError(t, fc.SignVote(chainID, newPrevote(pv.Key.Address, 2).ToProto(), false))
+}
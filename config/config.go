@@ -267,10 +267,28 @@ type BaseConfig struct {
 	// Path to the JSON file containing the last sign state of a validator
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
+	// Path to the audit log of the votes and proposals signed by the
+	// validator. If empty, no audit log is kept.
+	PrivValidatorAuditLog string `mapstructure:"priv_validator_audit_log_file"`
+
 	// TCP or UNIX socket address for CometBFT to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process, or the grpc://
+	// address of a remote signer serving the gRPC PrivValidatorService.
+	// Several comma-separated grpc:// addresses may be set for failover,
+	// which is not supported with TCP or UNIX sockets.
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// PEM files with the certificate and key of the node, and the certificate
+	// authority of the remote signer, used for mutual TLS with a gRPC remote
+	// signer. They are required, unless PrivValidatorGRPCInsecure is set.
+	PrivValidatorGRPCCert   string `mapstructure:"priv_validator_grpc_cert_file"`
+	PrivValidatorGRPCKey    string `mapstructure:"priv_validator_grpc_key_file"`
+	PrivValidatorGRPCRootCA string `mapstructure:"priv_validator_grpc_root_ca_file"`
+
+	// Connect to a gRPC remote signer without transport security, which is
+	// only acceptable on a trusted network.
+	PrivValidatorGRPCInsecure bool `mapstructure:"priv_validator_grpc_insecure"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
@@ -325,6 +343,39 @@ func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
 }
 
+// PrivValidatorAuditLogFile returns the full path to the audit log of the
+// validator, or an empty string if it is disabled.
+func (cfg BaseConfig) PrivValidatorAuditLogFile() string {
+	if cfg.PrivValidatorAuditLog == "" {
+		return ""
+	}
+	return rootify(cfg.PrivValidatorAuditLog, cfg.RootDir)
+}
+
+// PrivValidatorGRPCTLSEnabled returns true if the TLS files of the gRPC remote
+// signer connection are set.
+func (cfg BaseConfig) PrivValidatorGRPCTLSEnabled() bool {
+	return cfg.PrivValidatorGRPCCert != "" || cfg.PrivValidatorGRPCKey != "" || cfg.PrivValidatorGRPCRootCA != ""
+}
+
+// PrivValidatorGRPCCertFile returns the full path to the certificate used to
+// connect to a gRPC remote signer.
+func (cfg BaseConfig) PrivValidatorGRPCCertFile() string {
+	return rootify(cfg.PrivValidatorGRPCCert, cfg.RootDir)
+}
+
+// PrivValidatorGRPCKeyFile returns the full path to the key used to connect to
+// a gRPC remote signer.
+func (cfg BaseConfig) PrivValidatorGRPCKeyFile() string {
+	return rootify(cfg.PrivValidatorGRPCKey, cfg.RootDir)
+}
+
+// PrivValidatorGRPCRootCAFile returns the full path to the certificate
+// authority of a gRPC remote signer.
+func (cfg BaseConfig) PrivValidatorGRPCRootCAFile() string {
+	return rootify(cfg.PrivValidatorGRPCRootCA, cfg.RootDir)
+}
+
 // NodeKeyFile returns the full path to the node_key.json file.
 func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
@@ -350,6 +401,25 @@ func (cfg BaseConfig) ValidateBasic() error {
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
 
+	if cfg.PrivValidatorGRPCTLSEnabled() &&
+		(cfg.PrivValidatorGRPCCert == "" || cfg.PrivValidatorGRPCKey == "" || cfg.PrivValidatorGRPCRootCA == "") {
+		return errors.New("priv_validator_grpc_cert_file, priv_validator_grpc_key_file and " +
+			"priv_validator_grpc_root_ca_file must be set together")
+	}
+	if addrs := strings.Split(cfg.PrivValidatorListenAddr, ","); len(addrs) > 1 {
+		for _, addr := range addrs {
+			if !strings.HasPrefix(strings.TrimSpace(addr), "grpc://") {
+				return errors.New("several priv_validator_laddr addresses are only supported with grpc:// remote signers")
+			}
+		}
+	}
+	if strings.HasPrefix(cfg.PrivValidatorListenAddr, "grpc://") &&
+		!cfg.PrivValidatorGRPCTLSEnabled() && !cfg.PrivValidatorGRPCInsecure {
+		return errors.New("a gRPC remote signer requires priv_validator_grpc_cert_file, " +
+			"priv_validator_grpc_key_file and priv_validator_grpc_root_ca_file, " +
+			"unless priv_validator_grpc_insecure is set")
+	}
+
 	return cfg.validateProxyApp()
 }
 
@@ -628,6 +698,14 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC snapshot service serves the state sync snapshots of the
+	// application to clients which do not speak the p2p protocol.
+	SnapshotService *GRPCSnapshotServiceConfig `mapstructure:"snapshot_service"`
+
+	// The gRPC consensus timeline service serves the consensus timelines of
+	// the recent heights.
+	ConsensusTimelineService *GRPCConsensusTimelineServiceConfig `mapstructure:"consensus_timeline_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -635,21 +713,25 @@ type GRPCConfig struct {
 
 func DefaultGRPCConfig() *GRPCConfig {
 	return &GRPCConfig{
-		ListenAddress:       "",
-		VersionService:      DefaultGRPCVersionServiceConfig(),
-		BlockService:        DefaultGRPCBlockServiceConfig(),
-		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
-		Privileged:          DefaultGRPCPrivilegedConfig(),
+		ListenAddress:            "",
+		VersionService:           DefaultGRPCVersionServiceConfig(),
+		BlockService:             DefaultGRPCBlockServiceConfig(),
+		BlockResultsService:      DefaultGRPCBlockResultsServiceConfig(),
+		SnapshotService:          DefaultGRPCSnapshotServiceConfig(),
+		ConsensusTimelineService: DefaultGRPCConsensusTimelineServiceConfig(),
+		Privileged:               DefaultGRPCPrivilegedConfig(),
 	}
 }
 
 func TestGRPCConfig() *GRPCConfig {
 	return &GRPCConfig{
-		ListenAddress:       "tcp://127.0.0.1:36670",
-		VersionService:      TestGRPCVersionServiceConfig(),
-		BlockService:        TestGRPCBlockServiceConfig(),
-		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
-		Privileged:          TestGRPCPrivilegedConfig(),
+		ListenAddress:            "tcp://127.0.0.1:36670",
+		VersionService:           TestGRPCVersionServiceConfig(),
+		BlockService:             TestGRPCBlockServiceConfig(),
+		BlockResultsService:      DefaultGRPCBlockResultsServiceConfig(),
+		SnapshotService:          TestGRPCSnapshotServiceConfig(),
+		ConsensusTimelineService: TestGRPCConsensusTimelineServiceConfig(),
+		Privileged:               TestGRPCPrivilegedConfig(),
 	}
 }
 
@@ -663,6 +745,9 @@ func (cfg *GRPCConfig) ValidateBasic() error {
 			)
 		}
 	}
+	if cfg.SnapshotService != nil && cfg.SnapshotService.SendRate < 0 {
+		return cmterrors.ErrNegativeField{Field: "snapshot_service.send_rate"}
+	}
 	return nil
 }
 
@@ -708,6 +793,44 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCSnapshotServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Maximum rate at which snapshot chunks are streamed, in bytes per
+	// second, shared between all the clients. 0 means unlimited.
+	SendRate int64 `mapstructure:"send_rate"`
+}
+
+func DefaultGRPCSnapshotServiceConfig() *GRPCSnapshotServiceConfig {
+	return &GRPCSnapshotServiceConfig{
+		Enabled:  false,
+		SendRate: 5120000, // 5 mB/s
+	}
+}
+
+func TestGRPCSnapshotServiceConfig() *GRPCSnapshotServiceConfig {
+	return &GRPCSnapshotServiceConfig{
+		Enabled:  true,
+		SendRate: 5120000,
+	}
+}
+
+type GRPCConsensusTimelineServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCConsensusTimelineServiceConfig() *GRPCConsensusTimelineServiceConfig {
+	return &GRPCConsensusTimelineServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCConsensusTimelineServiceConfig() *GRPCConsensusTimelineServiceConfig {
+	return &GRPCConsensusTimelineServiceConfig{
+		Enabled: true,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -1109,6 +1232,7 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 // StateSyncConfig defines the configuration for the CometBFT state sync service.
 type StateSyncConfig struct {
 	Enable              bool          `mapstructure:"enable"`
+	UseP2P              bool          `mapstructure:"use_p2p"`
 	TempDir             string        `mapstructure:"temp_dir"`
 	RPCServers          []string      `mapstructure:"rpc_servers"`
 	TrustPeriod         time.Duration `mapstructure:"trust_period"`
@@ -1117,6 +1241,18 @@ type StateSyncConfig struct {
 	MaxDiscoveryTime    time.Duration `mapstructure:"max_discovery_time"`
 	ChunkRequestTimeout time.Duration `mapstructure:"chunk_request_timeout"`
 	ChunkFetchers       int32         `mapstructure:"chunk_fetchers"`
+
+	// PersistProgress makes state sync persist the snapshot being restored,
+	// the fetched chunks and the chunks applied by the app in the data
+	// directory, so that a restarted node resumes restoring the snapshot
+	// instead of starting over.
+	PersistProgress bool `mapstructure:"persist_progress"`
+
+	// SnapshotFile is the path to a snapshot file to restore the node from
+	// when it starts with empty stores, instead of syncing from peers. The
+	// snapshot is verified from the trusted header given by TrustPeriod,
+	// TrustHeight and TrustHash.
+	SnapshotFile string `mapstructure:"snapshot_file"`
 }
 
 func (cfg *StateSyncConfig) TrustHashBytes() []byte {
@@ -1145,18 +1281,25 @@ func TestStateSyncConfig() *StateSyncConfig {
 
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
+	if cfg.Enable && cfg.SnapshotFile != "" {
+		return ErrSnapshotFileWithStateSync
+	}
+
 	if cfg.Enable {
-		if len(cfg.RPCServers) == 0 {
-			return cmterrors.ErrRequiredField{Field: "rpc_servers"}
-		}
+		// Light blocks are fetched from peers instead of RPC servers with use_p2p.
+		if !cfg.UseP2P {
+			if len(cfg.RPCServers) == 0 {
+				return cmterrors.ErrRequiredField{Field: "rpc_servers"}
+			}
 
-		if len(cfg.RPCServers) < 2 {
-			return ErrNotEnoughRPCServers
-		}
+			if len(cfg.RPCServers) < 2 {
+				return ErrNotEnoughRPCServers
+			}
 
-		for _, server := range cfg.RPCServers {
-			if len(server) == 0 {
-				return ErrEmptyRPCServerEntry
+			for _, server := range cfg.RPCServers {
+				if len(server) == 0 {
+					return ErrEmptyRPCServerEntry
+				}
 			}
 		}
 
@@ -1164,21 +1307,8 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 			return cmterrors.ErrNegativeField{Field: "max_discovery_time"}
 		}
 
-		if cfg.TrustPeriod <= 0 {
-			return cmterrors.ErrRequiredField{Field: "trusted_period"}
-		}
-
-		if cfg.TrustHeight <= 0 {
-			return cmterrors.ErrRequiredField{Field: "trusted_height"}
-		}
-
-		if len(cfg.TrustHash) == 0 {
-			return cmterrors.ErrRequiredField{Field: "trusted_hash"}
-		}
-
-		_, err := hex.DecodeString(cfg.TrustHash)
-		if err != nil {
-			return fmt.Errorf("invalid trusted_hash: %w", err)
+		if err := cfg.validateTrustOptions(); err != nil {
+			return err
 		}
 
 		if cfg.ChunkRequestTimeout < 5*time.Second {
@@ -1190,6 +1320,33 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 		}
 	}
 
+	if cfg.SnapshotFile != "" {
+		if err := cfg.validateTrustOptions(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cfg *StateSyncConfig) validateTrustOptions() error {
+	if cfg.TrustPeriod <= 0 {
+		return cmterrors.ErrRequiredField{Field: "trusted_period"}
+	}
+
+	if cfg.TrustHeight <= 0 {
+		return cmterrors.ErrRequiredField{Field: "trusted_height"}
+	}
+
+	if len(cfg.TrustHash) == 0 {
+		return cmterrors.ErrRequiredField{Field: "trusted_hash"}
+	}
+
+	_, err := hex.DecodeString(cfg.TrustHash)
+	if err != nil {
+		return fmt.Errorf("invalid trusted_hash: %w", err)
+	}
+
 	return nil
 }
 
@@ -1208,6 +1365,12 @@ func (cfg *StateSyncConfig) PossibleMisconfigurations() []string {
 // BlockSyncConfig (formerly known as FastSync) defines the configuration for the CometBFT block sync service.
 type BlockSyncConfig struct {
 	Version string `mapstructure:"version"`
+
+	// ArchivePath is the path to a local block archive to sync blocks from
+	// before syncing from peers: either a file written by the export-blocks
+	// command, or the data directory or blockstore.db directory of another,
+	// stopped, node.
+	ArchivePath string `mapstructure:"archive_path"`
 }
 
 // DefaultBlockSyncConfig returns a default configuration for the block sync service.
@@ -1255,6 +1418,16 @@ type ConsensusConfig struct {
 	// Deprecated: use `next_block_delay` in the ABCI application's `FinalizeBlockResponse`.
 	TimeoutCommit time.Duration `mapstructure:"timeout_commit"`
 
+	// Derive timeout_propose and timeout_vote from the observed latencies of
+	// the proposals and votes, instead of using the static values
+	AdaptiveTimeouts bool `mapstructure:"adaptive_timeouts"`
+	// Bounds of the adaptive timeout_propose, before the per-round delta is added
+	TimeoutProposeMin time.Duration `mapstructure:"timeout_propose_min"`
+	TimeoutProposeMax time.Duration `mapstructure:"timeout_propose_max"`
+	// Bounds of the adaptive timeout_vote, before the per-round delta is added
+	TimeoutVoteMin time.Duration `mapstructure:"timeout_vote_min"`
+	TimeoutVoteMax time.Duration `mapstructure:"timeout_vote_max"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -1265,6 +1438,17 @@ type ConsensusConfig struct {
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// Number of recent heights whose consensus timeline is kept in memory. 0
+	// disables the timeline.
+	TimelineHeights int `mapstructure:"timeline_heights"`
+	// Directory where the consensus timelines of the committed heights are
+	// persisted, in the background, if the timeline is enabled. Empty disables
+	// persistence.
+	TimelinePath string `mapstructure:"timeline_dir"`
+	// Number of heights whose consensus timeline is kept on disk. 0 keeps all
+	// of them.
+	TimelineRetainHeights int64 `mapstructure:"timeline_retain_heights"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service.
@@ -1276,12 +1460,20 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutVote:                      1000 * time.Millisecond,
 		TimeoutVoteDelta:                 500 * time.Millisecond,
 		TimeoutCommit:                    0 * time.Millisecond,
+		AdaptiveTimeouts:                 false,
+		TimeoutProposeMin:                500 * time.Millisecond,
+		TimeoutProposeMax:                10000 * time.Millisecond,
+		TimeoutVoteMin:                   100 * time.Millisecond,
+		TimeoutVoteMax:                   3000 * time.Millisecond,
 		CreateEmptyBlocks:                true,
 		CreateEmptyBlocksInterval:        0 * time.Second,
 		PeerGossipSleepDuration:          100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration:      2000 * time.Millisecond,
 		PeerGossipIntraloopSleepDuration: 0 * time.Second,
 		DoubleSignCheckHeight:            int64(0),
+		TimelineHeights:                  0,
+		TimelinePath:                     filepath.Join(DefaultDataDir, "cs.timeline"),
+		TimelineRetainHeights:            0,
 	}
 }
 
@@ -1344,6 +1536,15 @@ func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
 }
 
+// TimelineDir returns the full path to the directory where the consensus
+// timelines are persisted, or an empty string if they are not.
+func (cfg *ConsensusConfig) TimelineDir() string {
+	if cfg.TimelinePath == "" {
+		return ""
+	}
+	return rootify(cfg.TimelinePath, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *ConsensusConfig) ValidateBasic() error {
@@ -1362,6 +1563,18 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return cmterrors.ErrNegativeField{Field: "timeout_commit"}
 	}
+	if cfg.TimeoutProposeMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_propose_min"}
+	}
+	if cfg.TimeoutProposeMax < cfg.TimeoutProposeMin {
+		return fmt.Errorf("timeout_propose_max must be >= timeout_propose_min (%v)", cfg.TimeoutProposeMin)
+	}
+	if cfg.TimeoutVoteMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_vote_min"}
+	}
+	if cfg.TimeoutVoteMax < cfg.TimeoutVoteMin {
+		return fmt.Errorf("timeout_vote_max must be >= timeout_vote_min (%v)", cfg.TimeoutVoteMin)
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return cmterrors.ErrNegativeField{Field: "create_empty_blocks_interval"}
 	}
@@ -1374,6 +1587,12 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return cmterrors.ErrNegativeField{Field: "double_sign_check_height"}
 	}
+	if cfg.TimelineHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeline_heights"}
+	}
+	if cfg.TimelineRetainHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeline_retain_heights"}
+	}
 	return nil
 }
 
@@ -1411,6 +1630,14 @@ type StorageConfig struct {
 	// Not that this is an experimental feature and switching back from v2 to v1
 	// is not supported by CometBFT.
 	ExperimentalKeyLayout string `mapstructure:"experimental_db_key_layout"`
+
+	// If set to true, databases created with the v1 key layout are migrated
+	// to the v2 layout when the node starts, before they are used. This is a
+	// startup migration, not a background one: the node does not start until
+	// it is complete. Large databases are better migrated offline with the
+	// migrate-db-key-layout command.
+	// Requires ExperimentalKeyLayout to be v2.
+	ExperimentalMigrateKeyLayout bool `mapstructure:"experimental_migrate_db_key_layout"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
@@ -1441,6 +1668,9 @@ func (cfg *StorageConfig) ValidateBasic() error {
 	if cfg.ExperimentalKeyLayout != "v1" && cfg.ExperimentalKeyLayout != "v2" {
 		return fmt.Errorf("unsupported version of DB Key layout, expected v1 or v2, got %s", cfg.ExperimentalKeyLayout)
 	}
+	if cfg.ExperimentalMigrateKeyLayout && cfg.ExperimentalKeyLayout != "v2" {
+		return errors.New("experimental_migrate_db_key_layout requires experimental_db_key_layout to be v2")
+	}
 	return nil
 }
 
@@ -1580,6 +1810,12 @@ type PruningConfig struct {
 	Interval time.Duration `mapstructure:"interval"`
 	// Data companion-related pruning configuration.
 	DataCompanion *DataCompanionPruningConfig `mapstructure:"data_companion"`
+	// Blocks whose time is older than this may be pruned. 0 disables the
+	// age target.
+	MaxBlockAge time.Duration `mapstructure:"max_block_age"`
+	// The oldest blocks may be pruned to keep the block store under this size,
+	// in bytes. 0 disables the size target.
+	MaxBlockStoreBytes int64 `mapstructure:"max_block_store_bytes"`
 }
 
 func DefaultPruningConfig() *PruningConfig {
@@ -1600,6 +1836,12 @@ func (cfg *PruningConfig) ValidateBasic() error {
 	if cfg.Interval <= 0 {
 		return errors.New("interval must be > 0")
 	}
+	if cfg.MaxBlockAge < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_block_age"}
+	}
+	if cfg.MaxBlockStoreBytes < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_block_store_bytes"}
+	}
 	if err := cfg.DataCompanion.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [data_companion] section: %w", err)
 	}
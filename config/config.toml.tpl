@@ -61,10 +61,33 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 # Path to the JSON file containing the last sign state of a validator
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
+# Path to the audit log of the votes and proposals signed by the validator,
+# and of the ones it refused to sign. The log is rotated every 10MB, and the
+# oldest files are removed beyond 1GB. If empty, no audit log is kept.
+# Use the "cometbft audit-log" command to query it. The log is only kept
+# without a remote signer (see priv_validator_laddr).
+priv_validator_audit_log_file = "{{ js .BaseConfig.PrivValidatorAuditLog }}"
+
 # TCP or UNIX socket address for CometBFT to listen on for
-# connections from an external PrivValidator process
+# connections from an external PrivValidator process, or the grpc://
+# address of a remote signer serving the gRPC PrivValidatorService.
+# Several comma-separated grpc:// addresses of signers sharing their last
+# sign state may be set, to fail over from one to the next. Failover is not
+# supported with TCP or UNIX sockets, for which a single address must be set.
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# PEM files with the certificate and key of the node, and the certificate
+# authority of the remote signer, used for mutual TLS with a gRPC remote signer.
+# Either all or none must be set, and they are required with a gRPC remote
+# signer unless priv_validator_grpc_insecure is true.
+priv_validator_grpc_cert_file = "{{ js .BaseConfig.PrivValidatorGRPCCert }}"
+priv_validator_grpc_key_file = "{{ js .BaseConfig.PrivValidatorGRPCKey }}"
+priv_validator_grpc_root_ca_file = "{{ js .BaseConfig.PrivValidatorGRPCRootCA }}"
+
+# Connect to a gRPC remote signer without transport security. Only set it when
+# the signer is reached over a trusted network.
+priv_validator_grpc_insecure = {{ .BaseConfig.PrivValidatorGRPCInsecure }}
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
@@ -221,6 +244,26 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC snapshot service lists the state sync snapshots of the application and
+# streams their chunks, for tools which do not speak the p2p protocol (e.g. to
+# archive snapshots or restore a node offline).
+#
+# Disabled by default.
+[grpc.snapshot_service]
+enabled = {{ .GRPC.SnapshotService.Enabled }}
+
+# Maximum rate at which snapshot chunks are streamed, in bytes per second,
+# shared between all the clients. 0 means unlimited.
+send_rate = {{ .GRPC.SnapshotService.SendRate }}
+
+# The gRPC consensus timeline service serves the consensus timelines of the
+# recent heights (see consensus.timeline_heights), which record when the node
+# received the proposal, the block parts and the votes of each round.
+#
+# Disabled by default.
+[grpc.consensus_timeline_service]
+enabled = {{ .GRPC.ConsensusTimelineService.Enabled }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -412,6 +455,11 @@ dog_adjust_interval = "{{ .Mempool.DOGAdjustInterval }}"
 # starting from the height of the snapshot.
 enable = {{ .StateSync.Enable }}
 
+# Fetch the light blocks and consensus parameters used to verify the snapshot from connected
+# peers, over the p2p network, instead of from rpc_servers. Only the trusted height, header hash
+# and period are then needed.
+use_p2p = {{ .StateSync.UseP2P }}
+
 # RPC servers (comma-separated) for light client verification of the synced state machine and
 # retrieval of state data for node bootstrapping. Also needs a trusted height and corresponding
 # header hash obtained from a trusted source, and a period during which validators can be trusted.
@@ -435,9 +483,27 @@ temp_dir = "{{ .StateSync.TempDir }}"
 # peer (default: 1 minute).
 chunk_request_timeout = "{{ .StateSync.ChunkRequestTimeout }}"
 
-# The number of concurrent chunk fetchers to run (default: 1).
+# The maximum number of concurrent chunk fetchers to run (default: 1). The number of
+# concurrent chunk requests is halved when a request times out, and grows back as chunks
+# are received. Chunks are requested preferably from the peers serving them the fastest.
 chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 
+# Persist the snapshot being restored, the state and commit verified for it by the light client,
+# the fetched chunks and the chunks applied by the application in the data directory, so that
+# a node restarted during state sync resumes restoring the same snapshot instead of starting
+# over, without verifying the light blocks again. On restart, the snapshot is offered
+# to the application again: if it did not keep the chunks applied before the restart, it must
+# answer RETRY_SNAPSHOT to the first chunk, and all chunks are applied again from disk.
+persist_progress = {{ .StateSync.PersistProgress }}
+
+# Path to a snapshot file to restore the node from when it starts with empty stores,
+# instead of syncing from peers. The snapshot is verified from the trusted header given by
+# trust_height, trust_hash and trust_period, so no RPC servers or peers are needed.
+# Cannot be set together with enable = true. The file is written with the
+# "cometbft export-snapshot" command, and can also be restored with the
+# "cometbft restore-snapshot" command.
+snapshot_file = "{{ .StateSync.SnapshotFile }}"
+
 #######################################################
 ###       Block Sync Configuration Options          ###
 #######################################################
@@ -451,6 +517,12 @@ chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 #   1) "v0" - the default block sync implementation
 version = "{{ .BlockSync.Version }}"
 
+# Path to a local block archive to sync blocks from before syncing from peers:
+# either a file written by the "cometbft export-blocks" command, or the data
+# directory (or blockstore.db directory) of another node, which must be stopped.
+# Blocks are verified and executed exactly as when syncing from peers.
+archive_path = "{{ js .BlockSync.ArchivePath }}"
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -469,6 +541,20 @@ timeout_vote_delta = "{{ .Consensus.TimeoutVoteDelta }}"
 # Deprecated: use `next_block_delay` in the ABCI application's `FinalizeBlockResponse`.
 timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 
+# Derive timeout_propose and timeout_vote from a moving estimate of the
+# latencies observed in the recent rounds: how long after entering the propose
+# step the complete proposal was received, and how long after +2/3 of the votes
+# of a round were received +2/3 of them agreed on a block or nil (or the wait
+# timed out). The estimates are bounded by the
+# min and max values below, and timeout_propose_delta and timeout_vote_delta
+# are still added in each round. The static values are used until the first
+# latencies are observed.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+timeout_propose_min = "{{ .Consensus.TimeoutProposeMin }}"
+timeout_propose_max = "{{ .Consensus.TimeoutProposeMax }}"
+timeout_vote_min = "{{ .Consensus.TimeoutVoteMin }}"
+timeout_vote_max = "{{ .Consensus.TimeoutVoteMax }}"
+
 # How many blocks to look back to check existence of the node's consensus votes before joining consensus
 # When non-zero, the node will panic upon restart
 # if the same consensus key was used to sign {double_sign_check_height} last blocks.
@@ -484,6 +570,22 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Number of recent heights whose consensus timeline (the times at which the
+# node received the proposal, the block parts and the votes of each round,
+# and the durations of the ABCI calls) is kept in memory and served by the
+# /consensus_timeline RPC endpoint. 0 (the default) disables the timeline.
+timeline_heights = {{ .Consensus.TimelineHeights }}
+
+# Directory where the consensus timelines of the committed heights are
+# persisted, in the background, if the timeline is enabled, so that they remain
+# available once evicted from memory or after a restart. Leave empty to
+# disable persistence.
+timeline_dir = "{{ js .Consensus.TimelinePath }}"
+
+# Number of heights whose consensus timeline is kept on disk. 0 keeps all of
+# them.
+timeline_retain_heights = {{ .Consensus.TimelineRetainHeights }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -501,11 +603,21 @@ discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 # Note that this is an experimental feature and switching back from v2 to v1
 # is not supported by CometBFT.
 # If the database was initially created with v1, it is necessary to migrate the DB
-# before switching to v2. The migration is not done automatically.
+# before switching to v2, either with the `cometbft migrate-db-key-layout` command
+# or by enabling experimental_migrate_db_key_layout below.
 # v1 - the legacy layout existing in Comet prior to v1.
 # v2 - Order preserving representation ordering entries by height.
 experimental_db_key_layout = "{{ .Storage.ExperimentalKeyLayout }}"
 
+# If set to true, and experimental_db_key_layout is v2, databases created with the
+# v1 layout are migrated to v2 when the node starts, before they are used,
+# including the light client database of the data directory, if any.
+# This is a startup migration, not a background one: the node does not start until
+# the migration is complete, which may take a long time for large databases; the
+# progress is logged. An interrupted migration resumes on the next start. Large
+# databases are better migrated offline with `cometbft migrate-db-key-layout`.
+experimental_migrate_db_key_layout = {{ .Storage.ExperimentalMigrateKeyLayout }}
+
 # If set to true, CometBFT will force compaction to happen for databases that support this feature.
 # and save on storage space. Setting this to true is most benefits when used in combination
 # with pruning as it will physically delete the entries marked for deletion.
@@ -524,6 +636,24 @@ compaction_interval = "{{ .Storage.CompactionInterval }}"
 # The time period between automated background pruning operations.
 interval = "{{ .Storage.Pruning.Interval }}"
 
+# Pruning targets set by the operator. They are translated into a block retain
+# height by the pruner. Blocks are only pruned below the lowest of the retain
+# heights set by the application, the data companion (if enabled) and these
+# targets, so the most conservative one wins. As an exception, an application
+# retain height of 0, reported by applications which never set one, does not
+# prevent these targets from being applied. While no target requires pruning,
+# no block is pruned, whatever the application and data companion retain heights.
+#
+# Blocks whose time is older than this may be pruned (e.g. "720h" to keep the
+# last 30 days of blocks). 0 disables the age target.
+max_block_age = "{{ .Storage.Pruning.MaxBlockAge }}"
+
+# The oldest blocks may be pruned to keep the block store under this size, in
+# bytes. The size on disk only decreases once the database is compacted, so
+# this target is best used together with compact = true. 0 disables the size
+# target.
+max_block_store_bytes = {{ .Storage.Pruning.MaxBlockStoreBytes }}
+
 #
 # Storage pruning configuration relating only to the data companion.
 #
@@ -66,6 +66,24 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	require.Error(t, cfg.ValidateBasic())
+
+	// a gRPC remote signer requires TLS, unless explicitly disabled
+	cfg = config.TestBaseConfig()
+	cfg.PrivValidatorListenAddr = "grpc://127.0.0.1:26659"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorGRPCInsecure = true
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.PrivValidatorGRPCInsecure = false
+	cfg.PrivValidatorGRPCCert, cfg.PrivValidatorGRPCKey, cfg.PrivValidatorGRPCRootCA = "node.crt", "node.key", "ca.crt"
+	require.NoError(t, cfg.ValidateBasic())
+
+	// failover is only supported with gRPC remote signers
+	cfg.PrivValidatorListenAddr = "grpc://127.0.0.1:26659, grpc://127.0.0.1:26660"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.PrivValidatorListenAddr = "tcp://127.0.0.1:26659,tcp://127.0.0.1:26660"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorListenAddr = "grpc://127.0.0.1:26659,unix:///tmp/pv.sock"
+	require.Error(t, cfg.ValidateBasic())
 }
 
 func TestBaseConfigProxyApp_ValidateBasic(t *testing.T) {
@@ -118,6 +136,18 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestGRPCConfigValidateBasic(t *testing.T) {
+	cfg := config.TestGRPCConfig()
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.ListenAddress = "127.0.0.1:36670"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.ListenAddress = "tcp://127.0.0.1:36670"
+
+	cfg.SnapshotService.SendRate = -1
+	require.Error(t, cfg.ValidateBasic())
+}
+
 func TestP2PConfigValidateBasic(t *testing.T) {
 	cfg := config.TestP2PConfig()
 	require.NoError(t, cfg.ValidateBasic())
@@ -204,6 +234,24 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	// a snapshot file requires the trust options
+	cfg.SnapshotFile = "snapshot.bin"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.TrustHeight = 1
+	cfg.TrustHash = "0A0B0C"
+	require.NoError(t, cfg.ValidateBasic())
+
+	// and cannot be used together with state sync
+	cfg.Enable = true
+	require.ErrorIs(t, cfg.ValidateBasic(), config.ErrSnapshotFileWithStateSync)
+
+	// state sync needs two RPC servers, unless light blocks are fetched from peers
+	cfg.SnapshotFile = ""
+	require.Error(t, cfg.ValidateBasic())
+	cfg.UseP2P = true
+	require.NoError(t, cfg.ValidateBasic())
 }
 
 func TestBlockSyncConfigValidateBasic(t *testing.T) {
@@ -234,11 +282,19 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"TimeoutVoteDelta negative":            {func(c *config.ConsensusConfig) { c.TimeoutVoteDelta = -1 }, true},
 		"TimeoutCommit":                        {func(c *config.ConsensusConfig) { c.TimeoutCommit = time.Second }, false},
 		"TimeoutCommit negative":               {func(c *config.ConsensusConfig) { c.TimeoutCommit = -1 }, true},
+		"AdaptiveTimeouts":                     {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts = true }, false},
+		"TimeoutProposeMin negative":           {func(c *config.ConsensusConfig) { c.TimeoutProposeMin = -1 }, true},
+		"TimeoutProposeMax below min":          {func(c *config.ConsensusConfig) { c.TimeoutProposeMax = c.TimeoutProposeMin - 1 }, true},
+		"TimeoutVoteMin negative":              {func(c *config.ConsensusConfig) { c.TimeoutVoteMin = -1 }, true},
+		"TimeoutVoteMax below min":             {func(c *config.ConsensusConfig) { c.TimeoutVoteMax = c.TimeoutVoteMin - 1 }, true},
 		"PeerGossipSleepDuration":              {func(c *config.ConsensusConfig) { c.PeerGossipSleepDuration = time.Second }, false},
 		"PeerGossipSleepDuration negative":     {func(c *config.ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"TimelineHeights disabled":             {func(c *config.ConsensusConfig) { c.TimelineHeights = 0 }, false},
+		"TimelineHeights negative":             {func(c *config.ConsensusConfig) { c.TimelineHeights = -1 }, true},
+		"TimelineRetainHeights negative":       {func(c *config.ConsensusConfig) { c.TimelineRetainHeights = -1 }, true},
 	}
 	for desc, tc := range testcases {
 		t.Run(desc, func(t *testing.T) {
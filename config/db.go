@@ -2,8 +2,10 @@ package config
 
 import (
 	"context"
+	"path/filepath"
 
 	dbm "github.com/cometbft/cometbft-db"
+	cmtos "github.com/cometbft/cometbft/internal/os"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/service"
 )
@@ -27,3 +29,13 @@ func DefaultDBProvider(ctx *DBContext) (dbm.DB, error) {
 
 	return dbm.NewDB(ctx.ID, dbType, ctx.Config.DBDir())
 }
+
+// DBExists reports whether a database with the given ID was created in dir by
+// the given backend, without creating it.
+func DBExists(id string, backend dbm.BackendType, dir string) bool {
+	// Badger does not support database names and uses a directory per database.
+	if backend == dbm.BadgerDBBackend {
+		return cmtos.FileExists(filepath.Join(dir, id))
+	}
+	return cmtos.FileExists(filepath.Join(dir, id+".db"))
+}
@@ -10,6 +10,7 @@ var (
 	ErrNotEnoughRPCServers             = errors.New("at least two rpc_servers entries are required")
 	ErrInsufficientChunkRequestTimeout = errors.New("timeout for re-requesting a chunk (chunk_request_timeout) is less than 5 seconds")
 	ErrUnknownLogFormat                = errors.New("unknown log_format (must be 'plain' or 'json')")
+	ErrSnapshotFileWithStateSync       = errors.New("snapshot_file cannot be set when state sync is enabled")
 	ErrSubscriptionBufferSizeInvalid   = fmt.Errorf("experimental_subscription_buffer_size must be >= %d", minSubscriptionBufferSize)
 )
 
@@ -19,3 +19,30 @@ func TestArmor(t *testing.T) {
 	assert.Equal(t, blockType, blockType2)
 	assert.Equal(t, data, data2)
 }
+
+func TestEncryptArmor(t *testing.T) {
+	blockType := "MINT TEST"
+	data := []byte("somedata")
+	armorStr, err := EncryptArmor(blockType, data, "passphrase")
+	require.NoError(t, err)
+	assert.True(t, IsArmored([]byte(armorStr)))
+	assert.NotContains(t, armorStr, "somedata")
+
+	blockType2, data2, err := DecryptArmor(armorStr, "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, blockType, blockType2)
+	assert.Equal(t, data, data2)
+
+	_, _, err = DecryptArmor(armorStr, "wrong passphrase")
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+
+	// The block type is authenticated.
+	_, headers, ciphertext, err := DecodeArmor(armorStr)
+	require.NoError(t, err)
+	otherType, err := EncodeArmor("OTHER TEST", headers, ciphertext)
+	require.NoError(t, err)
+	_, _, err = DecryptArmor(otherType, "passphrase")
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+
+	assert.False(t, IsArmored([]byte(`{"priv_key": {}}`)))
+}
@@ -0,0 +1,102 @@
+package armor
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/cometbft/cometbft/crypto"
+)
+
+const (
+	headerKDF    = "kdf"
+	headerSalt   = "salt"
+	headerCipher = "cipher"
+
+	kdfScrypt        = "scrypt"
+	cipherXChaCha    = "xchacha20-poly1305"
+	saltSize         = 16
+	scryptN          = 1 << 15
+	scryptR          = 8
+	scryptP          = 1
+	armorBeginPrefix = "-----BEGIN "
+)
+
+// ErrWrongPassphrase is returned when decrypting with a wrong passphrase, or
+// when the encrypted data has been tampered with.
+var ErrWrongPassphrase = errors.New("armor: wrong passphrase or corrupted data")
+
+// EncryptArmor encrypts data with a key derived from the passphrase and
+// returns it ASCII armored. The key is derived with scrypt and a random salt,
+// and data is encrypted with XChaCha20-Poly1305. The block type is
+// authenticated, so the data cannot be decrypted as another type of block.
+func EncryptArmor(blockType string, data []byte, passphrase string) (string, error) {
+	salt := crypto.CRandBytes(saltSize)
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := crypto.CRandBytes(aead.NonceSize())
+	ciphertext := aead.Seal(nonce, nonce, data, []byte(blockType))
+
+	headers := map[string]string{
+		headerKDF:    kdfScrypt,
+		headerSalt:   hex.EncodeToString(salt),
+		headerCipher: cipherXChaCha,
+	}
+	return EncodeArmor(blockType, headers, ciphertext)
+}
+
+// DecryptArmor decrypts ASCII armored data encrypted by EncryptArmor. It
+// returns ErrWrongPassphrase if the passphrase is not the one used to encrypt
+// the data.
+func DecryptArmor(armorStr, passphrase string) (blockType string, data []byte, err error) {
+	blockType, headers, ciphertext, err := DecodeArmor(armorStr)
+	if err != nil {
+		return "", nil, err
+	}
+	if headers[headerKDF] != kdfScrypt {
+		return "", nil, fmt.Errorf("armor: unsupported key derivation function %q", headers[headerKDF])
+	}
+	if headers[headerCipher] != cipherXChaCha {
+		return "", nil, fmt.Errorf("armor: unsupported cipher %q", headers[headerCipher])
+	}
+	salt, err := hex.DecodeString(headers[headerSalt])
+	if err != nil || len(salt) != saltSize {
+		return "", nil, errors.New("armor: invalid salt")
+	}
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return "", nil, ErrWrongPassphrase
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	data, err = aead.Open(nil, nonce, ciphertext, []byte(blockType))
+	if err != nil {
+		return "", nil, ErrWrongPassphrase
+	}
+	return blockType, data, nil
+}
+
+// IsArmored returns true if data looks like an ASCII armored block, as opposed
+// to plain JSON for instance.
+func IsArmored(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte(armorBeginPrefix))
+}
+
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("armor: deriving key: %w", err)
+	}
+	return chacha20poly1305.NewX(key)
+}
@@ -65,6 +65,11 @@ func (PrivKey) Sign([]byte) ([]byte, error) {
 	panic("bls12_381 is disabled")
 }
 
+// ProofOfPossession returns ErrDisabled.
+func (PrivKey) ProofOfPossession() ([]byte, error) {
+	return nil, ErrDisabled
+}
+
 // Zeroize always panics.
 func (PrivKey) Zeroize() {
 	panic("bls12_381 is disabled")
@@ -108,3 +113,31 @@ func (PubKey) Bytes() []byte {
 func (PubKey) Type() string {
 	return KeyType
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures returns ErrDisabled.
+func AggregateSignatures([][]byte) ([]byte, error) {
+	return nil, ErrDisabled
+}
+
+// VerifyAggregateSignature always returns false.
+func VerifyAggregateSignature([]crypto.PubKey, []byte, []byte) bool {
+	return false
+}
+
+// VerifyProofOfPossession always returns false.
+func VerifyProofOfPossession(crypto.PubKey, []byte) bool {
+	return false
+}
+
+// ===============================================================================================
+// Batch Verification
+// ===============================================================================================
+
+// NewBatchVerifier always panics.
+func NewBatchVerifier() crypto.BatchVerifier {
+	panic("bls12_381 is disabled")
+}
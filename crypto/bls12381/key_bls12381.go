@@ -26,8 +26,20 @@ var (
 	// ErrInfinitePubKey is returned when the public key is infinite. It is part
 	// of a more comprehensive subgroup check on the key.
 	ErrInfinitePubKey = errors.New("bls12381: pubkey is infinite")
+	// ErrNoSignatures is returned when aggregating an empty list of
+	// signatures.
+	ErrNoSignatures = errors.New("bls12381: no signatures to aggregate")
+	// ErrNotBls12381Key is returned when adding a key of another type to the
+	// BatchVerifier.
+	ErrNotBls12381Key = errors.New("bls12381: pubkey is not BLS12-381")
+	// ErrInvalidSignature is returned when adding a signature that is not a
+	// valid point of the group to the BatchVerifier.
+	ErrInvalidSignature = errors.New("bls12381: invalid signature")
 
 	dstMinSig = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+	// dstPoP separates the proofs of possession from the signatures, so that a
+	// proof can't be used as a signature of the public key and vice versa.
+	dstPoP = []byte("BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
 )
 
 // For minimal-pubkey-size operations.
@@ -37,8 +49,8 @@ var (
 type (
 	blstPublicKey          = blst.P1Affine
 	blstSignature          = blst.P2Affine
-	blstAggregateSignature = blst.P1Aggregate
-	blstAggregatePublicKey = blst.P2Aggregate
+	blstAggregateSignature = blst.P2Aggregate
+	blstAggregatePublicKey = blst.P1Aggregate
 )
 
 // -------------------------------------.
@@ -114,6 +126,14 @@ func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
 	return signature.Compress(), nil
 }
 
+// ProofOfPossession returns a proof of the possession of the private key,
+// which can be verified with VerifyProofOfPossession.
+func (privKey PrivKey) ProofOfPossession() ([]byte, error) {
+	pubKey := new(blstPublicKey).From(privKey.sk)
+	proof := new(blstSignature).Sign(privKey.sk, pubKey.Serialize(), dstPoP)
+	return proof.Compress(), nil
+}
+
 // Zeroize clears the private key.
 func (privKey *PrivKey) Zeroize() {
 	privKey.sk.Zeroize()
@@ -216,3 +236,156 @@ func (pubkey *PubKey) UnmarshalJSON(bz []byte) error {
 	pubkey.pk = pk.pk
 	return nil
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures aggregates the signatures into a single signature, which
+// can be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrNoSignatures
+	}
+	agg := new(blstAggregateSignature)
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, ErrDeserialization
+	}
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies the aggregate of the signatures of msg by
+// each of the public keys. It returns false if one of the keys is not a
+// BLS12-381 key.
+//
+// The verification is only secure if the possession of the private keys has
+// been proven with VerifyProofOfPossession, to prevent rogue key attacks.
+func VerifyAggregateSignature(pubKeys []crypto.PubKey, msg, sig []byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+	pks := make([]*blstPublicKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		switch pk := pubKey.(type) {
+		case PubKey:
+			pks[i] = pk.pk
+		case *PubKey:
+			pks[i] = pk.pk
+		default:
+			return false
+		}
+	}
+
+	signature := new(blstSignature).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+	if !signature.SigValidate(false) {
+		return false
+	}
+	return signature.FastAggregateVerify(false, pks, msg, dstMinSig)
+}
+
+// VerifyProofOfPossession reports whether proof is a valid proof of the
+// possession of the private key of pubKey, as returned by
+// PrivKey.ProofOfPossession. It returns false if pubKey is not a BLS12-381 key.
+func VerifyProofOfPossession(pubKey crypto.PubKey, proof []byte) bool {
+	var pk *blstPublicKey
+	switch k := pubKey.(type) {
+	case PubKey:
+		pk = k.pk
+	case *PubKey:
+		pk = k.pk
+	default:
+		return false
+	}
+
+	signature := new(blstSignature).Uncompress(proof)
+	if signature == nil {
+		return false
+	}
+	if !signature.SigValidate(false) {
+		return false
+	}
+	return signature.Verify(false, pk, false, pk.Serialize(), dstPoP)
+}
+
+// ===============================================================================================
+// Batch Verification
+// ===============================================================================================
+
+// batchRandBits is the number of bits of the random scalars by which the
+// signatures are multiplied in a batch, so that invalid signatures can't cancel
+// each other out.
+const batchRandBits = 64
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier implements batch verification for BLS12-381. The signatures
+// are verified at once with a single multi-pairing.
+type BatchVerifier struct {
+	pks  []*blstPublicKey
+	sigs []*blstSignature
+	msgs []blst.Message
+}
+
+// NewBatchVerifier returns a new BLS12-381 BatchVerifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add adds the key, message and signature to the batch.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	var pk *blstPublicKey
+	switch k := key.(type) {
+	case PubKey:
+		pk = k.pk
+	case *PubKey:
+		pk = k.pk
+	default:
+		return ErrNotBls12381Key
+	}
+
+	sig := new(blstSignature).Uncompress(signature)
+	if sig == nil || !sig.SigValidate(false) {
+		return ErrInvalidSignature
+	}
+
+	b.pks = append(b.pks, pk)
+	b.sigs = append(b.sigs, sig)
+	b.msgs = append(b.msgs, msg)
+	return nil
+}
+
+// Verify verifies all the signatures of the batch. If the batch is invalid,
+// the signatures are verified one by one to tell which ones are invalid.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	n := len(b.sigs)
+	if n == 0 {
+		return false, nil
+	}
+
+	valid := make([]bool, n)
+	// The signatures were already group checked in Add.
+	if new(blstSignature).MultipleAggregateVerify(b.sigs, false, b.pks, false, b.msgs, dstMinSig,
+		randScalar, batchRandBits) {
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	for i := range valid {
+		valid[i] = b.sigs[i].Verify(false, b.pks[i], false, b.msgs[i], dstMinSig)
+	}
+	return false, valid
+}
+
+// randScalar sets s to a random scalar.
+func randScalar(s *blst.Scalar) {
+	var bz [blst.BLST_SCALAR_BYTES]byte
+	if _, err := rand.Read(bz[:]); err != nil {
+		panic(err)
+	}
+	s.FromBEndian(bz[:])
+}
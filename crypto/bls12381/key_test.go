@@ -112,6 +112,30 @@ func TestPrivKeySignAndPubKeyVerifySignature(t *testing.T) {
 	assert.True(t, pubKey.VerifySignature(msg, sig))
 }
 
+func TestProofOfPossession(t *testing.T) {
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	defer privKey.Zeroize()
+	pubKey := privKey.PubKey()
+
+	proof, err := privKey.ProofOfPossession()
+	require.NoError(t, err)
+	assert.True(t, bls12381.VerifyProofOfPossession(pubKey, proof))
+
+	// A signature of the public key is not a proof of possession.
+	sig, err := privKey.Sign(pubKey.Bytes())
+	require.NoError(t, err)
+	assert.False(t, bls12381.VerifyProofOfPossession(pubKey, sig))
+
+	// The proof of another key doesn't prove the possession of this one.
+	otherKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	defer otherKey.Zeroize()
+	otherProof, err := otherKey.ProofOfPossession()
+	require.NoError(t, err)
+	assert.False(t, bls12381.VerifyProofOfPossession(pubKey, otherProof))
+}
+
 func TestPubKey(t *testing.T) {
 	privKey, err := bls12381.GenPrivKey()
 	require.NoError(t, err)
@@ -214,3 +238,45 @@ func TestPubKey_NewPublicKeyFromInvalidBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchVerifier(t *testing.T) {
+	v := bls12381.NewBatchVerifier()
+
+	for i := 0; i <= 38; i++ {
+		priv, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		pub := priv.PubKey()
+
+		var msg []byte
+		if i%2 == 0 {
+			msg = []byte("easter")
+		} else {
+			msg = []byte("egg")
+		}
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		err = v.Add(pub, msg, sig)
+		require.NoError(t, err)
+	}
+
+	ok, valid := v.Verify()
+	require.True(t, ok)
+	require.Len(t, valid, 39)
+
+	// A wrong signature is reported at its index.
+	priv, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	sig, err := priv.Sign([]byte("easter"))
+	require.NoError(t, err)
+	require.NoError(t, v.Add(priv.PubKey(), []byte("egg"), sig))
+
+	ok, valid = v.Verify()
+	require.False(t, ok)
+	for i, isValid := range valid {
+		assert.Equal(t, i != 39, isValid, "#%d", i)
+	}
+
+	require.ErrorIs(t, v.Add(priv.PubKey(), []byte("egg"), sig[:48]), bls12381.ErrInvalidSignature)
+}
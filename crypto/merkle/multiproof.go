@@ -0,0 +1,234 @@
+package merkle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"hash"
+	"sort"
+
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+// MultiProof represents a Merkle proof of a subset of the leaves of a tree
+// computed by HashFromByteSlices.
+// Unlike a set of Proofs, where the hashes shared by the paths of several
+// leaves are repeated in each Proof, every hash needed to recompute the root is
+// included exactly once: the hashes of the proven leaves, and the hashes of the
+// largest subtrees without any proven leaf (the aunts).
+type MultiProof struct {
+	Total      int64    `json:"total"`           // Total number of items.
+	Indices    []int64  `json:"indices"`         // Indices of items to prove, in increasing order.
+	LeafHashes [][]byte `json:"leaf_hashes"`     // Hashes of item values, in the order of Indices.
+	Aunts      [][]byte `json:"aunts,omitempty"` // Hashes of the subtrees without items to prove, from left to right.
+}
+
+// MultiProofFromByteSlices computes the inclusion proof of the items at the
+// given indices, which must be in increasing order.
+func MultiProofFromByteSlices(items [][]byte, indices []int64) (rootHash []byte, proof *MultiProof, err error) {
+	if err := validateMultiProofIndices(int64(len(items)), indices); err != nil {
+		return nil, nil, ErrInvalidProof{Err: err}
+	}
+	proof = &MultiProof{
+		Total:      int64(len(items)),
+		Indices:    append([]int64(nil), indices...),
+		LeafHashes: make([][]byte, 0, len(indices)),
+	}
+	rootHash = proof.build(tmhash.New(), items, 0, indices)
+	return rootHash, proof, nil
+}
+
+// build computes the hash of the subtree of items, whose first item is at
+// offset in the tree, and appends the hashes needed to prove the items at
+// indices to the proof.
+// Recursive impl.
+func (mp *MultiProof) build(hash hash.Hash, items [][]byte, offset int64, indices []int64) []byte {
+	if len(indices) == 0 {
+		subtreeHash := hashFromByteSlices(hash, items)
+		mp.Aunts = append(mp.Aunts, subtreeHash)
+		return subtreeHash
+	}
+	if len(items) == 1 {
+		leafHash := leafHashOpt(hash, items[0])
+		mp.LeafHashes = append(mp.LeafHashes, leafHash)
+		return leafHash
+	}
+	numLeft := getSplitPoint(int64(len(items)))
+	split := sort.Search(len(indices), func(i int) bool { return indices[i] >= offset+numLeft })
+	leftHash := mp.build(hash, items[:numLeft], offset, indices[:split])
+	rightHash := mp.build(hash, items[numLeft:], offset+numLeft, indices[split:])
+	return innerHashOpt(hash, leftHash, rightHash)
+}
+
+// Verify that the MultiProof proves the root hash.
+// leaves are the values of the items at mp.Indices, in the same order.
+func (mp *MultiProof) Verify(rootHash []byte, leaves [][]byte) error {
+	if rootHash == nil {
+		return ErrInvalidHash{
+			Err: errors.New("nil root"),
+		}
+	}
+	if err := mp.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(leaves) != len(mp.Indices) {
+		return ErrInvalidProof{
+			Err: fmt.Errorf("got %d leaves, want %d", len(leaves), len(mp.Indices)),
+		}
+	}
+	hash := tmhash.New()
+	for i, leaf := range leaves {
+		leafHash := leafHashOpt(hash, leaf)
+		if !bytes.Equal(mp.LeafHashes[i], leafHash) {
+			return ErrInvalidHash{
+				Err: fmt.Errorf("leaf#%d %x, want %x", i, mp.LeafHashes[i], leafHash),
+			}
+		}
+	}
+	computedHash, err := mp.computeRootHash(hash)
+	if err != nil {
+		return ErrInvalidHash{
+			Err: fmt.Errorf("compute root hash: %w", err),
+		}
+	}
+	if !bytes.Equal(computedHash, rootHash) {
+		return ErrInvalidHash{
+			Err: fmt.Errorf("root %x, want %x", computedHash, rootHash),
+		}
+	}
+	return nil
+}
+
+// Compute the root hash from the leaf hashes and the aunts.
+// It expects mp to be valid (see ValidateBasic), and fails unless every leaf
+// hash and every aunt is used exactly once.
+func (mp *MultiProof) computeRootHash(hash hash.Hash) ([]byte, error) {
+	leafHashes, aunts := mp.LeafHashes, mp.Aunts
+
+	// Recursive impl.
+	var compute func(offset, total int64, indices []int64) ([]byte, error)
+	compute = func(offset, total int64, indices []int64) ([]byte, error) {
+		if len(indices) == 0 {
+			if len(aunts) == 0 {
+				return nil, errors.New("expected more aunts")
+			}
+			auntHash := aunts[0]
+			aunts = aunts[1:]
+			return auntHash, nil
+		}
+		if total == 1 {
+			leafHash := leafHashes[0]
+			leafHashes = leafHashes[1:]
+			return leafHash, nil
+		}
+		numLeft := getSplitPoint(total)
+		split := sort.Search(len(indices), func(i int) bool { return indices[i] >= offset+numLeft })
+		leftHash, err := compute(offset, numLeft, indices[:split])
+		if err != nil {
+			return nil, err
+		}
+		rightHash, err := compute(offset+numLeft, total-numLeft, indices[split:])
+		if err != nil {
+			return nil, err
+		}
+		return innerHashOpt(hash, leftHash, rightHash), nil
+	}
+
+	rootHash, err := compute(0, mp.Total, mp.Indices)
+	if err != nil {
+		return nil, err
+	}
+	if len(aunts) != 0 {
+		return nil, fmt.Errorf("%d unexpected aunts", len(aunts))
+	}
+	return rootHash, nil
+}
+
+// String implements the stringer interface for MultiProof.
+func (mp *MultiProof) String() string {
+	return fmt.Sprintf("MultiProof{Total: %d, Indices: %v, Aunts: %X}", mp.Total, mp.Indices, mp.Aunts)
+}
+
+// ValidateBasic performs basic validation.
+// NOTE: it expects the elements of LeafHashes and Aunts to be of size
+// tmhash.Size, and it expects at most MaxAunts elements in Aunts per index.
+func (mp *MultiProof) ValidateBasic() error {
+	if err := validateMultiProofIndices(mp.Total, mp.Indices); err != nil {
+		return ErrInvalidProof{Err: err}
+	}
+	if len(mp.LeafHashes) != len(mp.Indices) {
+		return ErrInvalidProof{
+			Err: fmt.Errorf("got %d leaf hashes, want %d", len(mp.LeafHashes), len(mp.Indices)),
+		}
+	}
+	for i, leafHash := range mp.LeafHashes {
+		if len(leafHash) != tmhash.Size {
+			return ErrInvalidHash{
+				Err: fmt.Errorf("leaf#%d hash length %d, want %d", i, len(leafHash), tmhash.Size),
+			}
+		}
+	}
+	if len(mp.Aunts) > len(mp.Indices)*MaxAunts {
+		return ErrMaxAuntsLenExceeded
+	}
+	for i, auntHash := range mp.Aunts {
+		if len(auntHash) != tmhash.Size {
+			return ErrInvalidHash{
+				Err: fmt.Errorf("aunt#%d hash length %d, want %d", i, len(auntHash), tmhash.Size),
+			}
+		}
+	}
+	return nil
+}
+
+// ToProto converts the MultiProof structure into its corresponding protobuf representation.
+func (mp *MultiProof) ToProto() *cmtcrypto.MultiProof {
+	if mp == nil {
+		return nil
+	}
+	pb := new(cmtcrypto.MultiProof)
+
+	pb.Total = mp.Total
+	pb.Indices = mp.Indices
+	pb.LeafHashes = mp.LeafHashes
+	pb.Aunts = mp.Aunts
+
+	return pb
+}
+
+// MultiProofFromProto converts a protobuf cmtcrypto.MultiProof object back into the MultiProof structure.
+func MultiProofFromProto(pb *cmtcrypto.MultiProof) (*MultiProof, error) {
+	if pb == nil {
+		return nil, ErrInvalidProof{Err: errors.New("nil proof")}
+	}
+
+	mp := new(MultiProof)
+
+	mp.Total = pb.Total
+	mp.Indices = pb.Indices
+	mp.LeafHashes = pb.LeafHashes
+	mp.Aunts = pb.Aunts
+
+	return mp, mp.ValidateBasic()
+}
+
+// validateMultiProofIndices checks that there is at least one index, and that
+// the indices are in increasing order and within [0, total).
+func validateMultiProofIndices(total int64, indices []int64) error {
+	if total <= 0 {
+		return errors.New("proof total must be positive")
+	}
+	if len(indices) == 0 {
+		return errors.New("no indices to prove")
+	}
+	for i, index := range indices {
+		if index < 0 || index >= total {
+			return fmt.Errorf("index#%d %d out of range [0, %d)", i, index, total)
+		}
+		if i > 0 && index <= indices[i-1] {
+			return fmt.Errorf("index#%d %d is not greater than the previous index %d", i, index, indices[i-1])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package merkle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+)
+
+func TestMultiProof(t *testing.T) {
+	for _, total := range []int{1, 2, 3, 7, 8, 13, 100} {
+		items := make([][]byte, total)
+		for i := range items {
+			items[i] = cmtrand.Bytes(32)
+		}
+		rootHash := HashFromByteSlices(items)
+
+		subsets := [][]int64{{0}, {int64(total - 1)}, allIndices(total)}
+		if total > 2 {
+			subsets = append(subsets, []int64{0, int64(total / 2), int64(total - 1)})
+		}
+		for _, indices := range subsets {
+			rh, proof, err := MultiProofFromByteSlices(items, indices)
+			require.NoError(t, err)
+			require.Equal(t, rootHash, rh, "total %d, indices %v", total, indices)
+
+			leaves := make([][]byte, len(indices))
+			for i, index := range indices {
+				leaves[i] = items[index]
+			}
+			require.NoError(t, proof.Verify(rootHash, leaves), "total %d, indices %v", total, indices)
+
+			// The proof is as compact as a single proof when proving one leaf,
+			// and has no aunts when proving all of them.
+			switch len(indices) {
+			case 1:
+				_, proofs := ProofsFromByteSlices(items)
+				assert.Len(t, proof.Aunts, len(proofs[indices[0]].Aunts))
+			case total:
+				assert.Empty(t, proof.Aunts)
+			}
+
+			// Protobuf round trip.
+			pb, err := MultiProofFromProto(proof.ToProto())
+			require.NoError(t, err)
+			require.Equal(t, proof, pb)
+
+			// Wrong root.
+			require.Error(t, proof.Verify(cmtrand.Bytes(32), leaves))
+
+			// Wrong leaf.
+			badLeaves := append([][]byte(nil), leaves...)
+			badLeaves[0] = cmtrand.Bytes(32)
+			require.Error(t, proof.Verify(rootHash, badLeaves))
+
+			// Missing leaf.
+			require.Error(t, proof.Verify(rootHash, leaves[1:]))
+
+			// Tampered aunts.
+			if len(proof.Aunts) > 0 {
+				bad := *proof
+				bad.Aunts = append([][]byte{}, proof.Aunts...)
+				bad.Aunts[0] = cmtrand.Bytes(32)
+				require.Error(t, bad.Verify(rootHash, leaves))
+
+				bad.Aunts = proof.Aunts[1:]
+				require.Error(t, bad.Verify(rootHash, leaves))
+			}
+			bad := *proof
+			bad.Aunts = append(append([][]byte{}, proof.Aunts...), cmtrand.Bytes(32))
+			require.Error(t, bad.Verify(rootHash, leaves))
+		}
+	}
+}
+
+func allIndices(total int) []int64 {
+	indices := make([]int64, total)
+	for i := range indices {
+		indices[i] = int64(i)
+	}
+	return indices
+}
+
+func TestMultiProofFromByteSlicesInvalidIndices(t *testing.T) {
+	items := [][]byte{bz("a"), bz("b"), bz("c")}
+
+	testCases := []struct {
+		name    string
+		items   [][]byte
+		indices []int64
+	}{
+		{"no items", nil, []int64{0}},
+		{"no indices", items, nil},
+		{"negative index", items, []int64{-1}},
+		{"index out of range", items, []int64{3}},
+		{"duplicate index", items, []int64{1, 1}},
+		{"indices not sorted", items, []int64{2, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := MultiProofFromByteSlices(tc.items, tc.indices)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMultiProofValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		malleateProof func(*MultiProof)
+		errStr        string
+	}{
+		{"Good", func(*MultiProof) {}, ""},
+		{"Zero Total", func(mp *MultiProof) { mp.Total = 0 }, "proof total must be positive"},
+		{"No Indices", func(mp *MultiProof) { mp.Indices = nil }, "no indices to prove"},
+		{"Index Out Of Range", func(mp *MultiProof) { mp.Indices[1] = mp.Total }, "out of range"},
+		{"Unsorted Indices", func(mp *MultiProof) { mp.Indices[0], mp.Indices[1] = mp.Indices[1], mp.Indices[0] }, "is not greater than"},
+		{"Missing LeafHash", func(mp *MultiProof) { mp.LeafHashes = mp.LeafHashes[1:] }, "leaf hashes"},
+		{"Short LeafHash", func(mp *MultiProof) { mp.LeafHashes[0] = make([]byte, 10) }, "leaf#0 hash length"},
+		{"Short Aunt", func(mp *MultiProof) { mp.Aunts[0] = make([]byte, 10) }, "aunt#0 hash length"},
+		{"Too Many Aunts", func(mp *MultiProof) { mp.Aunts = make([][]byte, 2*MaxAunts+1) }, "maximum aunts length"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			_, proof, err := MultiProofFromByteSlices([][]byte{bz("a"), bz("b"), bz("c"), bz("d"), bz("e")}, []int64{1, 3})
+			require.NoError(t, err)
+			tc.malleateProof(proof)
+			err = proof.ValidateBasic()
+			if tc.errStr != "" {
+				require.ErrorContains(t, err, tc.errStr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMultiValueOp(t *testing.T) {
+	items := [][]byte{bz("a"), bz("b"), bz("c"), bz("d"), bz("e")}
+	rootHash, proof, err := MultiProofFromByteSlices(items, []int64{0, 2, 3})
+	require.NoError(t, err)
+	leaves := [][]byte{bz("a"), bz("c"), bz("d")}
+
+	op := NewMultiValueOp(proof)
+	require.Empty(t, op.GetKey())
+	root, err := op.Run(leaves)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{rootHash}, root)
+
+	_, err = op.Run(leaves[:2])
+	require.Error(t, err)
+	_, err = op.Run([][]byte{bz("a"), bz("b"), bz("d")})
+	require.Error(t, err)
+
+	// Chain the operator with another one: it matches no part of the key path.
+	popz := ProofOperators{op, NewDominoOp("KEY2", string(rootHash), "OUTPUT")}
+	require.NoError(t, popz.Verify(bz("OUTPUT"), "/KEY2", leaves))
+	require.Error(t, popz.Verify(bz("OUTPUT"), "/KEY2", leaves[1:]))
+	require.ErrorIs(t, popz.Verify(bz("OUTPUT"), "/KEY/KEY2", leaves), ErrKeyPathNotConsumed)
+
+	// Encode and decode the operator through the default runtime.
+	pbops := &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{op.ProofOp()}}
+	decodedOps, err := DefaultProofRuntime().DecodeProof(pbops)
+	require.NoError(t, err)
+	popz = append(decodedOps, popz[1])
+	require.NoError(t, popz.Verify(bz("OUTPUT"), "/KEY2", leaves))
+
+	decoded, err := MultiValueOpDecoder(op.ProofOp())
+	require.NoError(t, err)
+	require.Equal(t, op, decoded)
+
+	// A MultiValueOp with a key is rejected, since the key isn't bound to the
+	// values.
+	pop := op.ProofOp()
+	pop.Key = bz("KEY")
+	_, err = MultiValueOpDecoder(pop)
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package merkle
+
+import (
+	"bytes"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+const ProofOpMultiValue = "simple:mv"
+
+// MultiValueOp takes the values of a subset of the leaves of a tree computed
+// by HashFromByteSlices, in the order of the proof indices, and produces the
+// root hash.
+//
+// Unlike the leaves of the SimpleMap tree proven by ValueOp, these leaves
+// aren't keyed: a MultiValueOp has no key, so that it can't be mistaken for a
+// proof that the values are bound to one. It matches no part of the key path.
+//
+// If the produced root hash matches the expected hash, the
+// proof is good.
+type MultiValueOp struct {
+	// To encode in ProofOp.Data
+	Proof *MultiProof `json:"proof"`
+}
+
+var _ ProofOperator = MultiValueOp{}
+
+func NewMultiValueOp(proof *MultiProof) MultiValueOp {
+	return MultiValueOp{
+		Proof: proof,
+	}
+}
+
+// MultiValueOpDecoder decodes a cmtcrypto.ProofOp into a MultiValueOp instance.
+func MultiValueOpDecoder(pop cmtcrypto.ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpMultiValue {
+		return nil, ErrInvalidProof{
+			Err: fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpMultiValue),
+		}
+	}
+	if len(pop.Key) != 0 {
+		return nil, ErrInvalidProof{
+			Err: fmt.Errorf("unexpected ProofOp.Key %X; MultiValueOp has no key", pop.Key),
+		}
+	}
+	var pbop cmtcrypto.MultiValueOp
+	err := pbop.Unmarshal(pop.Data)
+	if err != nil {
+		return nil, ErrInvalidProof{
+			Err: fmt.Errorf("decoding ProofOp.Data into MultiValueOp: %w", err),
+		}
+	}
+
+	mp, err := MultiProofFromProto(pbop.Proof)
+	if err != nil {
+		return nil, err
+	}
+	return NewMultiValueOp(mp), nil
+}
+
+// ProofOp encodes the MultiValueOp as a cmtcrypto.ProofOp, which can later be decoded.
+func (op MultiValueOp) ProofOp() cmtcrypto.ProofOp {
+	pbval := cmtcrypto.MultiValueOp{
+		Proof: op.Proof.ToProto(),
+	}
+	bz, err := pbval.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return cmtcrypto.ProofOp{
+		Type: ProofOpMultiValue,
+		Data: bz,
+	}
+}
+
+func (op MultiValueOp) String() string {
+	return fmt.Sprintf("MultiValueOp{%v}", op.Proof.Indices)
+}
+
+// Run computes the Merkle root using the MultiValueOp.
+func (op MultiValueOp) Run(args [][]byte) ([][]byte, error) {
+	if err := op.Proof.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if len(args) != len(op.Proof.Indices) {
+		return nil, ErrInvalidProof{
+			Err: fmt.Errorf("got %d args, want %d", len(args), len(op.Proof.Indices)),
+		}
+	}
+	hasher := tmhash.New()
+	for i, value := range args {
+		leafHash := leafHashOpt(hasher, value)
+		if !bytes.Equal(leafHash, op.Proof.LeafHashes[i]) {
+			return nil, ErrInvalidHash{
+				Err: fmt.Errorf("leaf#%d %x, want %x", i, leafHash, op.Proof.LeafHashes[i]),
+			}
+		}
+	}
+
+	rootHash, err := op.Proof.computeRootHash(hasher)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{
+		rootHash,
+	}, nil
+}
+
+// GetKey returns nil: a MultiValueOp has no key.
+func (MultiValueOp) GetKey() []byte {
+	return nil
+}
@@ -148,11 +148,12 @@ func (prt *ProofRuntime) Verify(proof *cmtcrypto.ProofOps, root []byte, keypath
 	return poz.Verify(root, keypath, args)
 }
 
-// DefaultProofRuntime only knows about value proofs.
+// DefaultProofRuntime only knows about value and multi value proofs.
 // To use e.g. IAVL proofs, register op-decoders as
 // defined in the IAVL package.
 func DefaultProofRuntime() (prt *ProofRuntime) {
 	prt = NewProofRuntime()
 	prt.RegisterOpDecoder(ProofOpValue, ValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpMultiValue, MultiValueOpDecoder)
 	return prt
 }
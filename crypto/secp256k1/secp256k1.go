@@ -2,9 +2,12 @@ package secp256k1
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	"runtime"
+	"sync"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
@@ -15,6 +18,15 @@ import (
 )
 
 // -------------------------------------.
+var (
+	// ErrNotSecp256k1Key is returned when adding a key of another type to the
+	// BatchVerifier.
+	ErrNotSecp256k1Key = errors.New("secp256k1: pubkey is not secp256k1")
+	// ErrInvalidSignature is returned when adding a signature of the wrong
+	// length to the BatchVerifier.
+	ErrInvalidSignature = errors.New("secp256k1: invalid signature")
+)
+
 const (
 	PrivKeyName = "tendermint/PrivKeySecp256k1"
 	PubKeyName  = "tendermint/PubKeySecp256k1"
@@ -224,3 +236,73 @@ func signatureFromBytes(sigStr []byte) *ecdsa.Signature {
 	s.SetByteSlice(sigStr[32:64])
 	return ecdsa.NewSignature(&r, &s)
 }
+
+// -------------------------------------
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier implements batch verification for secp256k1.
+//
+// Unlike ed25519 signatures, ECDSA signatures can't be verified faster in a
+// batch, so the signatures are verified concurrently instead.
+type BatchVerifier struct {
+	pubKeys []PubKey
+	msgs    [][]byte
+	sigs    [][]byte
+}
+
+// NewBatchVerifier returns a new secp256k1 BatchVerifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add adds the key, message and signature to the batch.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pubKey, ok := key.(PubKey)
+	if !ok {
+		return ErrNotSecp256k1Key
+	}
+	if len(pubKey) != PubKeySize {
+		return fmt.Errorf("secp256k1: invalid pubkey size %d, expected %d", len(pubKey), PubKeySize)
+	}
+	if len(signature) != 64 {
+		return ErrInvalidSignature
+	}
+
+	b.pubKeys = append(b.pubKeys, pubKey)
+	b.msgs = append(b.msgs, msg)
+	b.sigs = append(b.sigs, signature)
+	return nil
+}
+
+// Verify verifies all the signatures of the batch.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	n := len(b.sigs)
+	if n == 0 {
+		return false, nil
+	}
+
+	valid := make([]bool, n)
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < n; i += workers {
+				valid[i] = b.pubKeys[i].VerifySignature(b.msgs[i], b.sigs[i])
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, ok := range valid {
+		if !ok {
+			return false, valid
+		}
+	}
+	return true, valid
+}
@@ -123,3 +123,43 @@ func TestGenPrivKeySecp256k1(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchVerifier(t *testing.T) {
+	v := secp256k1.NewBatchVerifier()
+
+	for i := 0; i <= 38; i++ {
+		priv := secp256k1.GenPrivKey()
+		pub := priv.PubKey()
+
+		var msg []byte
+		if i%2 == 0 {
+			msg = []byte("easter")
+		} else {
+			msg = []byte("egg")
+		}
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		err = v.Add(pub, msg, sig)
+		require.NoError(t, err)
+	}
+
+	ok, valid := v.Verify()
+	require.True(t, ok)
+	require.Len(t, valid, 39)
+
+	// A wrong signature is reported at its index.
+	priv := secp256k1.GenPrivKey()
+	sig, err := priv.Sign([]byte("easter"))
+	require.NoError(t, err)
+	require.NoError(t, v.Add(priv.PubKey(), []byte("egg"), sig))
+
+	ok, valid = v.Verify()
+	require.False(t, ok)
+	for i, isValid := range valid {
+		assert.Equal(t, i != 39, isValid, "#%d", i)
+	}
+
+	require.ErrorIs(t, v.Add(priv.PubKey(), []byte("egg"), sig[:63]), secp256k1.ErrInvalidSignature)
+}
@@ -0,0 +1,313 @@
+package blocksync
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	bcproto "github.com/cometbft/cometbft/api/cometbft/blocksync/v2"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/protoio"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// A block archive file contains the blockArchiveMagic string and the
+// blockArchiveVersion byte, followed by consecutive blocks in increasing
+// height order. Each block is a varint-delimited BlockResponse message
+// holding the block and, if vote extensions were enabled at its height, its
+// extended commit.
+const (
+	blockArchiveMagic   = "CMTBLKS"
+	blockArchiveVersion = byte(1)
+)
+
+// WriteBlockArchive writes the blocks of the block store from height from to
+// height to to w, in the format read by SyncFromArchive.
+func WriteBlockArchive(w io.Writer, blockStore sm.BlockStore, from, to int64) error {
+	if _, err := w.Write(append([]byte(blockArchiveMagic), blockArchiveVersion)); err != nil {
+		return err
+	}
+	pw := protoio.NewDelimitedWriter(w)
+	for height := from; height <= to; height++ {
+		block, _ := blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("block #%d not found", height)
+		}
+		pbBlock, err := block.ToProto()
+		if err != nil {
+			return err
+		}
+		msg := &bcproto.BlockResponse{Block: pbBlock}
+		if extCommit := blockStore.LoadBlockExtendedCommit(height); extCommit != nil {
+			msg.ExtCommit = extCommit.ToProto()
+		}
+		if _, err := pw.WriteMsg(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockArchive is a local source of consecutive blocks.
+type blockArchive interface {
+	// Next returns the next block of the archive, and its extended commit if
+	// the archive has it. It returns io.EOF after the last block.
+	Next() (*types.Block, *types.ExtendedCommit, error)
+	Close() error
+}
+
+// openBlockArchive opens the block archive at path, to read the blocks
+// starting from height from. A directory is opened as the block store
+// database of another node, using the given database backend: either the
+// blockstore.db directory itself, or the data directory containing it.
+func openBlockArchive(path string, dbBackend string, from int64) (blockArchive, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return openStoreArchive(path, dbBackend, from)
+	}
+	return openFileArchive(path, from)
+}
+
+// fileArchive reads the blocks of a block archive file.
+type fileArchive struct {
+	file *os.File
+	r    protoio.ReadCloser
+	from int64
+}
+
+func openFileArchive(path string, from int64) (*fileArchive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(f)
+	header := make([]byte, len(blockArchiveMagic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("reading block archive header: %w", err)
+	}
+	if string(header[:len(blockArchiveMagic)]) != blockArchiveMagic {
+		f.Close()
+		return nil, errors.New("not a block archive file")
+	}
+	if v := header[len(blockArchiveMagic)]; v != blockArchiveVersion {
+		f.Close()
+		return nil, fmt.Errorf("unsupported block archive version %d", v)
+	}
+	return &fileArchive{
+		file: f,
+		r:    protoio.NewDelimitedReader(br, MaxMsgSize),
+		from: from,
+	}, nil
+}
+
+// Next implements blockArchive. Blocks below the starting height are skipped.
+func (a *fileArchive) Next() (*types.Block, *types.ExtendedCommit, error) {
+	for {
+		msg := &bcproto.BlockResponse{}
+		if _, err := a.r.ReadMsg(msg); err != nil {
+			return nil, nil, err
+		}
+		block, err := types.BlockFromProto(msg.Block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid block in archive: %w", err)
+		}
+		if block.Height < a.from {
+			continue
+		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			if extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit); err != nil {
+				return nil, nil, fmt.Errorf("invalid extended commit for block #%d in archive: %w", block.Height, err)
+			}
+		}
+		return block, extCommit, nil
+	}
+}
+
+// Close implements blockArchive.
+func (a *fileArchive) Close() error {
+	return a.file.Close()
+}
+
+// storeArchive reads the blocks of the block store of another node.
+type storeArchive struct {
+	db     dbm.DB
+	store  *store.BlockStore
+	height int64
+}
+
+func openStoreArchive(dir string, dbBackend string, from int64) (*storeArchive, error) {
+	name := "blockstore"
+	if base := filepath.Base(dir); strings.HasSuffix(base, ".db") {
+		name, dir = strings.TrimSuffix(base, ".db"), filepath.Dir(dir)
+	}
+	if _, err := os.Stat(filepath.Join(dir, name+".db")); err != nil {
+		return nil, fmt.Errorf("no block store found in %v: %w", dir, err)
+	}
+	db, err := openReadOnlyDB(name, dbm.BackendType(dbBackend), dir)
+	if err != nil {
+		return nil, err
+	}
+	blockStore := store.NewBlockStore(db, store.WithReadOnly())
+	if base := blockStore.Base(); from < base {
+		db.Close()
+		return nil, ErrArchiveGap{Expected: from, Got: base}
+	}
+	return &storeArchive{db: db, store: blockStore, height: from}, nil
+}
+
+// openReadOnlyDB opens the database of another node without writing to it.
+// The backends which cannot be opened read-only are opened as usual, but the
+// block store never writes to them.
+func openReadOnlyDB(name string, backend dbm.BackendType, dir string) (dbm.DB, error) {
+	switch backend {
+	case dbm.GoLevelDBBackend:
+		return dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{ReadOnly: true, ErrorIfMissing: true})
+	case dbm.PebbleDBBackend:
+		return dbm.NewPebbleDBWithOpts(name, dir, &pebble.Options{ReadOnly: true, ErrorIfNotExists: true})
+	default:
+		return dbm.NewDB(name, backend, dir)
+	}
+}
+
+// Next implements blockArchive.
+func (a *storeArchive) Next() (*types.Block, *types.ExtendedCommit, error) {
+	if a.height > a.store.Height() {
+		return nil, nil, io.EOF
+	}
+	block, _ := a.store.LoadBlock(a.height)
+	if block == nil {
+		return nil, nil, fmt.Errorf("block #%d not found in archive", a.height)
+	}
+	extCommit := a.store.LoadBlockExtendedCommit(a.height)
+	a.height++
+	return block, extCommit, nil
+}
+
+// Close implements blockArchive.
+func (a *storeArchive) Close() error {
+	return a.db.Close()
+}
+
+// SyncFromArchive applies the blocks of the block archive at path following
+// the given state, and returns the resulting state. The archive is either a
+// file written by WriteBlockArchive, or a directory holding the block store
+// of another node, stopped beforehand, opened with the given database backend.
+//
+// Each block is verified against the commit found in the next block, and
+// validated, before it is saved and applied, exactly as when block syncing
+// from peers. The last block of the archive is only applied if the archive
+// has its extended commit; otherwise it is left to be synced from peers, along
+// with the blocks following it.
+func SyncFromArchive(
+	ctx context.Context,
+	path string,
+	dbBackend string,
+	state sm.State,
+	blockExec *sm.BlockExecutor,
+	blockStore sm.BlockStore,
+	metrics *Metrics,
+	logger log.Logger,
+) (sm.State, error) {
+	nextHeight := state.LastBlockHeight + 1
+	if state.LastBlockHeight == 0 {
+		nextHeight = state.InitialHeight
+	}
+	archive, err := openBlockArchive(path, dbBackend, nextHeight)
+	if err != nil {
+		return state, err
+	}
+	defer archive.Close()
+
+	first, firstExtCommit, err := archive.Next()
+	if errors.Is(err, io.EOF) {
+		logger.Info("Block archive has no block to sync", "height", nextHeight)
+		return state, nil
+	} else if err != nil {
+		return state, err
+	}
+	if first.Height != nextHeight {
+		return state, ErrArchiveGap{Expected: nextHeight, Got: first.Height}
+	}
+
+	metrics.Syncing.Set(1)
+	defer metrics.Syncing.Set(0)
+
+	var (
+		blocksSynced = uint64(0)
+		lastHundred  = time.Now()
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return state, err
+		}
+
+		second, secondExtCommit, err := archive.Next()
+		var commit *types.Commit
+		switch {
+		case errors.Is(err, io.EOF):
+			if firstExtCommit == nil {
+				logger.Info("Synced blocks from archive", "height", state.LastBlockHeight, "blocks", blocksSynced)
+				return state, nil
+			}
+			commit = firstExtCommit.ToCommit()
+		case err != nil:
+			return state, err
+		case second.Height != first.Height+1:
+			return state, fmt.Errorf("heights of consecutive archive blocks are not consecutive; expected %d, got %d",
+				first.Height+1, second.Height)
+		default:
+			commit = second.LastCommit
+		}
+
+		firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
+		if err != nil {
+			return state, err
+		}
+		firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
+		extensionsEnabled := state.ConsensusParams.Feature.VoteExtensionsEnabled(first.Height)
+		if err := validateBlock(blockExec, state, first, firstID, commit, firstExtCommit); err != nil {
+			return state, fmt.Errorf("invalid block #%d in archive: %w", first.Height, err)
+		}
+
+		if extensionsEnabled {
+			blockStore.SaveBlockWithExtendedCommit(first, firstParts, firstExtCommit)
+		} else {
+			blockStore.SaveBlock(first, firstParts, commit)
+		}
+		state, err = blockExec.ApplyVerifiedBlock(state, firstID, first, first.Height)
+		if err != nil {
+			return state, fmt.Errorf("failed to process committed block (%d:%X): %w", first.Height, first.Hash(), err)
+		}
+		metrics.recordBlockMetrics(first)
+
+		blocksSynced++
+		if blocksSynced%100 == 0 {
+			logger.Info("Block Sync Rate", "height", state.LastBlockHeight,
+				"blocks/s", 100/time.Since(lastHundred).Seconds())
+			lastHundred = time.Now()
+		}
+
+		if second == nil {
+			logger.Info("Synced blocks from archive", "height", state.LastBlockHeight, "blocks", blocksSynced)
+			return state, nil
+		}
+		first, firstExtCommit = second, secondExtCommit
+	}
+}
@@ -0,0 +1,163 @@
+package blocksync
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// newArchiveSyncTarget returns the genesis state, block executor and block
+// store of a node syncing from a block archive.
+func newArchiveSyncTarget(t *testing.T, genDoc *types.GenesisDoc) (sm.State, *sm.BlockExecutor, *store.BlockStore) {
+	t.Helper()
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(abci.NewBaseApplication()), proxy.NopMetrics())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	state, err := stateStore.LoadFromDBOrGenesisDoc(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("PreUpdate").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).Return(nil)
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mp, sm.EmptyEvidencePool{}, blockStore)
+	return state, blockExec, blockStore
+}
+
+func writeArchiveFile(t *testing.T, blockStore sm.BlockStore, from, to int64) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "blocks.bin")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := bufio.NewWriter(f)
+	require.NoError(t, WriteBlockArchive(w, blockStore, from, to))
+	require.NoError(t, w.Flush())
+	require.NoError(t, f.Close())
+	return path
+}
+
+func TestSyncFromArchive_File(t *testing.T) {
+	const maxHeight = 10
+	genDoc, privVals := randGenesisDoc()
+	source := newReactor(t, log.TestingLogger(), genDoc, privVals, maxHeight)
+	defer func() { _ = source.app.Stop() }()
+	sourceStore := source.reactor.store
+
+	ctx := context.Background()
+	state, blockExec, blockStore := newArchiveSyncTarget(t, genDoc)
+
+	// The archive must contain the next block of the node.
+	path := writeArchiveFile(t, sourceStore, 3, maxHeight)
+	_, err := SyncFromArchive(ctx, path, "", state, blockExec, blockStore, NopMetrics(), log.TestingLogger())
+	require.Equal(t, ErrArchiveGap{Expected: 1, Got: 3}, err)
+
+	// The last block is applied too, as vote extensions are enabled and the
+	// archive has its extended commit.
+	path = writeArchiveFile(t, sourceStore, 1, 6)
+	state, err = SyncFromArchive(ctx, path, "", state, blockExec, blockStore, NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, state.LastBlockHeight)
+	assert.EqualValues(t, 6, blockStore.Height())
+
+	// Blocks the node already has are skipped.
+	path = writeArchiveFile(t, sourceStore, 1, maxHeight)
+	state, err = SyncFromArchive(ctx, path, "", state, blockExec, blockStore, NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+	assert.EqualValues(t, maxHeight, state.LastBlockHeight)
+	assert.Equal(t, sourceStore.LoadBlockMeta(maxHeight).BlockID, blockStore.LoadBlockMeta(maxHeight).BlockID)
+	assert.NotNil(t, blockStore.LoadBlockExtendedCommit(maxHeight))
+
+	state, err = SyncFromArchive(ctx, path, "", state, blockExec, blockStore, NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+	assert.EqualValues(t, maxHeight, state.LastBlockHeight)
+}
+
+func TestSyncFromArchive_InvalidBlock(t *testing.T) {
+	const maxHeight = 10
+	genDoc, privVals := randGenesisDoc()
+	// Block 5 is stored without its extended commit.
+	source := newReactor(t, log.TestingLogger(), genDoc, privVals, maxHeight, 5)
+	defer func() { _ = source.app.Stop() }()
+
+	state, blockExec, blockStore := newArchiveSyncTarget(t, genDoc)
+	path := writeArchiveFile(t, source.reactor.store, 1, maxHeight)
+	state, err := SyncFromArchive(context.Background(), path, "", state, blockExec, blockStore,
+		NopMetrics(), log.TestingLogger())
+	require.ErrorContains(t, err, "invalid block #5 in archive")
+	assert.EqualValues(t, 4, state.LastBlockHeight)
+	assert.EqualValues(t, 4, blockStore.Height())
+}
+
+func TestSyncFromArchive_BlockStore(t *testing.T) {
+	const maxHeight = 10
+	genDoc, privVals := randGenesisDoc()
+	genDoc.ConsensusParams.Feature.VoteExtensionsEnableHeight = 0
+	source := newReactor(t, log.TestingLogger(), genDoc, privVals, maxHeight)
+	defer func() { _ = source.app.Stop() }()
+
+	// Copy the blocks to the block store of a stopped node, which uses a key
+	// layout other than the default one.
+	dataDir := t.TempDir()
+	db, err := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+	archiveStore := store.NewBlockStore(db, store.WithDBKeyLayout("v2"))
+	for height := int64(1); height <= maxHeight; height++ {
+		block, _ := source.reactor.store.LoadBlock(height)
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		archiveStore.SaveBlock(block, parts, source.reactor.store.LoadSeenCommit(height))
+	}
+	require.NoError(t, archiveStore.Close())
+
+	// The last block is left to be synced from peers, as the archive does not
+	// have the commit for it.
+	for _, path := range []string{dataDir, filepath.Join(dataDir, "blockstore.db")} {
+		state, blockExec, blockStore := newArchiveSyncTarget(t, genDoc)
+		state, err = SyncFromArchive(context.Background(), path, string(dbm.GoLevelDBBackend), state, blockExec,
+			blockStore, NopMetrics(), log.TestingLogger())
+		require.NoError(t, err)
+		assert.EqualValues(t, maxHeight-1, state.LastBlockHeight)
+		assert.EqualValues(t, maxHeight-1, blockStore.Height())
+	}
+
+	// The archive is left untouched.
+	db, err = dbm.NewDB("blockstore", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+	version, err := db.Get([]byte("version"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(version))
+	require.NoError(t, db.Close())
+
+	_, err = SyncFromArchive(context.Background(), t.TempDir(), string(dbm.GoLevelDBBackend), sm.State{},
+		nil, nil, NopMetrics(), log.TestingLogger())
+	require.ErrorContains(t, err, "no block store found")
+}
@@ -30,6 +30,17 @@ func (e ErrInvalidBase) Error() string {
 	return fmt.Sprintf("invalid base %v: %s", e.Base, e.Reason)
 }
 
+// ErrArchiveGap is returned when a block archive does not contain the block
+// following the last block of the node.
+type ErrArchiveGap struct {
+	Expected int64
+	Got      int64
+}
+
+func (e ErrArchiveGap) Error() string {
+	return fmt.Sprintf("block archive does not contain block #%d, next block is #%d", e.Expected, e.Got)
+}
+
 type ErrUnknownMessageType struct {
 	Msg proto.Message
 }
@@ -44,15 +44,50 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "latest_block_height",
 			Help:      "The height of the latest block.",
 		}, labels).With(labelsAndValues...),
+		PeerBlockRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_block_rate",
+			Help:      "Rate at which a peer delivers the requested blocks, in blocks per second.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerBlockLatencySeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_block_latency_seconds",
+			Help:      "Average time taken by a peer to deliver a requested block.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerPendingRequests: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_pending_requests",
+			Help:      "Number of block requests outstanding with a peer.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerRequestWindow: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_request_window",
+			Help:      "Number of block requests which can be outstanding with a peer, adapted to its delivery rate.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		ReassignedRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reassigned_requests",
+			Help:      "Number of block requests also sent to another peer because the peer they were sent to was slow to deliver the block.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Syncing:           discard.NewGauge(),
-		NumTxs:            discard.NewGauge(),
-		TotalTxs:          discard.NewGauge(),
-		BlockSizeBytes:    discard.NewGauge(),
-		LatestBlockHeight: discard.NewGauge(),
+		Syncing:                 discard.NewGauge(),
+		NumTxs:                  discard.NewGauge(),
+		TotalTxs:                discard.NewGauge(),
+		BlockSizeBytes:          discard.NewGauge(),
+		LatestBlockHeight:       discard.NewGauge(),
+		PeerBlockRate:           discard.NewGauge(),
+		PeerBlockLatencySeconds: discard.NewGauge(),
+		PeerPendingRequests:     discard.NewGauge(),
+		PeerRequestWindow:       discard.NewGauge(),
+		ReassignedRequests:      discard.NewCounter(),
 	}
 }
@@ -25,6 +25,20 @@ type Metrics struct {
 	BlockSizeBytes metrics.Gauge
 	// The height of the latest block.
 	LatestBlockHeight metrics.Gauge
+
+	// Rate at which a peer delivers the requested blocks, in blocks per
+	// second.
+	PeerBlockRate metrics.Gauge `metrics_labels:"peer_id"`
+	// Average time taken by a peer to deliver a requested block.
+	PeerBlockLatencySeconds metrics.Gauge `metrics_labels:"peer_id"`
+	// Number of block requests outstanding with a peer.
+	PeerPendingRequests metrics.Gauge `metrics_labels:"peer_id"`
+	// Number of block requests which can be outstanding with a peer, adapted
+	// to its delivery rate.
+	PeerRequestWindow metrics.Gauge `metrics_labels:"peer_id"`
+	// Number of block requests also sent to another peer because the peer
+	// they were sent to was slow to deliver the block.
+	ReassignedRequests metrics.Counter `metrics_labels:"peer_id"`
 }
 
 func (m *Metrics) recordBlockMetrics(block *types.Block) {
@@ -29,9 +29,28 @@ eg, L = latency = 0.1s
 */
 
 const (
+	// The number of requests which can be outstanding with a peer is adapted to
+	// the rate at which it delivers blocks: it is the number of blocks the peer
+	// delivers in peerWindowDuration, bounded by minPendingRequestsPerPeer and
+	// maxPendingRequestsPerPeer. Peers whose rate is unknown yet get the
+	// maximum window.
 	maxPendingRequestsPerPeer = 20
+	minPendingRequestsPerPeer = 2
+	peerWindowDuration        = 2 * time.Second
 	requestRetrySeconds       = 30
 
+	// Weight of the latest sample in the moving averages of a peer's delivery
+	// rate and latency.
+	peerRateSmoothing = 0.2
+
+	// A block requested from a single peer for longer than slowRequestFactor
+	// times the average latency of the peer, and at least minSlowRequestAge,
+	// is also requested from another peer, without waiting for the slow peer
+	// to time out. Slow requests are checked every slowRequestCheckInterval.
+	slowRequestFactor        = 4
+	minSlowRequestAge        = 3 * time.Second
+	slowRequestCheckInterval = time.Second
+
 	// Minimum recv rate to ensure we're receiving blocks from a peer fast
 	// enough. If a peer is not sending us data at least that rate, we consider
 	// them to have timed out, and we disconnect.
@@ -81,11 +100,15 @@ type BlockPool struct {
 	// peers
 	peers         map[p2p.ID]*bpPeer
 	bannedPeers   map[p2p.ID]time.Time
-	sortedPeers   []*bpPeer // sorted by curRate, highest first
+	sortedPeers   []*bpPeer // sorted by deliveryRate, highest first
 	maxPeerHeight int64     // the biggest reported height
 
+	lastSlowRequestsCheck time.Time
+
 	requestsCh chan<- BlockRequest
 	errorsCh   chan<- peerError
+
+	metrics *Metrics
 }
 
 // NewBlockPool returns a new BlockPool with the height equal to start. Block
@@ -100,6 +123,7 @@ func NewBlockPool(start int64, requestsCh chan<- BlockRequest, errorsCh chan<- p
 
 		requestsCh: requestsCh,
 		errorsCh:   errorsCh,
+		metrics:    NopMetrics(),
 	}
 	bp.BaseService = *service.NewBaseService(nil, "BlockPool", bp)
 	return bp
@@ -127,9 +151,11 @@ func (pool *BlockPool) makeRequestersRoutine() {
 			time.Sleep(sleepDuration)
 		}
 
+		pool.reassignSlowRequests()
+
 		pool.mtx.Lock()
 		var (
-			maxRequestersCreated = len(pool.requesters) >= len(pool.peers)*maxPendingRequestsPerPeer
+			maxRequestersCreated = len(pool.requesters) >= pool.totalWindow()
 
 			nextHeight           = pool.height + int64(len(pool.requesters))
 			maxPeerHeightReached = nextHeight > pool.maxPeerHeight
@@ -185,6 +211,44 @@ func (pool *BlockPool) removeTimedoutPeers() {
 	pool.sortPeers()
 }
 
+// CONTRACT: pool.mtx must be locked.
+func (pool *BlockPool) totalWindow() int {
+	total := 0
+	for _, peer := range pool.peers {
+		total += int(peer.window())
+	}
+	return total
+}
+
+// reassignSlowRequests requests the blocks a peer is slow to deliver from
+// another peer as well.
+func (pool *BlockPool) reassignSlowRequests() {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	now := time.Now()
+	if now.Sub(pool.lastSlowRequestsCheck) < slowRequestCheckInterval {
+		return
+	}
+	pool.lastSlowRequestsCheck = now
+	pool.sortPeers()
+
+	if len(pool.peers) < 2 {
+		return
+	}
+	for _, requester := range pool.requesters {
+		peerID, requestedAt, ok := requester.singleRequest()
+		if !ok {
+			continue
+		}
+		peer := pool.peers[peerID]
+		if peer == nil || now.Sub(requestedAt) < peer.slowRequestAge() {
+			continue
+		}
+		requester.reassign()
+	}
+}
+
 // IsCaughtUp returns true if this node is caught up, false - otherwise.
 // TODO: relax conditions, prevent abuse.
 func (pool *BlockPool) IsCaughtUp() (isCaughtUp bool, height, maxPeerHeight int64) {
@@ -320,6 +384,7 @@ func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, extCommit *ty
 		return fmt.Errorf("got an already committed block #%d (possibly from the slow peer %s)", block.Height, peerID)
 	}
 
+	requestedAt := requester.requestTime(peerID)
 	if !requester.setBlock(block, extCommit, peerID) {
 		err := fmt.Errorf("requested block #%d from %v, not %s", block.Height, requester.requestedFrom(), peerID)
 		pool.sendError(err, peerID)
@@ -328,7 +393,11 @@ func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, extCommit *ty
 
 	peer := pool.peers[peerID]
 	if peer != nil {
-		peer.decrPending(blockSize)
+		var latency time.Duration
+		if !requestedAt.IsZero() {
+			latency = time.Since(requestedAt)
+		}
+		peer.decrPending(blockSize, latency)
 	}
 
 	return nil
@@ -365,7 +434,7 @@ func (pool *BlockPool) SetPeerRange(peerID p2p.ID, base int64, height int64) {
 		peer = newBPPeer(pool, peerID, base, height)
 		peer.setLogger(pool.Logger.With("peer", peerID))
 		pool.peers[peerID] = peer
-		// no need to sort because curRate is 0 at start.
+		// no need to sort because deliveryRate is 0 at start.
 		// just add to the beginning so it's picked first by pickIncrAvailablePeer.
 		pool.sortedPeers = append([]*bpPeer{peer}, pool.sortedPeers...)
 	}
@@ -398,6 +467,9 @@ func (pool *BlockPool) removePeer(peerID p2p.ID) {
 			peer.timeout.Stop()
 		}
 
+		pool.metrics.PeerPendingRequests.With("peer_id", string(peerID)).Set(0)
+		pool.metrics.PeerRequestWindow.With("peer_id", string(peerID)).Set(0)
+
 		delete(pool.peers, peerID)
 		for i, p := range pool.sortedPeers {
 			if p.id == peerID {
@@ -457,7 +529,7 @@ func (pool *BlockPool) pickIncrAvailablePeer(height int64, excludePeerID p2p.ID)
 			pool.removePeer(peer.id)
 			continue
 		}
-		if peer.numPending >= maxPendingRequestsPerPeer {
+		if peer.numPending >= peer.window() {
 			continue
 		}
 		if height < peer.base || height > peer.height {
@@ -470,12 +542,12 @@ func (pool *BlockPool) pickIncrAvailablePeer(height int64, excludePeerID p2p.ID)
 	return nil
 }
 
-// Sort peers by curRate, highest first.
+// Sort peers by deliveryRate, highest first.
 //
 // CONTRACT: pool.mtx must be locked.
 func (pool *BlockPool) sortPeers() {
-	sort.Slice(pool.sortedPeers, func(i, j int) bool {
-		return pool.sortedPeers[i].curRate > pool.sortedPeers[j].curRate
+	sort.SliceStable(pool.sortedPeers, func(i, j int) bool {
+		return pool.sortedPeers[i].deliveryRate > pool.sortedPeers[j].deliveryRate
 	})
 }
 
@@ -539,6 +611,13 @@ type bpPeer struct {
 	id          p2p.ID
 	recvMonitor *flow.Monitor
 
+	// Moving averages of the number of blocks delivered per second while
+	// requests are outstanding, and of the time taken to deliver a block.
+	deliveryRate float64
+	latency      time.Duration
+	busySince    time.Time
+	lastDelivery time.Time
+
 	timeout *time.Timer
 
 	logger log.Logger
@@ -578,12 +657,16 @@ func (peer *bpPeer) incrPending() {
 	if peer.numPending == 0 {
 		peer.resetMonitor()
 		peer.resetTimeout()
+		peer.busySince = time.Now()
 	}
 	peer.numPending++
+	peer.pool.metrics.PeerPendingRequests.With("peer_id", string(peer.id)).Set(float64(peer.numPending))
 }
 
-func (peer *bpPeer) decrPending(recvSize int) {
+func (peer *bpPeer) decrPending(recvSize int, latency time.Duration) {
+	peer.recordDelivery(latency)
 	peer.numPending--
+	peer.pool.metrics.PeerPendingRequests.With("peer_id", string(peer.id)).Set(float64(peer.numPending))
 	if peer.numPending == 0 {
 		peer.timeout.Stop()
 	} else {
@@ -592,6 +675,65 @@ func (peer *bpPeer) decrPending(recvSize int) {
 	}
 }
 
+// recordDelivery updates the delivery rate and latency of the peer with a
+// block delivered after the given latency. The rate is only measured while
+// requests are outstanding, so that idle periods do not count against the
+// peer.
+func (peer *bpPeer) recordDelivery(latency time.Duration) {
+	now := time.Now()
+	since := peer.lastDelivery
+	if since.Before(peer.busySince) {
+		since = peer.busySince
+	}
+	if interval := now.Sub(since); interval > 0 {
+		peer.deliveryRate = movingAverage(peer.deliveryRate, float64(time.Second)/float64(interval))
+	}
+	if latency > 0 {
+		peer.latency = time.Duration(movingAverage(float64(peer.latency), float64(latency)))
+	}
+	peer.lastDelivery = now
+
+	metrics := peer.pool.metrics
+	metrics.PeerBlockRate.With("peer_id", string(peer.id)).Set(peer.deliveryRate)
+	metrics.PeerBlockLatencySeconds.With("peer_id", string(peer.id)).Set(peer.latency.Seconds())
+	metrics.PeerRequestWindow.With("peer_id", string(peer.id)).Set(float64(peer.window()))
+}
+
+// window returns the number of requests which can be outstanding with the
+// peer. Since a peer cannot deliver more blocks than requested, the window
+// keeps growing while the peer delivers the blocks quickly, and stops growing
+// once more requests only increase the latency.
+func (peer *bpPeer) window() int32 {
+	if peer.deliveryRate == 0 {
+		return maxPendingRequestsPerPeer
+	}
+	window := int32(math.Ceil(peer.deliveryRate * peerWindowDuration.Seconds()))
+	switch {
+	case window < minPendingRequestsPerPeer:
+		return minPendingRequestsPerPeer
+	case window > maxPendingRequestsPerPeer:
+		return maxPendingRequestsPerPeer
+	}
+	return window
+}
+
+// slowRequestAge returns the age after which a request to the peer is
+// considered slow.
+func (peer *bpPeer) slowRequestAge() time.Duration {
+	age := slowRequestFactor * peer.latency
+	if age < minSlowRequestAge {
+		return minSlowRequestAge
+	}
+	return age
+}
+
+func movingAverage(avg, sample float64) float64 {
+	if avg == 0 {
+		return sample
+	}
+	return avg + peerRateSmoothing*(sample-avg)
+}
+
 func (peer *bpPeer) onTimeout() {
 	peer.pool.mtx.Lock()
 	defer peer.pool.mtx.Unlock()
@@ -620,13 +762,16 @@ type bpRequester struct {
 	gotBlockCh  chan struct{}
 	redoCh      chan p2p.ID // redo may got multiple messages, add peerId to identify repeat
 	newHeightCh chan int64
-
-	mtx          cmtsync.Mutex
-	peerID       p2p.ID
-	secondPeerID p2p.ID // alternative peer to request from (if close to pool's height)
-	gotBlockFrom p2p.ID
-	block        *types.Block
-	extCommit    *types.ExtendedCommit
+	reassignCh  chan struct{}
+
+	mtx               cmtsync.Mutex
+	peerID            p2p.ID
+	secondPeerID      p2p.ID // alternative peer to request from (if close to pool's height or the first peer is slow)
+	requestedAt       time.Time
+	secondRequestedAt time.Time
+	gotBlockFrom      p2p.ID
+	block             *types.Block
+	extCommit         *types.ExtendedCommit
 }
 
 func newBPRequester(pool *BlockPool, height int64) *bpRequester {
@@ -636,6 +781,7 @@ func newBPRequester(pool *BlockPool, height int64) *bpRequester {
 		gotBlockCh:  make(chan struct{}, 1),
 		redoCh:      make(chan p2p.ID, 1),
 		newHeightCh: make(chan int64, 1),
+		reassignCh:  make(chan struct{}, 1),
 
 		peerID:       "",
 		secondPeerID: "",
@@ -707,6 +853,31 @@ func (bpr *bpRequester) didRequestFrom(peerID p2p.ID) bool {
 	return bpr.peerID == peerID || bpr.secondPeerID == peerID
 }
 
+// Returns the time the block was requested from the given peer, or the zero
+// time if it was not.
+func (bpr *bpRequester) requestTime(peerID p2p.ID) time.Time {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+	switch peerID {
+	case bpr.peerID:
+		return bpr.requestedAt
+	case bpr.secondPeerID:
+		return bpr.secondRequestedAt
+	}
+	return time.Time{}
+}
+
+// Returns the peer the block was requested from, and when, if the block was
+// requested from a single peer and not received yet.
+func (bpr *bpRequester) singleRequest() (peerID p2p.ID, requestedAt time.Time, ok bool) {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+	if bpr.block != nil || bpr.peerID == "" || bpr.secondPeerID != "" {
+		return "", time.Time{}, false
+	}
+	return bpr.peerID, bpr.requestedAt, true
+}
+
 // Returns the ID of the peer who sent us the block.
 func (bpr *bpRequester) gotBlockFromPeerID() p2p.ID {
 	bpr.mtx.Lock()
@@ -729,8 +900,10 @@ func (bpr *bpRequester) reset(peerID p2p.ID) (removedBlock bool) {
 
 	if bpr.peerID == peerID {
 		bpr.peerID = ""
+		bpr.requestedAt = time.Time{}
 	} else {
 		bpr.secondPeerID = ""
+		bpr.secondRequestedAt = time.Time{}
 	}
 
 	return removedBlock
@@ -767,6 +940,7 @@ PICK_PEER_LOOP:
 	}
 	bpr.mtx.Lock()
 	bpr.peerID = peer.id
+	bpr.requestedAt = time.Now()
 	bpr.mtx.Unlock()
 
 	bpr.pool.sendRequest(bpr.height, peer.id)
@@ -787,6 +961,7 @@ func (bpr *bpRequester) pickSecondPeerAndSendRequest() (picked bool) {
 	if secondPeer != nil {
 		bpr.mtx.Lock()
 		bpr.secondPeerID = secondPeer.id
+		bpr.secondRequestedAt = time.Now()
 		bpr.mtx.Unlock()
 
 		bpr.pool.sendRequest(bpr.height, secondPeer.id)
@@ -804,6 +979,16 @@ func (bpr *bpRequester) newHeight(height int64) {
 	}
 }
 
+// Tells bpRequester to request the block from a second peer, because the
+// first one is slow to deliver it.
+// NOTE: Nonblocking, and does nothing if a reassignment was already requested.
+func (bpr *bpRequester) reassign() {
+	select {
+	case bpr.reassignCh <- struct{}{}:
+	default:
+	}
+}
+
 // Responsible for making more requests as necessary
 // Returns only when a block is found (e.g. AddBlock() is called).
 func (bpr *bpRequester) requestRoutine() {
@@ -861,6 +1046,19 @@ OUTER_LOOP:
 						retryTimer.Reset(requestRetrySeconds * time.Second)
 					}
 				}
+			case <-bpr.reassignCh:
+				if !gotBlock {
+					bpr.mtx.Lock()
+					slowPeerID := bpr.peerID
+					bpr.mtx.Unlock()
+					if picked := bpr.pickSecondPeerAndSendRequest(); picked {
+						bpr.Logger.Debug("Requested block from a second peer, as the first one is slow",
+							"height", bpr.height, "peer", slowPeerID)
+						bpr.pool.metrics.ReassignedRequests.With("peer_id", string(slowPeerID)).Add(1)
+						_ = retryTimer.Stop()
+						retryTimer.Reset(requestRetrySeconds * time.Second)
+					}
+				}
 			case <-bpr.gotBlockCh:
 				gotBlock = true
 				// We got a block!
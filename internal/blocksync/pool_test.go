@@ -393,3 +393,69 @@ func TestBlockPoolMaliciousNode(t *testing.T) {
 		}
 	}
 }
+
+func TestBPPeerWindow(t *testing.T) {
+	pool := NewBlockPool(1, make(chan BlockRequest), make(chan peerError, 1))
+	peer := newBPPeer(pool, "a", 1, 100)
+
+	// Peers whose rate is unknown get the maximum window.
+	assert.EqualValues(t, maxPendingRequestsPerPeer, peer.window())
+
+	// The window holds peerWindowDuration worth of blocks.
+	peer.deliveryRate = 3
+	assert.EqualValues(t, 6, peer.window())
+	peer.deliveryRate = 0.1
+	assert.EqualValues(t, minPendingRequestsPerPeer, peer.window())
+	peer.deliveryRate = 1000
+	assert.EqualValues(t, maxPendingRequestsPerPeer, peer.window())
+
+	// The rate is measured from the time requests are outstanding.
+	peer.deliveryRate = 0
+	peer.incrPending()
+	peer.incrPending()
+	peer.busySince = time.Now().Add(-500 * time.Millisecond)
+	peer.decrPending(100, 400*time.Millisecond)
+	assert.InDelta(t, 2, peer.deliveryRate, 0.1)
+	assert.Equal(t, 400*time.Millisecond, peer.latency)
+	assert.Equal(t, minSlowRequestAge, peer.slowRequestAge())
+
+	peer.lastDelivery = time.Now().Add(-250 * time.Millisecond)
+	peer.decrPending(100, 2*time.Second)
+	assert.InDelta(t, 2.4, peer.deliveryRate, 0.1)
+	assert.Equal(t, 720*time.Millisecond, peer.latency)
+	assert.Equal(t, minSlowRequestAge, peer.slowRequestAge())
+	peer.latency = 2 * time.Second
+	assert.Equal(t, 8*time.Second, peer.slowRequestAge())
+}
+
+func TestBlockPoolReassignSlowRequests(t *testing.T) {
+	pool := NewBlockPool(1, make(chan BlockRequest), make(chan peerError, 1))
+	pool.SetLogger(log.TestingLogger())
+	pool.SetPeerRange("slow", 1, 10)
+	pool.SetPeerRange("fast", 1, 10)
+	pool.peers["slow"].latency = time.Second
+
+	newRequester := func(height int64, requestedAt time.Time) *bpRequester {
+		r := newBPRequester(pool, height)
+		r.peerID = "slow"
+		r.requestedAt = requestedAt
+		pool.requesters[height] = r
+		return r
+	}
+	// Requests are slow after slowRequestFactor times the peer's latency.
+	recent := newRequester(1, time.Now().Add(-2*slowRequestFactor*time.Second/3))
+	slow := newRequester(2, time.Now().Add(-2*slowRequestFactor*time.Second))
+	// Requests already sent to a second peer are left alone.
+	hedged := newRequester(3, time.Now().Add(-2*slowRequestFactor*time.Second))
+	hedged.secondPeerID = "fast"
+
+	pool.reassignSlowRequests()
+	assert.Empty(t, recent.reassignCh)
+	assert.Len(t, slow.reassignCh, 1)
+	assert.Empty(t, hedged.reassignCh)
+
+	// Requests are checked every slowRequestCheckInterval.
+	<-slow.reassignCh
+	pool.reassignSlowRequests()
+	assert.Empty(t, slow.reassignCh)
+}
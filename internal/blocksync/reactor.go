@@ -1,6 +1,7 @@
 package blocksync
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
@@ -71,6 +72,12 @@ type Reactor struct {
 
 	switchToConsensusMs int
 
+	// the local block archive synced before syncing from peers, if any
+	archivePath      string
+	archiveDBBackend string
+	archiveCancel    context.CancelFunc
+	archiveWg        sync.WaitGroup
+
 	metrics *Metrics
 }
 
@@ -105,6 +112,7 @@ func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockS
 		startHeight = state.InitialHeight
 	}
 	pool := NewBlockPool(startHeight, requestsCh, errorsCh)
+	pool.metrics = metrics
 
 	bcR := &Reactor{
 		initialState: state,
@@ -127,12 +135,60 @@ func (bcR *Reactor) SetLogger(l log.Logger) {
 	bcR.pool.Logger = l
 }
 
+// SetArchive makes the reactor sync the blocks of the local block archive at
+// path, opened with the given database backend if it is a block store, before
+// block syncing from peers. It must be called before the reactor is started.
+func (bcR *Reactor) SetArchive(path, dbBackend string) {
+	bcR.archivePath = path
+	bcR.archiveDBBackend = dbBackend
+}
+
 // OnStart implements service.Service.
 func (bcR *Reactor) OnStart() error {
-	if bcR.blockSync {
-		return bcR.startPool(false)
+	if !bcR.blockSync {
+		return nil
+	}
+	if bcR.archivePath != "" {
+		ctx, cancel := context.WithCancel(context.Background())
+		bcR.archiveCancel = cancel
+		bcR.archiveWg.Add(1)
+		go func() {
+			defer bcR.archiveWg.Done()
+			bcR.archiveRoutine(ctx)
+		}()
+		return nil
+	}
+	return bcR.startPool(false)
+}
+
+// archiveRoutine syncs the blocks of the local block archive, then starts
+// block syncing the following blocks from peers. The blocks applied before a
+// failure are kept, and the remaining ones are synced from peers.
+func (bcR *Reactor) archiveRoutine(ctx context.Context) {
+	bcR.Logger.Info("Syncing blocks from archive", "path", bcR.archivePath)
+	state, err := SyncFromArchive(ctx, bcR.archivePath, bcR.archiveDBBackend,
+		bcR.initialState, bcR.blockExec, bcR.store, bcR.metrics, bcR.Logger)
+	if ctx.Err() != nil {
+		bcR.Logger.Info("Stopped syncing blocks from archive", "height", state.LastBlockHeight)
+		return
+	}
+	if err != nil {
+		bcR.Logger.Error("Failed to sync blocks from archive, syncing the remaining ones from peers",
+			"height", state.LastBlockHeight, "err", err)
+	}
+
+	synced := state.LastBlockHeight > bcR.initialState.LastBlockHeight
+	if synced {
+		bcR.initialState = state
+		bcR.pool.mtx.Lock()
+		bcR.pool.height = state.LastBlockHeight + 1
+		bcR.pool.mtx.Unlock()
+	}
+	// As after state sync, the WAL has nothing to replay once blocks were
+	// synced from the archive.
+	if err := bcR.startPool(synced); err != nil {
+		bcR.Logger.Error("Failed to start block sync", "err", err)
 	}
-	return nil
 }
 
 // SwitchToBlockSync is called by the statesync reactor when switching to blocksync.
@@ -164,9 +220,17 @@ func (bcR *Reactor) startPool(stateSynced bool) error {
 
 // OnStop implements service.Service.
 func (bcR *Reactor) OnStop() {
+	if bcR.archiveCancel != nil {
+		bcR.archiveCancel()
+		bcR.archiveWg.Wait()
+	}
 	if bcR.blockSync {
-		if err := bcR.pool.Stop(); err != nil {
-			bcR.Logger.Error("Error stopping pool", "err", err)
+		// The pool is not started yet if the node stops while syncing
+		// blocks from the archive.
+		if bcR.pool.IsRunning() {
+			if err := bcR.pool.Stop(); err != nil {
+				bcR.Logger.Error("Error stopping pool", "err", err)
+			}
 		}
 		bcR.poolRoutineWg.Wait()
 	}
@@ -534,37 +598,13 @@ func (bcR *Reactor) isCaughtUp(state sm.State, blocksSynced uint64, stateSynced
 
 func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.PartSet, state sm.State, extCommit *types.ExtendedCommit) (sm.State, error) {
 	var (
-		chainID            = bcR.initialState.ChainID
 		firstPartSetHeader = firstParts.Header()
 		firstID            = types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
+		extensionsEnabled  = state.ConsensusParams.Feature.VoteExtensionsEnabled(first.Height)
 	)
 
 	// Finally, verify the first block using the second's commit
-	// NOTE: we can probably make this more efficient, but note that calling
-	// first.Hash() doesn't verify the tx contents, so MakePartSet() is
-	// currently necessary.
-	// TODO(sergio): Should we also validate against the extended commit?
-	err := state.Validators.VerifyCommitLight(
-		chainID, firstID, first.Height, second.LastCommit)
-
-	if err == nil {
-		// validate the block before we persist it
-		err = bcR.blockExec.ValidateBlock(state, first)
-	}
-
-	presentExtCommit := extCommit != nil
-	extensionsEnabled := state.ConsensusParams.Feature.VoteExtensionsEnabled(first.Height)
-	if presentExtCommit != extensionsEnabled {
-		err = fmt.Errorf("non-nil extended commit must be received iff vote extensions are enabled for its height "+
-			"(height %d, non-nil extended commit %t, extensions enabled %t)",
-			first.Height, presentExtCommit, extensionsEnabled,
-		)
-	}
-	if err == nil && extensionsEnabled {
-		// if vote extensions were required at this height, ensure they exist.
-		err = extCommit.EnsureExtensions(true)
-	}
-
+	err := validateBlock(bcR.blockExec, state, first, firstID, second.LastCommit, extCommit)
 	if err != nil {
 		peerID := bcR.pool.RemovePeerAndRedoAllPeerRequests(first.Height)
 		peer := bcR.Switch.Peers().Get(peerID)
@@ -609,3 +649,41 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 
 	return state, nil
 }
+
+// validateBlock verifies the block against the commit for it, validates it,
+// and checks that its extended commit is present iff vote extensions are
+// enabled at its height.
+func validateBlock(
+	blockExec *sm.BlockExecutor,
+	state sm.State,
+	block *types.Block,
+	blockID types.BlockID,
+	commit *types.Commit,
+	extCommit *types.ExtendedCommit,
+) error {
+	// NOTE: we can probably make this more efficient, but note that calling
+	// block.Hash() doesn't verify the tx contents, so MakePartSet() is
+	// currently necessary.
+	// TODO(sergio): Should we also validate against the extended commit?
+	err := state.Validators.VerifyCommitLight(
+		state.ChainID, blockID, block.Height, commit)
+
+	if err == nil {
+		// validate the block before we persist it
+		err = blockExec.ValidateBlock(state, block)
+	}
+
+	presentExtCommit := extCommit != nil
+	extensionsEnabled := state.ConsensusParams.Feature.VoteExtensionsEnabled(block.Height)
+	if presentExtCommit != extensionsEnabled {
+		err = fmt.Errorf("non-nil extended commit must be received iff vote extensions are enabled for its height "+
+			"(height %d, non-nil extended commit %t, extensions enabled %t)",
+			block.Height, presentExtCommit, extensionsEnabled,
+		)
+	}
+	if err == nil && extensionsEnabled {
+		// if vote extensions were required at this height, ensure they exist.
+		err = extCommit.EnsureExtensions(true)
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+package consensus
+
+import (
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/types"
+)
+
+// latencyEstimator keeps a moving estimate of a latency and of its mean
+// deviation, the way TCP estimates round-trip times (RFC 6298).
+type latencyEstimator struct {
+	observed bool
+	avg      time.Duration
+	dev      time.Duration
+}
+
+// observe adds a sample to the estimate.
+func (le *latencyEstimator) observe(sample time.Duration) {
+	if sample < 0 {
+		sample = 0
+	}
+	if !le.observed {
+		le.observed = true
+		le.avg, le.dev = sample, sample/2
+		return
+	}
+	diff := sample - le.avg
+	if diff < 0 {
+		diff = -diff
+	}
+	le.dev += (diff - le.dev) / 4
+	le.avg += (sample - le.avg) / 8
+}
+
+// timeout returns twice the estimated latency plus four times its mean
+// deviation, bounded by minTimeout and maxTimeout.
+func (le *latencyEstimator) timeout(minTimeout, maxTimeout time.Duration) time.Duration {
+	return min(max(2*le.avg+4*le.dev, minTimeout), maxTimeout)
+}
+
+type voteRoundKey struct {
+	round    int32
+	voteType types.SignedMsgType
+}
+
+type voteRoundArrivals struct {
+	// when the vote set reached +2/3 votes for anything
+	twoThirdsAny time.Time
+	sampled      bool
+}
+
+// adaptiveTimeouts derives timeout_propose and timeout_vote from the
+// latencies observed in the recent rounds:
+//   - the proposal latency is the time between the start of the propose step
+//     and the receipt of the complete proposal of the round;
+//   - the vote latency is the time between the receipt of +2/3 prevotes
+//     (resp. precommits) for anything in a round, which starts the wait step,
+//     and the receipt of +2/3 of them for a single block or nil, or the end
+//     of the wait step if it timed out first.
+//
+// It is only used by the consensus routine, and is thus not safe for
+// concurrent use. A nil adaptiveTimeouts derives no timeouts.
+type adaptiveTimeouts struct {
+	config *cfg.ConsensusConfig
+
+	proposal latencyEstimator
+	vote     latencyEstimator
+
+	// the propose step being timed, in which the complete proposal may have
+	// been received before the step started
+	proposeHeight   int64
+	proposeRound    int32
+	proposeStart    time.Time
+	proposeComplete time.Time
+
+	// the arrivals of the votes of the rounds of voteHeight
+	voteHeight   int64
+	voteArrivals map[voteRoundKey]*voteRoundArrivals
+}
+
+// newAdaptiveTimeouts returns nil if adaptive timeouts are disabled.
+func newAdaptiveTimeouts(config *cfg.ConsensusConfig) *adaptiveTimeouts {
+	if !config.AdaptiveTimeouts {
+		return nil
+	}
+	return &adaptiveTimeouts{
+		config:       config,
+		voteArrivals: make(map[voteRoundKey]*voteRoundArrivals),
+	}
+}
+
+// Propose returns the timeout of the propose step of the round, or false if
+// no proposal latency was observed yet.
+func (at *adaptiveTimeouts) Propose(round int32) (time.Duration, bool) {
+	if at == nil || !at.proposal.observed {
+		return 0, false
+	}
+	timeout := at.proposal.timeout(at.config.TimeoutProposeMin, at.config.TimeoutProposeMax)
+	return timeout + time.Duration(round)*at.config.TimeoutProposeDelta, true
+}
+
+// Vote returns the timeout of the prevote and precommit wait steps of the
+// round, or false if no vote latency was observed yet.
+func (at *adaptiveTimeouts) Vote(round int32) (time.Duration, bool) {
+	if at == nil || !at.vote.observed {
+		return 0, false
+	}
+	timeout := at.vote.timeout(at.config.TimeoutVoteMin, at.config.TimeoutVoteMax)
+	return timeout + time.Duration(round)*at.config.TimeoutVoteDelta, true
+}
+
+// ProposeStarted records the start of the propose step of a round.
+func (at *adaptiveTimeouts) ProposeStarted(height int64, round int32, now time.Time) {
+	if at == nil {
+		return
+	}
+	if at.proposeHeight != height || at.proposeRound != round {
+		at.proposeHeight, at.proposeRound = height, round
+		at.proposeStart, at.proposeComplete = now, time.Time{}
+		return
+	}
+	if at.proposeStart.IsZero() {
+		at.proposeStart = now
+		if !at.proposeComplete.IsZero() {
+			at.proposal.observe(at.proposeComplete.Sub(now))
+		}
+	}
+}
+
+// ProposalCompleted records the receipt of the complete proposal of a round.
+// Proposals completed for a round older than the one being timed are ignored.
+func (at *adaptiveTimeouts) ProposalCompleted(height int64, round int32, now time.Time) {
+	if at == nil {
+		return
+	}
+	switch {
+	case height < at.proposeHeight || (height == at.proposeHeight && round < at.proposeRound):
+		return
+	case height != at.proposeHeight || round != at.proposeRound:
+		at.proposeHeight, at.proposeRound = height, round
+		at.proposeStart, at.proposeComplete = time.Time{}, now
+		return
+	}
+	if at.proposeComplete.IsZero() {
+		at.proposeComplete = now
+		if !at.proposeStart.IsZero() {
+			at.proposal.observe(now.Sub(at.proposeStart))
+		}
+	}
+}
+
+// VoteAdded records the arrival of a vote of the current height, and whether
+// its vote set has +2/3 votes for anything, and for a single block or nil, now
+// that it was added.
+func (at *adaptiveTimeouts) VoteAdded(vote *types.Vote, hasTwoThirdsAny, hasTwoThirdsMajority bool, now time.Time) {
+	if at == nil {
+		return
+	}
+	if vote.Height != at.voteHeight {
+		if vote.Height < at.voteHeight {
+			return
+		}
+		at.voteHeight = vote.Height
+		clear(at.voteArrivals)
+	}
+	if !hasTwoThirdsAny {
+		return
+	}
+	key := voteRoundKey{round: vote.Round, voteType: vote.Type}
+	arrivals, ok := at.voteArrivals[key]
+	if !ok {
+		arrivals = &voteRoundArrivals{twoThirdsAny: now}
+		at.voteArrivals[key] = arrivals
+	}
+	if hasTwoThirdsMajority && !arrivals.sampled {
+		arrivals.sampled = true
+		at.vote.observe(now.Sub(arrivals.twoThirdsAny))
+	}
+}
+
+// VoteWaitTimedOut records the timeout of the prevote (resp. precommit) wait
+// step of a round, before +2/3 votes for a single block or nil were received.
+// The time waited is a lower bound of the vote latency.
+func (at *adaptiveTimeouts) VoteWaitTimedOut(height int64, round int32, voteType types.SignedMsgType, now time.Time) {
+	if at == nil || height != at.voteHeight {
+		return
+	}
+	arrivals, ok := at.voteArrivals[voteRoundKey{round: round, voteType: voteType}]
+	if !ok || arrivals.sampled {
+		return
+	}
+	arrivals.sampled = true
+	at.vote.observe(now.Sub(arrivals.twoThirdsAny))
+}
@@ -0,0 +1,139 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestLatencyEstimator(t *testing.T) {
+	var le latencyEstimator
+
+	// The first sample sets the estimate, with a large deviation.
+	le.observe(100 * time.Millisecond)
+	require.Equal(t, 400*time.Millisecond, le.timeout(0, time.Minute))
+
+	// The deviation vanishes as the latency remains the same.
+	for i := 0; i < 100; i++ {
+		le.observe(100 * time.Millisecond)
+	}
+	require.InDelta(t, 200*time.Millisecond, le.timeout(0, time.Minute), float64(time.Millisecond))
+
+	// The estimate follows the latency when it increases.
+	for i := 0; i < 100; i++ {
+		le.observe(time.Second)
+	}
+	require.InDelta(t, 2*time.Second, le.timeout(0, time.Minute), float64(10*time.Millisecond))
+
+	// The timeout is bounded.
+	require.Equal(t, 3*time.Second, le.timeout(3*time.Second, time.Minute))
+	require.Equal(t, time.Second, le.timeout(0, time.Second))
+
+	// Negative latencies, caused by clock adjustments, count as 0.
+	le = latencyEstimator{}
+	le.observe(-time.Second)
+	require.Zero(t, le.timeout(0, time.Minute))
+}
+
+func TestAdaptiveTimeouts(t *testing.T) {
+	config := cfg.DefaultConsensusConfig()
+	require.Nil(t, newAdaptiveTimeouts(config))
+
+	config.AdaptiveTimeouts = true
+	config.TimeoutProposeMin, config.TimeoutProposeMax = 0, time.Minute
+	config.TimeoutVoteMin, config.TimeoutVoteMax = 0, time.Minute
+	at := newAdaptiveTimeouts(config)
+	now := time.Now()
+
+	// The static timeouts are used until latencies are observed.
+	_, ok := at.Propose(0)
+	require.False(t, ok)
+	_, ok = at.Vote(0)
+	require.False(t, ok)
+
+	// The proposal latency is measured from the start of the propose step.
+	at.ProposeStarted(1, 0, now)
+	at.ProposalCompleted(1, 0, now.Add(100*time.Millisecond))
+	timeout, ok := at.Propose(0)
+	require.True(t, ok)
+	require.Equal(t, 400*time.Millisecond, timeout)
+	timeout, _ = at.Propose(2)
+	require.Equal(t, 400*time.Millisecond+2*config.TimeoutProposeDelta, timeout)
+
+	// A proposal completed before the propose step started took no time, and
+	// a proposal of an older round is ignored.
+	at.ProposalCompleted(2, 0, now)
+	at.ProposalCompleted(1, 1, now)
+	at.ProposeStarted(2, 0, now.Add(time.Second))
+	require.Equal(t, 87500*time.Microsecond, at.proposal.avg)
+	require.Equal(t, 62500*time.Microsecond, at.proposal.dev)
+
+	// The vote latency is measured from +2/3 votes for anything to +2/3 votes
+	// for a single block or nil, once.
+	vote := &types.Vote{Type: types.PrevoteType, Height: 1, Round: 0}
+	at.VoteAdded(vote, false, false, now)
+	at.VoteAdded(vote, true, false, now.Add(time.Second))
+	_, ok = at.Vote(0)
+	require.False(t, ok)
+	at.VoteAdded(vote, true, true, now.Add(time.Second+50*time.Millisecond))
+	at.VoteAdded(vote, true, true, now.Add(2*time.Second))
+	timeout, ok = at.Vote(0)
+	require.True(t, ok)
+	require.Equal(t, 200*time.Millisecond, timeout)
+
+	// If the wait step times out first, the time waited is sampled instead.
+	vote = &types.Vote{Type: types.PrecommitType, Height: 1, Round: 0}
+	at.VoteAdded(vote, true, false, now)
+	at.VoteWaitTimedOut(1, 0, types.PrecommitType, now.Add(450*time.Millisecond))
+	at.VoteAdded(vote, true, true, now.Add(time.Second))
+	require.Equal(t, 100*time.Millisecond, at.vote.avg)
+	require.Equal(t, 118750*time.Microsecond, at.vote.dev)
+
+	// A wait step without +2/3 votes for anything samples nothing.
+	at.VoteWaitTimedOut(1, 1, types.PrevoteType, now.Add(time.Minute))
+	require.Equal(t, 100*time.Millisecond, at.vote.avg)
+
+	// Votes of an older height are ignored.
+	at.VoteAdded(&types.Vote{Type: types.PrecommitType, Height: 2, Round: 0}, true, false, now)
+	at.VoteAdded(&types.Vote{Type: types.PrecommitType, Height: 1, Round: 1}, true, false, now)
+	require.Len(t, at.voteArrivals, 1)
+}
+
+func TestAdaptiveTimeoutsSimulation(t *testing.T) {
+	// One of the validators is down, so every fourth round times out while
+	// waiting for its proposal.
+	network := simPartition{
+		simNetwork: simLinks{minDelay: time.Millisecond, maxDelay: 50 * time.Millisecond},
+		isolated:   map[int]bool{3: true},
+	}
+	run := func(adaptive bool) time.Duration {
+		sim := newSimulation(t, 0, 4, network)
+		if adaptive {
+			config := cfg.DefaultConsensusConfig()
+			config.AdaptiveTimeouts = true
+			for _, node := range sim.nodes {
+				node.cs.adaptiveTimeouts = newAdaptiveTimeouts(config)
+			}
+		}
+		sim.runUntilHeight(10, 5*time.Minute, 0, 1, 2)
+
+		if adaptive {
+			for i, node := range sim.nodes[:3] {
+				timeout, ok := node.cs.adaptiveTimeouts.Propose(0)
+				require.True(t, ok, "node %d", i)
+				require.Less(t, timeout, cfg.DefaultConsensusConfig().TimeoutPropose, "node %d", i)
+				_, ok = node.cs.adaptiveTimeouts.Vote(0)
+				require.True(t, ok, "node %d", i)
+			}
+		}
+		return sim.elapsed()
+	}
+
+	// On a fast network, the adaptive timeouts are lower than the static ones.
+	static, adaptive := run(false), run(true)
+	require.Less(t, adaptive, static)
+}
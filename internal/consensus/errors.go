@@ -63,3 +63,13 @@ func (e ErrDenyMessageOverflow) Error() string {
 func (e ErrDenyMessageOverflow) Unwrap() error {
 	return e.Err
 }
+
+// ErrTimelineNotFound is returned when the consensus timeline of a height was
+// not recorded, or is no longer retained.
+type ErrTimelineNotFound struct {
+	Height int64
+}
+
+func (e ErrTimelineNotFound) Error() string {
+	return fmt.Sprintf("no consensus timeline recorded for height %d", e.Height)
+}
@@ -206,41 +206,55 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: []float64{-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0},
 		}, append(labels, "is_timely")).With(labelsAndValues...),
+		AdaptiveTimeoutProposeSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "adaptive_timeout_propose_seconds",
+			Help:      "AdaptiveTimeoutProposeSeconds is the last timeout_propose derived from the observed proposal latencies, when adaptive timeouts are enabled.",
+		}, labels).With(labelsAndValues...),
+		AdaptiveTimeoutVoteSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "adaptive_timeout_vote_seconds",
+			Help:      "AdaptiveTimeoutVoteSeconds is the last timeout_vote derived from the observed vote latencies, when adaptive timeouts are enabled.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Height:                      discard.NewGauge(),
-		ValidatorLastSignedHeight:   discard.NewGauge(),
-		Rounds:                      discard.NewGauge(),
-		RoundDurationSeconds:        discard.NewHistogram(),
-		Validators:                  discard.NewGauge(),
-		ValidatorsPower:             discard.NewGauge(),
-		ValidatorPower:              discard.NewGauge(),
-		ValidatorMissedBlocks:       discard.NewGauge(),
-		MissingValidators:           discard.NewGauge(),
-		MissingValidatorsPower:      discard.NewGauge(),
-		ByzantineValidators:         discard.NewGauge(),
-		ByzantineValidatorsPower:    discard.NewGauge(),
-		BlockIntervalSeconds:        discard.NewHistogram(),
-		NumTxs:                      discard.NewGauge(),
-		BlockSizeBytes:              discard.NewGauge(),
-		ChainSizeBytes:              discard.NewCounter(),
-		TotalTxs:                    discard.NewGauge(),
-		CommittedHeight:             discard.NewGauge(),
-		BlockParts:                  discard.NewCounter(),
-		DuplicateBlockPart:          discard.NewCounter(),
-		DuplicateVote:               discard.NewCounter(),
-		StepDurationSeconds:         discard.NewHistogram(),
-		BlockGossipPartsReceived:    discard.NewCounter(),
-		QuorumPrevoteDelay:          discard.NewGauge(),
-		FullPrevoteDelay:            discard.NewGauge(),
-		VoteExtensionReceiveCount:   discard.NewCounter(),
-		ProposalReceiveCount:        discard.NewCounter(),
-		ProposalCreateCount:         discard.NewCounter(),
-		RoundVotingPowerPercent:     discard.NewGauge(),
-		LateVotes:                   discard.NewCounter(),
-		ProposalTimestampDifference: discard.NewHistogram(),
+		Height:                        discard.NewGauge(),
+		ValidatorLastSignedHeight:     discard.NewGauge(),
+		Rounds:                        discard.NewGauge(),
+		RoundDurationSeconds:          discard.NewHistogram(),
+		Validators:                    discard.NewGauge(),
+		ValidatorsPower:               discard.NewGauge(),
+		ValidatorPower:                discard.NewGauge(),
+		ValidatorMissedBlocks:         discard.NewGauge(),
+		MissingValidators:             discard.NewGauge(),
+		MissingValidatorsPower:        discard.NewGauge(),
+		ByzantineValidators:           discard.NewGauge(),
+		ByzantineValidatorsPower:      discard.NewGauge(),
+		BlockIntervalSeconds:          discard.NewHistogram(),
+		NumTxs:                        discard.NewGauge(),
+		BlockSizeBytes:                discard.NewGauge(),
+		ChainSizeBytes:                discard.NewCounter(),
+		TotalTxs:                      discard.NewGauge(),
+		CommittedHeight:               discard.NewGauge(),
+		BlockParts:                    discard.NewCounter(),
+		DuplicateBlockPart:            discard.NewCounter(),
+		DuplicateVote:                 discard.NewCounter(),
+		StepDurationSeconds:           discard.NewHistogram(),
+		BlockGossipPartsReceived:      discard.NewCounter(),
+		QuorumPrevoteDelay:            discard.NewGauge(),
+		FullPrevoteDelay:              discard.NewGauge(),
+		VoteExtensionReceiveCount:     discard.NewCounter(),
+		ProposalReceiveCount:          discard.NewCounter(),
+		ProposalCreateCount:           discard.NewCounter(),
+		RoundVotingPowerPercent:       discard.NewGauge(),
+		LateVotes:                     discard.NewCounter(),
+		ProposalTimestampDifference:   discard.NewHistogram(),
+		AdaptiveTimeoutProposeSeconds: discard.NewGauge(),
+		AdaptiveTimeoutVoteSeconds:    discard.NewGauge(),
 	}
 }
@@ -139,6 +139,14 @@ type Metrics struct {
 	// parameter SynchronyParams.MessageDelay, used by the PBTS algorithm.
 	// metrics:Difference in seconds between the local time when a proposal message is received and the timestamp in the proposal message.
 	ProposalTimestampDifference metrics.Histogram `metrics_bucketsizes:"-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0" metrics_labels:"is_timely"`
+
+	// AdaptiveTimeoutProposeSeconds is the last timeout_propose derived from
+	// the observed proposal latencies, when adaptive timeouts are enabled.
+	AdaptiveTimeoutProposeSeconds metrics.Gauge
+
+	// AdaptiveTimeoutVoteSeconds is the last timeout_vote derived from the
+	// observed vote latencies, when adaptive timeouts are enabled.
+	AdaptiveTimeoutVoteSeconds metrics.Gauge
 }
 
 func (m *Metrics) MarkProposalProcessed(accepted bool) {
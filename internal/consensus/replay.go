@@ -309,6 +309,7 @@ func (h *Handshaker) ReplayBlocks(
 				return nil, fmt.Errorf("unsupported public key type %s (validator name: %s)", val.PubKey.Type(), val.Name)
 			}
 			validators[i] = types.NewValidator(val.PubKey, val.Power)
+			validators[i].ProofOfPossession = val.ProofOfPossession
 		}
 		validatorSet := types.NewValidatorSet(validators)
 		nextVals := types.TM2PB.ValidatorUpdates(validatorSet)
@@ -352,6 +353,13 @@ func (h *Handshaker) ReplayBlocks(
 				state.ConsensusParams = state.ConsensusParams.Update(res.ConsensusParams)
 				state.Version.Consensus.App = state.ConsensusParams.Version.App
 			}
+			// The genesis validators must prove that they own their
+			// BLS12-381 keys if their signatures are aggregated.
+			if state.ConsensusParams.Feature.AggregatedCommitsEnableHeight > 0 {
+				if err := state.Validators.VerifyProofsOfPossession(); err != nil {
+					return nil, fmt.Errorf("genesis validators: %w", err)
+				}
+			}
 			// We update the last results hash with the empty hash, to conform with RFC-6962.
 			state.LastResultsHash = merkle.HashFromByteSlices(nil)
 			if err := h.stateStore.Save(state); err != nil {
@@ -0,0 +1,573 @@
+package consensus
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// The simulation drives a network of State instances from a single goroutine,
+// with a virtual clock, so that a run depends only on its seed. Instead of
+// running the receiveRoutine, it processes one event at a time, in the order
+// of their simulated time: the expiry of a timeout, or the delivery of a
+// proposal, block part or vote sent by another node. The messages a node
+// sends to itself are processed right away, and broadcast to the other nodes
+// through a simNetwork, which decides how long each delivery takes and whether
+// it gets lost.
+//
+// Like the gossip routines of the reactor, the simulation keeps sending a
+// message to a node until the node has it, or can no longer use it.
+
+// simGenesisTime is the genesis time of the simulated chains, and the time at
+// which every simulation starts.
+var simGenesisTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// simClock is a virtual clock, which moves forward only when the simulation
+// processes an event.
+type simClock struct {
+	now time.Time
+}
+
+func (c *simClock) Now() time.Time {
+	return c.now
+}
+
+// simTicker is a TimeoutTicker firing timeouts on the virtual clock.
+// Like timeoutTicker, a new timeout replaces the pending one, unless it is for
+// an earlier height/round/step.
+type simTicker struct {
+	sim  *simulation
+	node int
+
+	ti  timeoutInfo
+	gen uint64 // incremented on every scheduled timeout
+}
+
+var _ TimeoutTicker = (*simTicker)(nil)
+
+func (*simTicker) Start() error { return nil }
+
+func (*simTicker) Stop() error { return nil }
+
+// Chan returns nil: the simulation delivers timeouts itself.
+func (*simTicker) Chan() <-chan timeoutInfo { return nil }
+
+func (t *simTicker) ScheduleTimeout(ti timeoutInfo) {
+	if shouldSkipTick(ti, t.ti) {
+		return
+	}
+	t.ti = ti
+	t.gen++
+	ti.Duration = max(ti.Duration, 0)
+	t.sim.schedule(&simEvent{
+		at:      t.sim.clock.now.Add(ti.Duration),
+		node:    t.node,
+		kind:    simEventTimeout,
+		timeout: ti,
+		gen:     t.gen,
+	})
+}
+
+func (*simTicker) SetLogger(log.Logger) {}
+
+// simNetwork decides the fate of every attempt to deliver a message, which
+// makes it the place to model latency, loss and partitions.
+type simNetwork interface {
+	// deliver returns the delay of an attempt to deliver a message sent at
+	// elapsed simulated time, or false if the message is lost.
+	deliver(rng *rand.Rand, elapsed time.Duration, from, to int) (time.Duration, bool)
+}
+
+// simLinks delivers messages after a random delay in [minDelay, maxDelay],
+// which reorders them, and loses them with probability dropRate.
+type simLinks struct {
+	minDelay, maxDelay time.Duration
+	dropRate           float64
+}
+
+func (l simLinks) deliver(rng *rand.Rand, _ time.Duration, _, _ int) (time.Duration, bool) {
+	if rng.Float64() < l.dropRate {
+		return 0, false
+	}
+	return l.minDelay + time.Duration(rng.Int63n(int64(l.maxDelay-l.minDelay)+1)), true
+}
+
+// simPartition cuts the isolated nodes off from the others between from and
+// until (if not zero) of simulated time.
+type simPartition struct {
+	simNetwork
+	isolated    map[int]bool
+	from, until time.Duration
+}
+
+func (p simPartition) deliver(rng *rand.Rand, elapsed time.Duration, from, to int) (time.Duration, bool) {
+	if p.isolated[from] != p.isolated[to] && elapsed >= p.from && (p.until == 0 || elapsed < p.until) {
+		return 0, false
+	}
+	return p.simNetwork.deliver(rng, elapsed, from, to)
+}
+
+type simEventKind int
+
+const (
+	simEventTimeout simEventKind = iota // a timeout expires
+	simEventSend                        // an attempt to send a message
+	simEventDeliver                     // a message reaches its destination
+)
+
+type simEvent struct {
+	at   time.Time
+	seq  uint64 // orders the events scheduled at the same time
+	node int    // node the event happens at
+
+	kind    simEventKind
+	timeout timeoutInfo
+	gen     uint64 // of the ticker when the timeout was scheduled
+	msg     *simMessage
+}
+
+// simMessage is a message broadcast by a node.
+type simMessage struct {
+	from int
+	msg  Message
+	// header of the part set of a BlockPartMessage
+	partSetHeader types.PartSetHeader
+}
+
+// simEventQueue is a priority queue of events, ordered by time.
+type simEventQueue []*simEvent
+
+func (q simEventQueue) Len() int { return len(q) }
+
+func (q simEventQueue) Less(i, j int) bool {
+	if !q[i].at.Equal(q[j].at) {
+		return q[i].at.Before(q[j].at)
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q simEventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *simEventQueue) Push(x any) { *q = append(*q, x.(*simEvent)) }
+
+func (q *simEventQueue) Pop() any {
+	old := *q
+	ev := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return ev
+}
+
+type simNode struct {
+	cs     *State
+	ticker *simTicker
+	height int64 // last committed height
+}
+
+type simulation struct {
+	t          *testing.T
+	rng        *rand.Rand
+	clock      *simClock
+	network    simNetwork
+	retryDelay time.Duration
+
+	nodes []*simNode
+	queue simEventQueue
+	seq   uint64
+
+	// hash of the block committed at each height
+	commits map[int64][]byte
+	// one line per processed event, to compare runs
+	trace []string
+}
+
+// newSimulation creates a network of nValidators validators with equal voting
+// power, whose keys, like everything else in the simulation, derive from seed.
+func newSimulation(t *testing.T, seed int64, nValidators int, network simNetwork) *simulation {
+	t.Helper()
+
+	consensusConfig := cfg.DefaultConsensusConfig()
+	sim := &simulation{
+		t:          t,
+		rng:        rand.New(rand.NewSource(seed)), //nolint:gosec // reproducible on purpose
+		clock:      &simClock{now: simGenesisTime},
+		network:    network,
+		retryDelay: consensusConfig.PeerGossipSleepDuration,
+		commits:    make(map[int64][]byte),
+	}
+
+	privKeys := make([]ed25519.PrivKey, nValidators)
+	genDoc := &types.GenesisDoc{
+		GenesisTime:     simGenesisTime,
+		InitialHeight:   1,
+		ChainID:         test.DefaultTestChainID,
+		ConsensusParams: types.DefaultConsensusParams(),
+	}
+	for i := range privKeys {
+		privKeys[i] = ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("simulation %d validator %d", seed, i)))
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{
+			PubKey: privKeys[i].PubKey(),
+			Power:  10,
+		})
+	}
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	for i, privKey := range privKeys {
+		db := dbm.NewMemDB()
+		stateStore := sm.NewStore(db, sm.StoreOptions{DiscardABCIResponses: false})
+		require.NoError(t, stateStore.Save(state))
+		blockStore := store.NewBlockStore(db)
+
+		app := kvstore.NewInMemoryApplication()
+		_, lanesInfo := fetchAppInfo(app)
+		_, err := app.InitChain(context.Background(), &abci.InitChainRequest{
+			Validators: types.TM2PB.ValidatorUpdates(state.Validators),
+		})
+		require.NoError(t, err)
+		mtx := new(cmtsync.Mutex)
+		proxyAppConnCon := proxy.NewAppConnConsensus(abcicli.NewLocalClient(mtx, app), proxy.NopMetrics())
+		proxyAppConnMem := proxy.NewAppConnMempool(abcicli.NewLocalClient(mtx, app), proxy.NopMetrics())
+		mempool := mempl.NewCListMempool(cfg.TestMempoolConfig(), proxyAppConnMem, lanesInfo, state.LastBlockHeight)
+
+		evpool := sm.EmptyEvidencePool{}
+		blockExec := sm.NewBlockExecutor(stateStore, log.NewNopLogger(), proxyAppConnCon, mempool, evpool, blockStore)
+		cs := NewState(consensusConfig, state, blockExec, blockStore, mempool, evpool, StateTimeSource(sim.clock))
+		cs.SetLogger(log.NewNopLogger())
+		cs.SetPrivValidator(types.NewMockPVWithParams(privKey, false, false))
+		ticker := &simTicker{sim: sim, node: i}
+		cs.SetTimeoutTicker(ticker)
+
+		eventBus := types.NewEventBus()
+		eventBus.SetLogger(log.NewNopLogger())
+		require.NoError(t, eventBus.Start())
+		t.Cleanup(func() { _ = eventBus.Stop() })
+		cs.SetEventBus(eventBus)
+
+		sim.nodes = append(sim.nodes, &simNode{cs: cs, ticker: ticker})
+	}
+
+	for _, node := range sim.nodes {
+		rs := node.cs.GetRoundState()
+		node.cs.scheduleRound0(&rs)
+	}
+	return sim
+}
+
+func (sim *simulation) schedule(ev *simEvent) {
+	sim.seq++
+	ev.seq = sim.seq
+	heap.Push(&sim.queue, ev)
+}
+
+func (sim *simulation) elapsed() time.Duration {
+	return sim.clock.now.Sub(simGenesisTime)
+}
+
+// run processes events until done returns true, and fails the test if it
+// does not before timeout of simulated time.
+func (sim *simulation) run(timeout time.Duration, done func() bool) {
+	sim.t.Helper()
+	for !done() {
+		require.NotEmpty(sim.t, sim.queue, "no more events")
+		ev := heap.Pop(&sim.queue).(*simEvent)
+		if ev.at.Sub(simGenesisTime) > timeout {
+			require.FailNow(sim.t, "simulation timed out", "heights %v", sim.heights())
+		}
+		sim.clock.now = ev.at
+		sim.process(ev)
+	}
+}
+
+// runFor processes events for d of simulated time.
+func (sim *simulation) runFor(d time.Duration) {
+	sim.t.Helper()
+	until := sim.elapsed() + d
+	for len(sim.queue) > 0 && sim.queue[0].at.Sub(simGenesisTime) <= until {
+		ev := heap.Pop(&sim.queue).(*simEvent)
+		sim.clock.now = ev.at
+		sim.process(ev)
+	}
+	sim.clock.now = simGenesisTime.Add(until)
+}
+
+// runUntilHeight runs the simulation until the given nodes, or all of them if
+// none is given, commit height, and fails the test if it takes longer than
+// timeout of simulated time.
+func (sim *simulation) runUntilHeight(height int64, timeout time.Duration, nodes ...int) {
+	sim.t.Helper()
+	if len(nodes) == 0 {
+		for i := range sim.nodes {
+			nodes = append(nodes, i)
+		}
+	}
+	sim.run(timeout, func() bool {
+		for _, i := range nodes {
+			if sim.nodes[i].height < height {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (sim *simulation) heights() []int64 {
+	heights := make([]int64, len(sim.nodes))
+	for i, node := range sim.nodes {
+		heights[i] = node.height
+	}
+	return heights
+}
+
+func (sim *simulation) process(ev *simEvent) {
+	node := sim.nodes[ev.node]
+	cs := node.cs
+
+	switch ev.kind {
+	case simEventTimeout:
+		if ev.gen != node.ticker.gen {
+			// replaced by a later timeout
+			return
+		}
+		sim.tracef(ev.node, "timeout %v", &ev.timeout)
+		cs.handleTimeout(ev.timeout, cs.RoundState)
+
+	case simEventSend:
+		delay, ok := sim.network.deliver(sim.rng, sim.elapsed(), ev.msg.from, ev.node)
+		if !ok {
+			sim.schedule(&simEvent{at: ev.at.Add(sim.retryDelay), node: ev.node, kind: simEventSend, msg: ev.msg})
+			return
+		}
+		sim.schedule(&simEvent{at: ev.at.Add(delay), node: ev.node, kind: simEventDeliver, msg: ev.msg})
+		return
+
+	case simEventDeliver:
+		if !sim.received(cs, ev.msg) {
+			sim.tracef(ev.node, "receive %v from %d", ev.msg.msg, ev.msg.from)
+			cs.handleMsg(msgInfo{
+				Msg:         sim.copyMsg(ev.msg.msg),
+				PeerID:      p2p.ID(fmt.Sprintf("node%d", ev.msg.from)),
+				ReceiveTime: sim.clock.now,
+			})
+		}
+		if !sim.done(cs, ev.msg) {
+			sim.schedule(&simEvent{at: ev.at.Add(sim.retryDelay), node: ev.node, kind: simEventSend, msg: ev.msg})
+		}
+	}
+
+	sim.processInternalMsgs(ev.node)
+	sim.checkCommits(ev.node)
+}
+
+// processInternalMsgs processes the messages a node sent to itself, and
+// broadcasts them to the other nodes.
+func (sim *simulation) processInternalMsgs(i int) {
+	cs := sim.nodes[i].cs
+	for {
+		// the reactor would compute statistics from these
+		for len(cs.statsMsgQueue) > 0 {
+			<-cs.statsMsgQueue
+		}
+		select {
+		case mi := <-cs.internalMsgQueue:
+			// before handling the message, which may complete the block
+			// and move the node to the next height
+			m := sim.newMessage(i, mi.Msg)
+			cs.handleMsg(mi)
+			if m != nil {
+				sim.broadcast(m)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// newMessage returns the message a node broadcasts, or nil if it cannot use
+// it itself.
+func (sim *simulation) newMessage(from int, msg Message) *simMessage {
+	m := &simMessage{from: from, msg: msg}
+	if _, ok := msg.(*BlockPartMessage); ok {
+		parts := sim.nodes[from].cs.ProposalBlockParts
+		if parts == nil {
+			return nil
+		}
+		m.partSetHeader = parts.Header()
+	}
+	return m
+}
+
+func (sim *simulation) broadcast(m *simMessage) {
+	for to := range sim.nodes {
+		if to != m.from {
+			sim.schedule(&simEvent{at: sim.clock.now, node: to, kind: simEventSend, msg: m})
+		}
+	}
+}
+
+// done returns true if the node will never need the message again.
+func (sim *simulation) done(cs *State, m *simMessage) bool {
+	if msg, ok := m.msg.(*BlockPartMessage); ok {
+		// The node drops the parts of the proposal when it moves to the next
+		// round, but may need them again to commit the block.
+		return cs.Height > msg.Height
+	}
+	return sim.received(cs, m)
+}
+
+// received returns true if the node has the message, or can no longer use it.
+func (*simulation) received(cs *State, m *simMessage) bool {
+	rs := &cs.RoundState
+	switch msg := m.msg.(type) {
+	case *ProposalMessage:
+		p := msg.Proposal
+		if rs.Height != p.Height {
+			return rs.Height > p.Height
+		}
+		return rs.Round > p.Round || (rs.Round == p.Round && rs.Proposal != nil)
+
+	case *BlockPartMessage:
+		if rs.Height != msg.Height {
+			return rs.Height > msg.Height
+		}
+		return rs.ProposalBlockParts != nil &&
+			rs.ProposalBlockParts.HasHeader(m.partSetHeader) &&
+			rs.ProposalBlockParts.GetPart(int(msg.Part.Index)) != nil
+
+	case *VoteMessage:
+		vote := msg.Vote
+		if rs.Height != vote.Height {
+			return rs.Height > vote.Height
+		}
+		votes := rs.Votes.Prevotes(vote.Round)
+		if vote.Type == types.PrecommitType {
+			votes = rs.Votes.Precommits(vote.Round)
+		}
+		return votes != nil && votes.GetByIndex(vote.ValidatorIndex) != nil
+	}
+	return true
+}
+
+// copyMsg returns a copy of the message, as decoded by a peer, so that nodes
+// share no data.
+func (sim *simulation) copyMsg(msg Message) Message {
+	pb, err := MsgToWrappedProto(msg)
+	require.NoError(sim.t, err)
+	inner, err := pb.Unwrap()
+	require.NoError(sim.t, err)
+	msg, err = MsgFromProto(inner)
+	require.NoError(sim.t, err)
+	return msg
+}
+
+// checkCommits records the blocks committed by a node, and fails the test if
+// another node committed a different block at the same height.
+func (sim *simulation) checkCommits(i int) {
+	node := sim.nodes[i]
+	for node.height < node.cs.blockStore.Height() {
+		node.height++
+		hash := node.cs.blockStore.LoadBlockMeta(node.height).BlockID.Hash
+		sim.tracef(i, "commit %d %X", node.height, hash)
+		if committed, ok := sim.commits[node.height]; ok {
+			if !bytes.Equal(committed, hash) {
+				sim.t.Errorf("node %d committed block %X at height %d, but another node committed %X",
+					i, hash, node.height, committed)
+			}
+			continue
+		}
+		sim.commits[node.height] = hash
+	}
+}
+
+func (sim *simulation) tracef(node int, format string, args ...any) {
+	sim.trace = append(sim.trace, fmt.Sprintf("%v %d ", sim.elapsed(), node)+fmt.Sprintf(format, args...))
+}
+
+func TestSimulationReproducible(t *testing.T) {
+	run := func(seed int64) *simulation {
+		sim := newSimulation(t, seed, 4, simLinks{minDelay: 10 * time.Millisecond, maxDelay: time.Second, dropRate: 0.1})
+		sim.runUntilHeight(5, 5*time.Minute)
+		return sim
+	}
+
+	sim1, sim2 := run(1), run(1)
+	require.Equal(t, sim1.trace, sim2.trace)
+	require.Equal(t, sim1.commits, sim2.commits)
+
+	sim3 := run(2)
+	require.NotEqual(t, sim1.trace, sim3.trace)
+}
+
+func TestSimulationLivenessAndSafety(t *testing.T) {
+	networks := []struct {
+		name    string
+		network simNetwork
+	}{
+		{"fast", simLinks{minDelay: time.Millisecond, maxDelay: 50 * time.Millisecond}},
+		{"slow", simLinks{minDelay: 500 * time.Millisecond, maxDelay: 5 * time.Second}},
+		{"lossy", simLinks{minDelay: 10 * time.Millisecond, maxDelay: time.Second, dropRate: 0.5}},
+	}
+
+	for _, n := range networks {
+		for seed := int64(0); seed < 10; seed++ {
+			t.Run(fmt.Sprintf("%s/seed=%d", n.name, seed), func(t *testing.T) {
+				sim := newSimulation(t, seed, 4, n.network)
+				sim.runUntilHeight(5, 10*time.Minute)
+			})
+		}
+	}
+}
+
+func TestSimulationPartition(t *testing.T) {
+	links := simLinks{minDelay: 10 * time.Millisecond, maxDelay: 200 * time.Millisecond, dropRate: 0.1}
+
+	t.Run("minority", func(t *testing.T) {
+		sim := newSimulation(t, 0, 4, simPartition{
+			simNetwork: links,
+			isolated:   map[int]bool{3: true},
+			until:      time.Minute,
+		})
+
+		// The majority makes progress without the isolated node.
+		sim.runUntilHeight(3, time.Minute, 0, 1, 2)
+		require.Zero(t, sim.nodes[3].height)
+
+		// The isolated node catches up once the partition heals.
+		sim.runUntilHeight(sim.nodes[0].height+1, 5*time.Minute)
+	})
+
+	t.Run("no quorum", func(t *testing.T) {
+		sim := newSimulation(t, 0, 4, simPartition{
+			simNetwork: links,
+			isolated:   map[int]bool{2: true, 3: true},
+			until:      time.Minute,
+		})
+
+		// Neither half can commit on its own.
+		sim.runFor(time.Minute)
+		require.Empty(t, sim.commits)
+
+		sim.runUntilHeight(3, 5*time.Minute)
+	})
+}
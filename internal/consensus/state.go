@@ -120,6 +120,10 @@ type State struct {
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
 
+	// source of the current time; the system clock unless
+	// overwritten, e.g. by a simulated clock in tests
+	timeSource cmttime.Source
+
 	// some functions can be overwritten for testing
 	decideProposal func(height int64, round int32)
 	doPrevote      func(height int64, round int32)
@@ -135,6 +139,12 @@ type State struct {
 	// for reporting metrics
 	metrics *Metrics
 
+	// records when the milestones of the recent heights were reached
+	timeline *timelineRecorder
+
+	// derives the timeouts from the observed latencies, if enabled
+	adaptiveTimeouts *adaptiveTimeouts
+
 	// offline state sync height indicating to which height the node synced offline
 	offlineStateSyncHeight int64
 
@@ -171,6 +181,9 @@ func NewState(
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		timeSource:       cmttime.DefaultSource{},
+		timeline:         newTimelineRecorder(config.TimelineHeights),
+		adaptiveTimeouts: newAdaptiveTimeouts(config),
 	}
 	for _, option := range options {
 		option(cs)
@@ -220,6 +233,23 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateTimeSource sets the source of the current time, which is the system
+// clock by default.
+func StateTimeSource(source cmttime.Source) StateOption {
+	return func(cs *State) { cs.timeSource = source }
+}
+
+// StateTimelineDir sets the directory where the consensus timelines of the
+// committed heights are persisted, keeping those of the last retainHeights
+// heights, or all of them if retainHeights is 0.
+func StateTimelineDir(dir string, retainHeights int64) StateOption {
+	return func(cs *State) {
+		if cs.timeline != nil {
+			cs.timeline.dir, cs.timeline.retain = dir, retainHeights
+		}
+	}
+}
+
 // OfflineStateSyncHeight indicates the height at which the node
 // statesync offline - before booting sets the metrics.
 func OfflineStateSyncHeight(height int64) StateOption {
@@ -276,6 +306,22 @@ func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	return cmtjson.Marshal(cs.RoundState.RoundStateSimple())
 }
 
+// GetTimeline returns the consensus timeline of the given height, or of the
+// current height if height is 0.
+func (cs *State) GetTimeline(height int64) (*HeightTimeline, error) {
+	return cs.timeline.Get(height)
+}
+
+// GetTimelineJSON returns a json of the consensus timeline of the given
+// height, or of the current height if height is 0.
+func (cs *State) GetTimelineJSON(height int64) ([]byte, error) {
+	timeline, err := cs.timeline.Get(height)
+	if err != nil {
+		return nil, err
+	}
+	return cmtjson.Marshal(timeline)
+}
+
 // GetValidators returns a copy of the current validators.
 func (cs *State) GetValidators() (int64, []*types.Validator) {
 	cs.mtx.RLock()
@@ -319,6 +365,10 @@ func (cs *State) LoadCommit(height int64) *types.Commit {
 // OnStart loads the latest state via the WAL, and starts the timeout and
 // receive routines.
 func (cs *State) OnStart() error {
+	if err := cs.timeline.start(cs.Logger); err != nil {
+		return err
+	}
+
 	// We may set the WAL in testing before calling Start, so only OpenWAL if its
 	// still the nilWAL.
 	if _, ok := cs.wal.(nilWAL); ok {
@@ -443,6 +493,8 @@ func (cs *State) OnStop() {
 	if err := cs.timeoutTicker.Stop(); err != nil {
 		cs.Logger.Error("Failed trying to stop timeoutTicket", "error", err)
 	}
+
+	cs.timeline.stop()
 	// WAL is stopped in receiveRoutine.
 }
 
@@ -494,9 +546,9 @@ func (cs *State) AddVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 // SetProposal inputs a proposal.
 func (cs *State) SetProposal(proposal *types.Proposal, peerID p2p.ID) error {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, "", cmttime.Now()}
+		cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, "", cs.timeSource.Now()}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID, cmttime.Now()}
+		cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID, cs.timeSource.Now()}
 	}
 
 	// TODO: wait for event?!
@@ -560,12 +612,29 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *State) scheduleRound0(rs *cstypes.RoundState) {
 	// cs.Logger.Info("scheduleRound0", "now", cmttime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(cmttime.Now())
+	sleepDuration := rs.StartTime.Sub(cs.timeSource.Now())
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
 // Attempt to schedule a timeout (by sending timeoutInfo on the tickChan).
+// If adaptive timeouts are enabled, the timeouts of the propose, prevote wait
+// and precommit wait steps are derived from the observed latencies instead.
 func (cs *State) scheduleTimeout(duration time.Duration, height int64, round int32, step cstypes.RoundStepType) {
+	if cs.adaptiveTimeouts != nil {
+		switch step {
+		case cstypes.RoundStepPropose:
+			if timeout, ok := cs.adaptiveTimeouts.Propose(round); ok {
+				duration = timeout
+			}
+			cs.adaptiveTimeouts.ProposeStarted(height, round, cs.timeSource.Now())
+			cs.metrics.AdaptiveTimeoutProposeSeconds.Set(duration.Seconds())
+		case cstypes.RoundStepPrevoteWait, cstypes.RoundStepPrecommitWait:
+			if timeout, ok := cs.adaptiveTimeouts.Vote(round); ok {
+				duration = timeout
+			}
+			cs.metrics.AdaptiveTimeoutVoteSeconds.Set(duration.Seconds())
+		}
+	}
 	cs.timeoutTicker.ScheduleTimeout(timeoutInfo{duration, height, round, step})
 }
 
@@ -640,6 +709,13 @@ func (cs *State) votesFromSeenCommit(state sm.State) (*types.VoteSet, error) {
 		return nil, fmt.Errorf("heights don't match in votesFromSeenCommit %v!=%v",
 			commit.Height, state.LastBlockHeight)
 	}
+	if commit.IsAggregated() {
+		// The individual precommits can't be recovered from an aggregated
+		// commit. Aggregated commits require vote extensions, so the last
+		// commit is normally reconstructed from the extended commit instead.
+		return nil, fmt.Errorf("seen commit for height %v is aggregated; its precommits can't be recovered",
+			state.LastBlockHeight)
+	}
 	vs := commit.ToVoteSet(state.ChainID, state.LastValidators)
 	if !vs.HasTwoThirdsMajority() {
 		return nil, ErrCommitQuorumNotMet
@@ -735,7 +811,7 @@ func (cs *State) updateToState(state sm.State) {
 		// We add timeoutCommit to allow transactions to be gathered for
 		// the first block. An alternative solution that relies on clocks:
 		// `cs.StartTime = state.LastBlockTime.Add(timeoutCommit)`
-		cs.StartTime = cmttime.Now().Add(timeoutCommit)
+		cs.StartTime = cs.timeSource.Now().Add(timeoutCommit)
 	} else {
 		cs.StartTime = cs.CommitTime.Add(timeoutCommit)
 	}
@@ -761,6 +837,7 @@ func (cs *State) updateToState(state sm.State) {
 	cs.TriggeredTimeoutPrecommit = false
 
 	cs.state = state
+	cs.timeline.EnterHeight(height, cs.timeSource.Now())
 
 	// Finally, broadcast RoundState
 	cs.newStep()
@@ -1008,6 +1085,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 			cs.Logger.Error("Failed publishing timeout wait", "err", err)
 		}
 
+		cs.adaptiveTimeouts.VoteWaitTimedOut(ti.Height, ti.Round, types.PrevoteType, cs.timeSource.Now())
 		cs.enterPrecommit(ti.Height, ti.Round)
 
 	case cstypes.RoundStepPrecommitWait:
@@ -1015,6 +1093,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 			cs.Logger.Error("Failed publishing timeout wait", "err", err)
 		}
 
+		cs.adaptiveTimeouts.VoteWaitTimedOut(ti.Height, ti.Round, types.PrecommitType, cs.timeSource.Now())
 		cs.enterPrecommit(ti.Height, ti.Round)
 		cs.enterNewRound(ti.Height, ti.Round+1)
 
@@ -1040,7 +1119,7 @@ func (cs *State) handleTxsAvailable() {
 		}
 
 		// +1ms to ensure RoundStepNewRound timeout always happens after RoundStepNewHeight
-		timeoutCommit := cs.StartTime.Sub(cmttime.Now()) + 1*time.Millisecond
+		timeoutCommit := cs.StartTime.Sub(cs.timeSource.Now()) + 1*time.Millisecond
 		cs.scheduleTimeout(timeoutCommit, cs.Height, 0, cstypes.RoundStepNewRound)
 
 	case cstypes.RoundStepNewRound: // after timeoutCommit
@@ -1071,7 +1150,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
-	if now := cmttime.Now(); cs.StartTime.After(now) {
+	if now := cs.timeSource.Now(); cs.StartTime.After(now) {
 		logger.Debug("Need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
 
@@ -1108,6 +1187,10 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	cs.Votes.SetRound(cmtmath.SafeAddInt32(round, 1)) // also track next round (round+1) to allow round-skipping
 	cs.TriggeredTimeoutPrecommit = false
 
+	if err := cs.timeline.EnterRound(height, round, cs.timeSource.Now()); err != nil {
+		logger.Error("Failed to persist the consensus timeline of the previous height", "err", err)
+	}
+
 	if err := cs.eventBus.PublishEventNewRound(cs.NewRoundEvent()); err != nil {
 		cs.Logger.Error("Failed publishing new round", "err", err)
 	}
@@ -1163,7 +1246,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	// If this validator is the proposer of this round, and the previous block time is later than
 	// our local clock time, wait to propose until our local clock time has passed the block time.
 	if cs.isPBTSEnabled(height) && cs.privValidatorPubKey != nil && cs.isProposer(cs.privValidatorPubKey.Address()) {
-		proposerWaitTime := proposerWaitTime(cmttime.DefaultSource{}, cs.state.LastBlockTime)
+		proposerWaitTime := proposerWaitTime(cs.timeSource, cs.state.LastBlockTime)
 		if proposerWaitTime > 0 {
 			cs.scheduleTimeout(proposerWaitTime, height, round, cstypes.RoundStepNewRound)
 			return
@@ -1263,7 +1346,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 		proposal.Signature = p.Signature
 
 		// send proposal and block parts on internal msg queue
-		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, "", cmttime.Now()})
+		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, "", cs.timeSource.Now()})
 
 		for i := 0; i < int(blockParts.Total()); i++ {
 			part := blockParts.GetPart(i)
@@ -1327,7 +1410,9 @@ func (cs *State) createProposalBlock(ctx context.Context) (*types.Block, error)
 
 	proposerAddr := cs.privValidatorPubKey.Address()
 
+	start := cs.timeSource.Now()
 	ret, err := cs.blockExec.CreateProposalBlock(ctx, cs.Height, cs.state, lastExtCommit, proposerAddr)
+	cs.timeline.ABCICall(cs.Height, cs.Round, ABCICallPrepareProposal, start, cs.timeSource.Now())
 	if err != nil {
 		panic(err)
 	}
@@ -1481,7 +1566,9 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 			// the liveness properties of consensus.
 			// Please see `PrepareProosal`-`ProcessProposal` coherence and determinism properties
 			// in the ABCI++ specification.
+			start := cs.timeSource.Now()
 			isAppValid, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
+			cs.timeline.ABCICall(height, round, ABCICallProcessProposal, start, cs.timeSource.Now())
 			if err != nil {
 				panic(fmt.Sprintf(
 					"state machine returned an error (%v) when calling ProcessProposal", err,
@@ -1758,7 +1845,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = cmttime.Now()
+		cs.CommitTime = cs.timeSource.Now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -1917,6 +2004,7 @@ func (cs *State) finalizeCommit(height int64) {
 	// Execute and commit the block, update and save the state, and update the mempool.
 	// We use apply verified block here because we have verified the block in this function already.
 	// NOTE The block.AppHash won't reflect these txs until the next block.
+	start := cs.timeSource.Now()
 	stateCopy, err := cs.blockExec.ApplyVerifiedBlock(
 		stateCopy,
 		types.BlockID{
@@ -1929,6 +2017,9 @@ func (cs *State) finalizeCommit(height int64) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to apply block; error %v", err))
 	}
+	now := cs.timeSource.Now()
+	cs.timeline.ABCICall(height, cs.CommitRound, ABCICallApplyBlock, start, now)
+	cs.timeline.Committed(height, cs.CommitRound, now)
 
 	fail.Fail() // XXX
 
@@ -1978,6 +2069,10 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 			panic(fmt.Sprintf("commit size (%d) doesn't match valset length (%d) at height %d\n\n%v\n\n%v",
 				commitSize, valSetLen, block.Height, block.LastCommit.Signatures, cs.LastValidators.Validators))
 		}
+		commitSigs, err := block.LastCommit.CommitSigs(cs.LastValidators)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get the signatures of the last commit at height %d: %v", block.Height, err))
+		}
 
 		if cs.privValidator != nil {
 			if cs.privValidatorPubKey == nil {
@@ -1989,7 +2084,7 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 		}
 
 		for i, val := range cs.LastValidators.Validators {
-			commitSig := block.LastCommit.Signatures[i]
+			commitSig := commitSigs[i]
 			if commitSig.BlockIDFlag == types.BlockIDFlagAbsent {
 				missingValidators++
 				missingValidatorsPower += val.VotingPower
@@ -2084,6 +2179,7 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal, recvTime time.Time
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
 	cs.ProposalReceiveTime = recvTime
+	cs.timeline.ProposalReceived(proposal.Height, proposal.Round, recvTime)
 	cs.calculateProposalTimestampDifferenceMetric()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
@@ -2200,6 +2296,11 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 
 		cs.ProposalBlock = block
 		cs.ProposalBlockParts.Unlock()
+		cs.timeline.ProposalCompleted(height, round, cs.timeSource.Now())
+		// Our own proposals tell nothing about the network latency.
+		if cs.Proposal != nil && (cs.privValidatorPubKey == nil || !cs.isProposer(cs.privValidatorPubKey.Address())) {
+			cs.adaptiveTimeouts.ProposalCompleted(height, cs.Proposal.Round, cs.timeSource.Now())
+		}
 
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("Received complete proposal block",
@@ -2313,6 +2414,12 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		cs.metrics.MarkLateVote(vote.Type)
 	}
 
+	// Precommits are signed without a timestamp when the commit is
+	// aggregated, so that they all have the same sign bytes.
+	if vote.Type == types.PrecommitType && !vote.Timestamp.IsZero() && cs.aggregatesPrecommits(vote.Height) {
+		return added, ErrInvalidVote{Reason: "precommit has a timestamp but commits are aggregated"}
+	}
+
 	// A precommit for the previous height?
 	// These come in while we wait timeoutCommit
 	if vote.Height+1 == cs.Height && vote.Type == types.PrecommitType {
@@ -2331,6 +2438,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return added, err
 		}
 
+		cs.timeline.VoteAdded(vote, cs.LastCommit.HasTwoThirdsAny(), cs.LastCommit.HasTwoThirdsMajority(), cs.timeSource.Now())
 		cs.Logger.Debug("Added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
 		if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
 			return added, err
@@ -2388,7 +2496,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 				return false, err
 			}
 
+			start := cs.timeSource.Now()
 			err := cs.blockExec.VerifyVoteExtension(context.TODO(), vote)
+			cs.timeline.ABCICall(vote.Height, vote.Round, ABCICallVerifyVoteExtension, start, cs.timeSource.Now())
 			cs.metrics.MarkVoteExtensionReceived(err == nil)
 			if err != nil {
 				return false, err
@@ -2415,6 +2525,12 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		}
 		return added, err
 	}
+	voteSet := cs.Votes.Prevotes(vote.Round)
+	if vote.Type == types.PrecommitType {
+		voteSet = cs.Votes.Precommits(vote.Round)
+	}
+	cs.timeline.VoteAdded(vote, voteSet.HasTwoThirdsAny(), voteSet.HasTwoThirdsMajority(), cs.timeSource.Now())
+	cs.adaptiveTimeouts.VoteAdded(vote, voteSet.HasTwoThirdsAny(), voteSet.HasTwoThirdsMajority(), cs.timeSource.Now())
 	if vote.Round == cs.Round {
 		vals := cs.state.Validators
 		_, val := vals.GetByIndex(vote.ValidatorIndex)
@@ -2542,6 +2658,9 @@ func (cs *State) signVote(
 	addr := cs.privValidatorPubKey.Address()
 	valIdx, _ := cs.Validators.GetByAddress(addr)
 	timestamp := cs.voteTime(cs.Height)
+	if msgType == types.PrecommitType && cs.aggregatesPrecommits(cs.Height) {
+		timestamp = time.Time{}
+	}
 
 	vote := &types.Vote{
 		ValidatorAddress: addr,
@@ -2558,7 +2677,9 @@ func (cs *State) signVote(
 		// if the signedMessage type is for a non-nil precommit, add
 		// VoteExtension
 		if extEnabled {
+			start := cs.timeSource.Now()
 			ext, nonRpExt, err := cs.blockExec.ExtendVote(context.TODO(), vote, block, cs.state)
+			cs.timeline.ABCICall(vote.Height, vote.Round, ABCICallExtendVote, start, cs.timeSource.Now())
 			if err != nil {
 				return nil, err
 			}
@@ -2575,11 +2696,25 @@ func (cs *State) signVote(
 	return vote, err
 }
 
+// aggregatesPrecommits returns true if the precommits for the given height,
+// which must be the current or the previous height, are aggregated into the
+// commit.
+func (cs *State) aggregatesPrecommits(height int64) bool {
+	switch height {
+	case cs.Height:
+		return types.UseAggregatedCommit(cs.state.ConsensusParams.Feature, height, cs.Validators)
+	case cs.Height - 1:
+		return types.UseAggregatedCommit(cs.state.ConsensusParams.Feature, height, cs.LastValidators)
+	default:
+		return false
+	}
+}
+
 func (cs *State) voteTime(height int64) time.Time {
 	if cs.isPBTSEnabled(height) {
-		return cmttime.Now()
+		return cs.timeSource.Now()
 	}
-	now := cmttime.Now()
+	now := cs.timeSource.Now()
 	minVoteTime := now
 
 	// Minimum time increment between blocks
@@ -2667,7 +2802,19 @@ func (cs *State) checkDoubleSigningRisk(height int64) error {
 		for i := int64(1); i < doubleSignCheckHeight; i++ {
 			lastCommit := cs.blockStore.LoadSeenCommit(height - i)
 			if lastCommit != nil {
-				for sigIdx, s := range lastCommit.Signatures {
+				var sigs []types.CommitSig
+				if lastCommit.IsAggregated() {
+					vals, err := cs.blockExec.Store().LoadValidators(height - i)
+					if err != nil {
+						return err
+					}
+					if sigs, err = lastCommit.CommitSigs(vals); err != nil {
+						return err
+					}
+				} else {
+					sigs = lastCommit.Signatures
+				}
+				for sigIdx, s := range sigs {
 					if s.BlockIDFlag == types.BlockIDFlagCommit && bytes.Equal(s.ValidatorAddress, valAddr) {
 						cs.Logger.Info("Found signature from the same key", "sig", s, "idx", sigIdx, "height", height-i)
 						return ErrSignatureFoundInPastBlocks
@@ -0,0 +1,424 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/internal/tempfile"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Names of the ABCI calls recorded in the timeline.
+const (
+	ABCICallPrepareProposal     = "PrepareProposal"
+	ABCICallProcessProposal     = "ProcessProposal"
+	ABCICallExtendVote          = "ExtendVote"
+	ABCICallVerifyVoteExtension = "VerifyVoteExtension"
+	// ApplyBlock covers FinalizeBlock and Commit, along with the update of the
+	// stores and of the mempool in between.
+	ABCICallApplyBlock = "ApplyBlock"
+)
+
+// timelineFileExt is the extension of the files persisting timelines.
+const timelineFileExt = ".json"
+
+// timelineWriteBufferSize is the number of timelines which can wait to be
+// persisted before the next ones are dropped.
+const timelineWriteBufferSize = 100
+
+// HeightTimeline records when this node reached the milestones of a height.
+// Times are taken from the local clock when the events are processed by the
+// consensus state machine; the zero time means the milestone was not reached.
+type HeightTimeline struct {
+	Height int64 `json:"height"`
+	// When the node entered the height, after committing the previous one.
+	StartTime time.Time `json:"start_time"`
+	// The round in which the block was committed, or -1.
+	CommitRound int32 `json:"commit_round"`
+	// When the block was committed, once it was applied.
+	CommitTime time.Time          `json:"commit_time"`
+	Rounds     []*RoundTimeline   `json:"rounds"`
+	ABCICalls  []ABCICallTimeline `json:"abci_calls"`
+}
+
+// RoundTimeline records when this node reached the milestones of a round.
+type RoundTimeline struct {
+	Round     int32     `json:"round"`
+	StartTime time.Time `json:"start_time"`
+	// When the proposal, and all the parts of the proposed block, were received.
+	ProposalTime         time.Time `json:"proposal_time"`
+	ProposalCompleteTime time.Time `json:"proposal_complete_time"`
+	// When +2/3 prevotes (resp. precommits) for anything, and for a single
+	// block or nil, were received.
+	PrevotesAnyTime     time.Time `json:"prevotes_any_time"`
+	PrevotesMaj23Time   time.Time `json:"prevotes_maj23_time"`
+	PrecommitsAnyTime   time.Time `json:"precommits_any_time"`
+	PrecommitsMaj23Time time.Time `json:"precommits_maj23_time"`
+	// The votes of the round, in order of arrival.
+	Votes []VoteArrival `json:"votes"`
+}
+
+// VoteArrival records when a vote was added to the vote set of its round.
+type VoteArrival struct {
+	Type             types.SignedMsgType `json:"type"`
+	ValidatorIndex   int32               `json:"validator_index"`
+	ValidatorAddress crypto.Address      `json:"validator_address"`
+	Time             time.Time           `json:"time"`
+}
+
+// ABCICallTimeline records how long an ABCI call to the application took.
+type ABCICallTimeline struct {
+	Method    string        `json:"method"`
+	Round     int32         `json:"round"`
+	StartTime time.Time     `json:"start_time"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// round returns the timeline of the round, adding it if needed.
+func (ht *HeightTimeline) round(round int32) *RoundTimeline {
+	for _, rt := range ht.Rounds {
+		if rt.Round == round {
+			return rt
+		}
+	}
+	rt := &RoundTimeline{Round: round}
+	ht.Rounds = append(ht.Rounds, rt)
+	return rt
+}
+
+// copy returns a deep copy of the timeline.
+func (ht *HeightTimeline) copy() *HeightTimeline {
+	cp := *ht
+	cp.Rounds = make([]*RoundTimeline, len(ht.Rounds))
+	for i, rt := range ht.Rounds {
+		rtCopy := *rt
+		rtCopy.Votes = append([]VoteArrival(nil), rt.Votes...)
+		cp.Rounds[i] = &rtCopy
+	}
+	cp.ABCICalls = append([]ABCICallTimeline(nil), ht.ABCICalls...)
+	return &cp
+}
+
+// timelineRecorder keeps the timelines of the most recent heights in memory
+// and, if it has a directory, persists the timeline of every height once the
+// node moves on to the next one. Timelines are written to disk by a background
+// routine, so that the consensus state machine never waits for the disk.
+//
+// A nil recorder records nothing.
+type timelineRecorder struct {
+	size   int
+	dir    string
+	retain int64
+
+	mtx       cmtsync.RWMutex
+	timelines []*HeightTimeline // in increasing order of height
+
+	// the timelines waiting to be persisted by writeRoutine, or nil if they
+	// are not persisted
+	writeCh chan *HeightTimeline
+	quit    chan struct{}
+	done    chan struct{}
+	// the lowest height whose persisted timeline may not have been removed,
+	// or 0 if the directory was not pruned yet; only used by writeRoutine
+	pruneHeight int64
+}
+
+// newTimelineRecorder returns a recorder keeping the timelines of the last
+// size heights in memory, or nil if size is not positive.
+func newTimelineRecorder(size int) *timelineRecorder {
+	if size <= 0 {
+		return nil
+	}
+	return &timelineRecorder{size: size}
+}
+
+// start creates the directory where timelines are persisted, if any, and
+// starts the routine writing them.
+func (tr *timelineRecorder) start(logger log.Logger) error {
+	if tr == nil || tr.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(tr.dir, 0o700); err != nil {
+		return fmt.Errorf("unable to create consensus timeline dir: %w", err)
+	}
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+	tr.writeCh = make(chan *HeightTimeline, timelineWriteBufferSize)
+	tr.quit = make(chan struct{})
+	tr.done = make(chan struct{})
+	go tr.writeRoutine(logger, tr.writeCh, tr.quit, tr.done)
+	return nil
+}
+
+// stop stops the routine writing timelines once it persisted those already
+// queued.
+func (tr *timelineRecorder) stop() {
+	if tr == nil {
+		return
+	}
+	tr.mtx.Lock()
+	quit, done := tr.quit, tr.done
+	tr.writeCh, tr.quit, tr.done = nil, nil, nil
+	tr.mtx.Unlock()
+	if quit == nil {
+		return
+	}
+	close(quit)
+	<-done
+}
+
+// writeRoutine persists the timelines received on writeCh until quit is
+// closed.
+func (tr *timelineRecorder) writeRoutine(logger log.Logger, writeCh <-chan *HeightTimeline, quit <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	write := func(ht *HeightTimeline) {
+		if err := tr.save(ht); err != nil {
+			logger.Error("Failed to persist the consensus timeline", "height", ht.Height, "err", err)
+		}
+	}
+	for {
+		select {
+		case ht := <-writeCh:
+			write(ht)
+		case <-quit:
+			for {
+				select {
+				case ht := <-writeCh:
+					write(ht)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Get returns a copy of the timeline of the height, loading it from disk if
+// it is no longer in memory. If height is 0, it returns the timeline of the
+// latest height.
+func (tr *timelineRecorder) Get(height int64) (*HeightTimeline, error) {
+	if tr == nil {
+		return nil, ErrTimelineNotFound{Height: height}
+	}
+	tr.mtx.RLock()
+	defer tr.mtx.RUnlock()
+
+	if height == 0 && len(tr.timelines) > 0 {
+		return tr.timelines[len(tr.timelines)-1].copy(), nil
+	}
+	if ht := tr.find(height); ht != nil {
+		return ht.copy(), nil
+	}
+	if tr.dir == "" || height <= 0 {
+		return nil, ErrTimelineNotFound{Height: height}
+	}
+	bz, err := os.ReadFile(tr.path(height))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrTimelineNotFound{Height: height}
+	} else if err != nil {
+		return nil, err
+	}
+	ht := new(HeightTimeline)
+	if err := cmtjson.Unmarshal(bz, ht); err != nil {
+		return nil, fmt.Errorf("invalid consensus timeline of height %d: %w", height, err)
+	}
+	return ht, nil
+}
+
+// EnterHeight starts the timeline of a new height, evicting the oldest
+// timeline from memory if needed.
+func (tr *timelineRecorder) EnterHeight(height int64, now time.Time) {
+	if tr == nil {
+		return
+	}
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+
+	if n := len(tr.timelines); n > 0 && tr.timelines[n-1].Height >= height {
+		return
+	}
+	tr.timelines = append(tr.timelines, &HeightTimeline{
+		Height:      height,
+		StartTime:   now,
+		CommitRound: -1,
+	})
+	if len(tr.timelines) > tr.size {
+		tr.timelines[0] = nil
+		tr.timelines = tr.timelines[1:]
+	}
+}
+
+// EnterRound records the start of a round. Entering the first round of a
+// height queues the timeline of the previous height, which may have collected
+// late precommits since it was committed, to be persisted. It returns an error
+// if the timeline was dropped because too many timelines are already queued.
+func (tr *timelineRecorder) EnterRound(height int64, round int32, now time.Time) error {
+	if tr == nil {
+		return nil
+	}
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+
+	if ht := tr.find(height); ht != nil {
+		if rt := ht.round(round); rt.StartTime.IsZero() {
+			rt.StartTime = now
+		}
+	}
+	if round != 0 || tr.writeCh == nil {
+		return nil
+	}
+	if prev := tr.find(height - 1); prev != nil && prev.CommitRound >= 0 {
+		select {
+		case tr.writeCh <- prev.copy():
+		default:
+			return fmt.Errorf("dropped the consensus timeline of height %d: %d timelines are waiting to be persisted",
+				prev.Height, timelineWriteBufferSize)
+		}
+	}
+	return nil
+}
+
+// ProposalReceived records the receipt of the proposal of a round.
+func (tr *timelineRecorder) ProposalReceived(height int64, round int32, recvTime time.Time) {
+	tr.update(height, func(ht *HeightTimeline) {
+		ht.round(round).ProposalTime = recvTime
+	})
+}
+
+// ProposalCompleted records the receipt of the last part of a proposed block.
+func (tr *timelineRecorder) ProposalCompleted(height int64, round int32, now time.Time) {
+	tr.update(height, func(ht *HeightTimeline) {
+		if rt := ht.round(round); rt.ProposalCompleteTime.IsZero() {
+			rt.ProposalCompleteTime = now
+		}
+	})
+}
+
+// VoteAdded records the arrival of a vote, and whether its vote set has +2/3
+// votes for anything and for a single block or nil now that it was added.
+func (tr *timelineRecorder) VoteAdded(vote *types.Vote, hasTwoThirdsAny, hasTwoThirdsMajority bool, now time.Time) {
+	tr.update(vote.Height, func(ht *HeightTimeline) {
+		rt := ht.round(vote.Round)
+		rt.Votes = append(rt.Votes, VoteArrival{
+			Type:             vote.Type,
+			ValidatorIndex:   vote.ValidatorIndex,
+			ValidatorAddress: vote.ValidatorAddress,
+			Time:             now,
+		})
+		anyTime, maj23Time := &rt.PrevotesAnyTime, &rt.PrevotesMaj23Time
+		if vote.Type == types.PrecommitType {
+			anyTime, maj23Time = &rt.PrecommitsAnyTime, &rt.PrecommitsMaj23Time
+		}
+		if hasTwoThirdsAny && anyTime.IsZero() {
+			*anyTime = now
+		}
+		if hasTwoThirdsMajority && maj23Time.IsZero() {
+			*maj23Time = now
+		}
+	})
+}
+
+// ABCICall records the duration of an ABCI call made in a round.
+func (tr *timelineRecorder) ABCICall(height int64, round int32, method string, start, end time.Time) {
+	tr.update(height, func(ht *HeightTimeline) {
+		ht.ABCICalls = append(ht.ABCICalls, ABCICallTimeline{
+			Method:    method,
+			Round:     round,
+			StartTime: start,
+			Duration:  end.Sub(start),
+		})
+	})
+}
+
+// Committed records the commit of the block of a height.
+func (tr *timelineRecorder) Committed(height int64, round int32, now time.Time) {
+	tr.update(height, func(ht *HeightTimeline) {
+		ht.CommitRound = round
+		ht.CommitTime = now
+	})
+}
+
+// update applies fn to the timeline of the height, if it is in memory.
+func (tr *timelineRecorder) update(height int64, fn func(*HeightTimeline)) {
+	if tr == nil {
+		return
+	}
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+
+	if ht := tr.find(height); ht != nil {
+		fn(ht)
+	}
+}
+
+// find returns the timeline of the height if it is in memory, or nil. The
+// caller must hold the mutex lock.
+func (tr *timelineRecorder) find(height int64) *HeightTimeline {
+	for i := len(tr.timelines) - 1; i >= 0; i-- {
+		if ht := tr.timelines[i]; ht.Height == height {
+			return ht
+		} else if ht.Height < height {
+			break
+		}
+	}
+	return nil
+}
+
+// path returns the path of the file persisting the timeline of the height.
+func (tr *timelineRecorder) path(height int64) string {
+	return filepath.Join(tr.dir, strconv.FormatInt(height, 10)+timelineFileExt)
+}
+
+// save writes the timeline to disk, and removes the timelines which are no
+// longer retained. It is only called by writeRoutine.
+func (tr *timelineRecorder) save(ht *HeightTimeline) error {
+	bz, err := cmtjson.Marshal(ht)
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(tr.path(ht.Height), bz, 0o600); err != nil {
+		return err
+	}
+	if tr.retain <= 0 {
+		return nil
+	}
+	retainHeight := ht.Height - tr.retain + 1
+	if tr.pruneHeight > 0 {
+		for height := tr.pruneHeight; height < retainHeight; height++ {
+			err := os.Remove(tr.path(height))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+		tr.pruneHeight = max(tr.pruneHeight, retainHeight)
+		return nil
+	}
+	entries, err := os.ReadDir(tr.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, timelineFileExt) {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(name, timelineFileExt), 10, 64)
+		if err != nil || height >= retainHeight {
+			continue
+		}
+		if err := os.Remove(filepath.Join(tr.dir, name)); err != nil {
+			return err
+		}
+	}
+	tr.pruneHeight = max(retainHeight, 1)
+	return nil
+}
@@ -0,0 +1,156 @@
+package consensus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestTimelineRecorded(t *testing.T) {
+	sim := newSimulation(t, 0, 4, simLinks{minDelay: time.Millisecond, maxDelay: 50 * time.Millisecond})
+	// The timeline is disabled by default.
+	for _, node := range sim.nodes {
+		node.cs.timeline = newTimelineRecorder(10)
+	}
+	sim.runUntilHeight(3, time.Minute)
+
+	prepared := 0
+	for i, node := range sim.nodes {
+		timeline, err := node.cs.GetTimeline(2)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, timeline.Height)
+		require.GreaterOrEqual(t, timeline.CommitRound, int32(0), "node %d", i)
+		require.Len(t, timeline.Rounds, int(timeline.CommitRound)+1, "node %d", i)
+
+		rt := timeline.Rounds[timeline.CommitRound]
+		milestones := []time.Time{
+			timeline.StartTime,
+			rt.StartTime,
+			rt.ProposalTime,
+			rt.ProposalCompleteTime,
+			rt.PrevotesMaj23Time,
+			rt.PrecommitsMaj23Time,
+			timeline.CommitTime,
+		}
+		for j, milestone := range milestones {
+			require.False(t, milestone.IsZero(), "node %d, milestone %d", i, j)
+			if j > 0 {
+				require.False(t, milestone.Before(milestones[j-1]), "node %d, milestone %d", i, j)
+			}
+		}
+		require.False(t, rt.PrevotesAnyTime.After(rt.PrevotesMaj23Time))
+		require.False(t, rt.PrecommitsAnyTime.After(rt.PrecommitsMaj23Time))
+
+		// At least +2/3 of the validators prevoted and precommitted; the
+		// other votes may arrive after the node moved on.
+		votes := map[types.SignedMsgType]int{}
+		for _, vote := range rt.Votes {
+			votes[vote.Type]++
+		}
+		assert.GreaterOrEqual(t, votes[types.PrevoteType], 3, "node %d", i)
+		assert.GreaterOrEqual(t, votes[types.PrecommitType], 3, "node %d", i)
+
+		calls := map[string]int{}
+		for _, call := range timeline.ABCICalls {
+			calls[call.Method]++
+		}
+		assert.Equal(t, 1, calls[ABCICallProcessProposal], "node %d", i)
+		assert.Equal(t, 1, calls[ABCICallApplyBlock], "node %d", i)
+		prepared += calls[ABCICallPrepareProposal]
+	}
+	// The proposer of each round prepared its proposal.
+	require.Positive(t, prepared)
+
+	// The current height is returned by default.
+	timeline, err := sim.nodes[0].cs.GetTimeline(0)
+	require.NoError(t, err)
+	require.Equal(t, sim.nodes[0].cs.GetRoundState().Height, timeline.Height)
+
+	_, err = sim.nodes[0].cs.GetTimeline(100)
+	require.ErrorAs(t, err, &ErrTimelineNotFound{})
+}
+
+func TestTimelineRecorderPersistence(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "timeline")
+	tr := newTimelineRecorder(2)
+	tr.dir, tr.retain = dir, 3
+	require.NoError(t, tr.start(log.NewNopLogger()))
+
+	now := time.Now().Round(0).UTC()
+	for height := int64(1); height <= 6; height++ {
+		tr.EnterHeight(height, now)
+		require.NoError(t, tr.EnterRound(height, 0, now))
+		if height < 6 {
+			tr.Committed(height, 0, now.Add(time.Second))
+		}
+	}
+	// Stopping the recorder persists the queued timelines.
+	tr.stop()
+
+	// Only the last two heights are in memory, and the timelines of the
+	// heights committed before them are persisted.
+	require.Len(t, tr.timelines, 2)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.ElementsMatch(t, []string{"3.json", "4.json", "5.json"}, names)
+
+	timeline, err := tr.Get(3)
+	require.NoError(t, err)
+	require.Equal(t, &HeightTimeline{
+		Height:      3,
+		StartTime:   now,
+		CommitRound: 0,
+		CommitTime:  now.Add(time.Second),
+		Rounds:      []*RoundTimeline{{Round: 0, StartTime: now}},
+	}, timeline)
+
+	_, err = tr.Get(2)
+	require.ErrorAs(t, err, &ErrTimelineNotFound{})
+
+	timeline, err = tr.Get(0)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, timeline.Height)
+	require.EqualValues(t, -1, timeline.CommitRound)
+
+	// The recorder returns copies.
+	timeline.Rounds[0].StartTime = time.Time{}
+	timeline, err = tr.Get(6)
+	require.NoError(t, err)
+	require.Equal(t, now, timeline.Rounds[0].StartTime)
+
+	// A disabled recorder records nothing.
+	tr = newTimelineRecorder(0)
+	tr.EnterHeight(1, now)
+	_, err = tr.Get(1)
+	require.ErrorAs(t, err, &ErrTimelineNotFound{})
+}
+
+func TestTimelineRecorderDropsWhenLagging(t *testing.T) {
+	tr := newTimelineRecorder(timelineWriteBufferSize + 2)
+	tr.dir = t.TempDir()
+	// Queue the timelines without a routine writing them.
+	tr.writeCh = make(chan *HeightTimeline, timelineWriteBufferSize)
+
+	now := time.Now()
+	for height := int64(1); height <= timelineWriteBufferSize+1; height++ {
+		tr.EnterHeight(height, now)
+		require.NoError(t, tr.EnterRound(height, 0, now))
+		tr.Committed(height, 0, now)
+	}
+	// The state machine doesn't wait for the queue to drain.
+	height := int64(timelineWriteBufferSize + 2)
+	tr.EnterHeight(height, now)
+	require.Error(t, tr.EnterRound(height, 0, now))
+	require.Len(t, tr.writeCh, timelineWriteBufferSize)
+}
@@ -2,7 +2,9 @@ package evidence
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +16,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	"github.com/cometbft/cometbft/internal/clist"
+	"github.com/cometbft/cometbft/internal/keymigrate"
 	"github.com/cometbft/cometbft/libs/log"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
@@ -709,3 +712,43 @@ const (
 	baseKeyCommitted = byte(0x00)
 	baseKeyPending   = byte(0x01)
 )
+
+// MigrateKeyLayoutToV2 rewrites the keys of an evidence database from the v1 to the
+// v2 layout. It returns the number of keys rewritten, and reports the
+// progress to progress if it is not nil. The database must not be in use while
+// it is migrated; an interrupted migration is resumed by calling
+// MigrateKeyLayoutToV2 again.
+func MigrateKeyLayoutToV2(db dbm.DB, progress func(migrated int64)) (int64, error) {
+	return keymigrate.MigrateV1ToV2(db, v1KeyToV2, keymigrate.WithProgress(progress))
+}
+
+// v1KeyToV2 translates a key of the v1 layout, of the form
+// <base key><big endian padded hex height>/<hex hash>, to the v2 layout.
+func v1KeyToV2(key []byte) ([]byte, bool, error) {
+	const heightLen = 16
+	if len(key) < heightLen+2 || key[heightLen+1] != '/' {
+		return nil, false, nil
+	}
+
+	var prefix int64
+	switch key[0] {
+	case baseKeyCommitted:
+		prefix = prefixCommitted
+	case baseKeyPending:
+		prefix = prefixPending
+	default:
+		return nil, false, nil
+	}
+
+	height, err := strconv.ParseInt(string(key[1:heightLen+1]), 16, 64)
+	if err != nil {
+		return nil, false, err
+	}
+	hash, err := hex.DecodeString(string(key[heightLen+2:]))
+	if err != nil {
+		return nil, false, err
+	}
+
+	newKey, err := orderedcode.Append(nil, prefix, height, string(hash))
+	return newKey, err == nil, err
+}
@@ -369,6 +369,52 @@ func TestRecoverPendingEvidence(t *testing.T) {
 	require.Equal(t, goodEvidence, next.Value.(types.Evidence))
 }
 
+func TestMigrateKeyLayoutToV2(t *testing.T) {
+	height := int64(10)
+	val := types.NewMockPV()
+	valAddress := val.PrivKey.PubKey().Address()
+	evidenceDB := dbm.NewMemDB()
+	stateStore := initializeValidatorState(val, height)
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	blockStore, err := initializeBlockStore(dbm.NewMemDB(), state, valAddress)
+	require.NoError(t, err)
+	pool, err := evidence.NewPool(evidenceDB, stateStore, blockStore, evidence.WithDBKeyLayout("v1"))
+	require.NoError(t, err)
+
+	pendingEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(height-1,
+		defaultEvidenceTime.Add(9*time.Minute), val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(pendingEv))
+	committedEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(height,
+		defaultEvidenceTime.Add(10*time.Minute), val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.CheckEvidence(types.EvidenceList{committedEv}))
+	state.LastBlockHeight = height + 1
+	state.LastBlockTime = defaultEvidenceTime.Add(11 * time.Minute)
+	pool.Update(state, types.EvidenceList{committedEv})
+
+	migrated, err := evidence.MigrateKeyLayoutToV2(evidenceDB, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, migrated)
+
+	// The recorded layout takes precedence over the requested one.
+	newPool, err := evidence.NewPool(evidenceDB, stateStore, blockStore, evidence.WithDBKeyLayout("v1"))
+	require.NoError(t, err)
+	evList, _ := newPool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Equal(t, []types.Evidence{pendingEv}, evList)
+
+	err = newPool.CheckEvidence(types.EvidenceList{committedEv})
+	if assert.Error(t, err) { //nolint:testifylint // require.Error doesn't work with the conditional here
+		assert.Equal(t, evidence.ErrEvidenceAlreadyCommitted.Error(), err.(*types.ErrInvalidEvidence).Reason.Error())
+	}
+
+	// Migrating again does nothing.
+	migrated, err = evidence.MigrateKeyLayoutToV2(evidenceDB, nil)
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+}
+
 func initializeStateFromValidatorSet(valSet *types.ValidatorSet, height int64) sm.Store {
 	stateDB := dbm.NewMemDB()
 	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
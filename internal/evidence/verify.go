@@ -118,7 +118,13 @@ func VerifyLightClientAttack(
 	// In the case of lunatic attack there will be a different commonHeader height. Therefore the node perform a single
 	// verification jump between the common header and the conflicting one
 	if commonHeader.Height != e.ConflictingBlock.Height {
-		err := commonVals.VerifyCommitLightTrustingAllSignatures(trustedHeader.ChainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		var err error
+		if e.ConflictingBlock.Commit.IsAggregated() {
+			err = types.VerifyAggregatedCommitLightTrusting(trustedHeader.ChainID, commonVals,
+				e.ConflictingBlock.ValidatorSet, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		} else {
+			err = commonVals.VerifyCommitLightTrustingAllSignatures(trustedHeader.ChainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		}
 		if err != nil {
 			return ErrConflictingBlock{fmt.Errorf("skipping verification of conflicting block failed: %w", err)}
 		}
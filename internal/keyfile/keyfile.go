@@ -0,0 +1,162 @@
+// Package keyfile reads the key files of a node, like priv_validator_key.json
+// and node_key.json, which may be encrypted with a passphrase.
+//
+// An encrypted key file is the ASCII armored encryption of the JSON key file
+// (see armor.EncryptArmor). Its passphrase is read, in order of precedence:
+//   - from the CMT_KEY_PASSPHRASE environment variable;
+//   - from the file named by the CMT_KEY_PASSPHRASE_FILE environment variable;
+//   - from the terminal, if the standard input is a terminal.
+package keyfile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/cometbft/cometbft/crypto/armor"
+	"github.com/cometbft/cometbft/internal/tempfile"
+)
+
+const (
+	// PassphraseEnvVar is the environment variable holding the passphrase of
+	// the encrypted key files.
+	PassphraseEnvVar = "CMT_KEY_PASSPHRASE"
+	// PassphraseFileEnvVar is the environment variable holding the path to a
+	// file containing the passphrase of the encrypted key files.
+	PassphraseFileEnvVar = "CMT_KEY_PASSPHRASE_FILE"
+)
+
+var (
+	// ErrNoPassphrase is returned when a passphrase is needed, but none is
+	// set and the standard input is not a terminal.
+	ErrNoPassphrase = fmt.Errorf("no passphrase: set %s or %s", PassphraseEnvVar, PassphraseFileEnvVar)
+	// ErrEmptyPassphrase is returned when the passphrase is empty.
+	ErrEmptyPassphrase = errors.New("passphrase is empty")
+	// ErrPassphraseMismatch is returned when the confirmation of a new
+	// passphrase does not match.
+	ErrPassphraseMismatch = errors.New("passphrases do not match")
+)
+
+// Passphrase returns the passphrase of the encrypted key files, prompting for
+// it on the terminal with the given prompt if it is not set in the
+// environment.
+func Passphrase(prompt string) (string, error) {
+	return readPassphrase(prompt, false)
+}
+
+// NewPassphrase is like Passphrase, but the passphrase is entered twice when
+// prompted for, as it is used to encrypt a key file.
+func NewPassphrase(prompt string) (string, error) {
+	return readPassphrase(prompt, true)
+}
+
+// PassphraseFromFile reads a passphrase from the given file, ignoring the
+// trailing newline.
+func PassphraseFromFile(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading passphrase file: %w", err)
+	}
+	passphrase := strings.TrimRight(string(bz), "\r\n")
+	if passphrase == "" {
+		return "", ErrEmptyPassphrase
+	}
+	return passphrase, nil
+}
+
+func readPassphrase(prompt string, confirm bool) (string, error) {
+	if passphrase, ok := os.LookupEnv(PassphraseEnvVar); ok {
+		if passphrase == "" {
+			return "", ErrEmptyPassphrase
+		}
+		return passphrase, nil
+	}
+	if path := os.Getenv(PassphraseFileEnvVar); path != "" {
+		return PassphraseFromFile(path)
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", ErrNoPassphrase
+	}
+	passphrase, err := readPassword(fd, prompt)
+	if err != nil {
+		return "", err
+	}
+	if confirm {
+		again, err := readPassword(fd, "Repeat passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if again != passphrase {
+			return "", ErrPassphraseMismatch
+		}
+	}
+	return passphrase, nil
+}
+
+func readPassword(fd int, prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	bz, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("reading passphrase: %w", err)
+	}
+	if len(bz) == 0 {
+		return "", ErrEmptyPassphrase
+	}
+	return string(bz), nil
+}
+
+// Read reads the key file at path. If it is encrypted, it is decrypted with
+// the passphrase (see Passphrase), after checking that it is a block of the
+// given type. Hence, the returned bytes are always the JSON key file. The
+// passphrase is returned as well, so that the key file can be saved again
+// with the same passphrase; it is empty if the key file is not encrypted.
+func Read(path, blockType string) (jsonBytes []byte, passphrase string, err error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if !armor.IsArmored(bz) {
+		return bz, "", nil
+	}
+
+	passphrase, err = Passphrase(fmt.Sprintf("Passphrase for %s: ", path))
+	if err != nil {
+		return nil, "", err
+	}
+	jsonBytes, err = Decrypt(bz, blockType, passphrase)
+	if err != nil {
+		return nil, "", err
+	}
+	return jsonBytes, passphrase, nil
+}
+
+// Decrypt decrypts an encrypted key file of the given type.
+func Decrypt(bz []byte, blockType, passphrase string) ([]byte, error) {
+	typ, data, err := armor.DecryptArmor(string(bz), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if typ != blockType {
+		return nil, fmt.Errorf("expected a %q key file, got %q", blockType, typ)
+	}
+	return data, nil
+}
+
+// Write writes the JSON key file to path, atomically and readable only by
+// the owner. If passphrase is not empty, the key file is encrypted with it.
+func Write(path string, jsonBytes []byte, blockType, passphrase string) error {
+	if passphrase != "" {
+		armored, err := armor.EncryptArmor(blockType, jsonBytes, passphrase)
+		if err != nil {
+			return err
+		}
+		jsonBytes = []byte(armored)
+	}
+	return tempfile.WriteFileAtomic(path, jsonBytes, 0o600)
+}
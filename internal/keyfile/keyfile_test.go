@@ -0,0 +1,51 @@
+package keyfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassphrase(t *testing.T) {
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("from file\n"), 0o600))
+
+	t.Setenv(PassphraseFileEnvVar, passphraseFile)
+	passphrase, err := Passphrase("")
+	require.NoError(t, err)
+	assert.Equal(t, "from file", passphrase)
+
+	// The environment variable takes precedence over the file.
+	t.Setenv(PassphraseEnvVar, "from env")
+	passphrase, err = NewPassphrase("")
+	require.NoError(t, err)
+	assert.Equal(t, "from env", passphrase)
+
+	t.Setenv(PassphraseEnvVar, "")
+	_, err = Passphrase("")
+	require.ErrorIs(t, err, ErrEmptyPassphrase)
+}
+
+func TestReadWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.json")
+	jsonBytes := []byte(`{"priv_key":"secret"}`)
+
+	require.NoError(t, Write(path, jsonBytes, "TEST KEY", ""))
+	bz, passphrase, err := Read(path, "TEST KEY")
+	require.NoError(t, err)
+	assert.Equal(t, jsonBytes, bz)
+	assert.Empty(t, passphrase)
+
+	require.NoError(t, Write(path, jsonBytes, "TEST KEY", "passphrase"))
+	t.Setenv(PassphraseEnvVar, "passphrase")
+	bz, passphrase, err = Read(path, "TEST KEY")
+	require.NoError(t, err)
+	assert.Equal(t, jsonBytes, bz)
+	assert.Equal(t, "passphrase", passphrase)
+
+	_, _, err = Read(path, "OTHER KEY")
+	require.Error(t, err)
+}
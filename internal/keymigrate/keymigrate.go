@@ -0,0 +1,196 @@
+// Package keymigrate rewrites the keys of a database from one key layout to
+// another, such as the v1 and v2 layouts of the CometBFT stores.
+package keymigrate
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// DefaultBatchSize is the number of keys rewritten per batch by the store
+// migrations.
+const DefaultBatchSize = 10000
+
+// VersionKey is the key under which the stores record their key layout version.
+var VersionKey = []byte("version")
+
+// TranslateFunc returns the key to use in the new layout for the given key.
+// It returns ok == false for keys that must be left untouched, including keys
+// that are already in the new layout.
+type TranslateFunc func(key []byte) (newKey []byte, ok bool, err error)
+
+// ProgressFunc is called with the number of keys rewritten so far after
+// every batch.
+type ProgressFunc func(migrated int64)
+
+// FinalizeFunc adds to batch the writes completing a migration, such as
+// recomputed metadata, once all the keys were rewritten. The batch also
+// records the new version.
+type FinalizeFunc func(db dbm.DB, batch dbm.Batch) error
+
+// Option customizes MigrateV1ToV2.
+type Option func(*migration)
+
+type migration struct {
+	progress ProgressFunc
+	finalize FinalizeFunc
+}
+
+// WithProgress reports the progress of the migration to fn.
+func WithProgress(fn ProgressFunc) Option {
+	return func(m *migration) { m.progress = fn }
+}
+
+// WithFinalize completes the migration with fn before the new version is
+// recorded.
+func WithFinalize(fn FinalizeFunc) Option {
+	return func(m *migration) { m.finalize = fn }
+}
+
+// Migrate rewrites every key of db accepted by translate to its new form,
+// deleting the original key in the same batch. It returns the number of keys
+// rewritten.
+//
+// Because already rewritten keys are not accepted by translate, an interrupted
+// migration is resumed by calling Migrate again.
+//
+// Keys are read in chunks of batchSize and the iterator is closed before
+// writing, so that backends which do not support writes during iteration can
+// be migrated.
+func Migrate(db dbm.DB, translate TranslateFunc, batchSize int) (int64, error) {
+	return migrate(db, translate, batchSize, nil)
+}
+
+func migrate(db dbm.DB, translate TranslateFunc, batchSize int, progress ProgressFunc) (int64, error) {
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("invalid batch size %d", batchSize)
+	}
+
+	var (
+		migrated int64
+		start    []byte
+	)
+	for {
+		keys, newKeys, values, next, err := readChunk(db, start, translate, batchSize)
+		if err != nil {
+			return migrated, err
+		}
+
+		if len(keys) > 0 {
+			if err := writeChunk(db, keys, newKeys, values); err != nil {
+				return migrated, err
+			}
+			migrated += int64(len(keys))
+			if progress != nil {
+				progress(migrated)
+			}
+		}
+
+		if next == nil {
+			return migrated, nil
+		}
+		start = next
+	}
+}
+
+// readChunk reads up to batchSize keys to rewrite, starting at start. It
+// returns the key to continue from, or nil once the end of db was reached.
+func readChunk(db dbm.DB, start []byte, translate TranslateFunc, batchSize int) (
+	keys, newKeys, values [][]byte, next []byte, err error,
+) {
+	it, err := db.Iterator(start, nil)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(keys) == batchSize {
+			return keys, newKeys, values, append([]byte{}, key...), nil
+		}
+
+		newKey, ok, err := translate(key)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("failed to translate key %X: %w", key, err)
+		}
+		if !ok || bytes.Equal(newKey, key) {
+			continue
+		}
+
+		// Iterator keys and values may be reused on the next call to Next.
+		keys = append(keys, append([]byte{}, key...))
+		newKeys = append(newKeys, newKey)
+		values = append(values, append([]byte{}, it.Value()...))
+	}
+	return keys, newKeys, values, nil, it.Error()
+}
+
+func writeChunk(db dbm.DB, keys, newKeys, values [][]byte) error {
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for i := range keys {
+		if err := batch.Set(newKeys[i], values[i]); err != nil {
+			return err
+		}
+		if err := batch.Delete(keys[i]); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// Version returns the key layout version recorded in db, or "v1" for a
+// database created before versions were recorded.
+func Version(db dbm.DB) (string, error) {
+	version, err := db.Get(VersionKey)
+	if err != nil {
+		return "", err
+	}
+	if len(version) == 0 {
+		return "v1", nil
+	}
+	return string(version), nil
+}
+
+// MigrateV1ToV2 migrates db from the v1 to the v2 key layout using translate,
+// and records the new version once all keys were rewritten. It does nothing
+// if db already uses the v2 layout.
+func MigrateV1ToV2(db dbm.DB, translate TranslateFunc, opts ...Option) (int64, error) {
+	var m migration
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	version, err := Version(db)
+	if err != nil {
+		return 0, err
+	}
+	switch version {
+	case "v2":
+		return 0, nil
+	case "v1":
+	default:
+		return 0, fmt.Errorf("unknown key layout version %q", version)
+	}
+
+	migrated, err := migrate(db, translate, DefaultBatchSize, m.progress)
+	if err != nil {
+		return migrated, err
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	if m.finalize != nil {
+		if err := m.finalize(db, batch); err != nil {
+			return migrated, err
+		}
+	}
+	if err := batch.Set(VersionKey, []byte("v2")); err != nil {
+		return migrated, err
+	}
+	return migrated, batch.WriteSync()
+}
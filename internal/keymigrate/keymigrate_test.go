@@ -0,0 +1,117 @@
+package keymigrate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+func TestMigrate(t *testing.T) {
+	db := dbm.NewMemDB()
+	keys := []string{"old:1", "old:2", "old:3", "old:4", "old:5", "other"}
+	for _, key := range keys {
+		require.NoError(t, db.Set([]byte(key), []byte("value-"+key)))
+	}
+
+	translate := func(key []byte) ([]byte, bool, error) {
+		rest, found := strings.CutPrefix(string(key), "old:")
+		if !found {
+			return nil, false, nil
+		}
+		return []byte("new:" + rest), true, nil
+	}
+
+	migrated, err := Migrate(db, translate, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, migrated)
+
+	for _, key := range keys[:5] {
+		has, err := db.Has([]byte(key))
+		require.NoError(t, err)
+		require.False(t, has, key)
+
+		value, err := db.Get([]byte(strings.Replace(key, "old:", "new:", 1)))
+		require.NoError(t, err)
+		require.Equal(t, "value-"+key, string(value))
+	}
+	value, err := db.Get([]byte("other"))
+	require.NoError(t, err)
+	require.Equal(t, "value-other", string(value))
+
+	// Migrating again does nothing.
+	migrated, err = Migrate(db, translate, 2)
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+
+	_, err = Migrate(db, translate, 0)
+	require.Error(t, err)
+}
+
+func TestMigrateV1ToV2(t *testing.T) {
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("a"), []byte("1")))
+	translate := func(key []byte) ([]byte, bool, error) {
+		if string(key) != "a" {
+			return nil, false, nil
+		}
+		return []byte("b"), true, nil
+	}
+
+	version, err := Version(db)
+	require.NoError(t, err)
+	require.Equal(t, "v1", version)
+
+	migrated, err := MigrateV1ToV2(db, translate)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, migrated)
+
+	version, err = Version(db)
+	require.NoError(t, err)
+	require.Equal(t, "v2", version)
+
+	// Databases already using v2 are left untouched.
+	require.NoError(t, db.Set([]byte("a"), []byte("2")))
+	migrated, err = MigrateV1ToV2(db, translate)
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+
+	require.NoError(t, db.Set(VersionKey, []byte("v3")))
+	_, err = MigrateV1ToV2(db, translate)
+	require.Error(t, err)
+}
+
+func TestMigrateV1ToV2Options(t *testing.T) {
+	db := dbm.NewMemDB()
+	for i := 0; i < DefaultBatchSize+1; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("old:%06d", i)), []byte("value")))
+	}
+	translate := func(key []byte) ([]byte, bool, error) {
+		rest, found := strings.CutPrefix(string(key), "old:")
+		if !found {
+			return nil, false, nil
+		}
+		return []byte("new:" + rest), true, nil
+	}
+
+	var progress []int64
+	migrated, err := MigrateV1ToV2(db, translate,
+		WithProgress(func(migrated int64) { progress = append(progress, migrated) }),
+		WithFinalize(func(_ dbm.DB, batch dbm.Batch) error {
+			return batch.Set([]byte("size"), []byte("1"))
+		}))
+	require.NoError(t, err)
+	require.EqualValues(t, DefaultBatchSize+1, migrated)
+	require.Equal(t, []int64{DefaultBatchSize, DefaultBatchSize + 1}, progress)
+
+	// The writes of the finalizer are committed along with the version.
+	size, err := db.Get([]byte("size"))
+	require.NoError(t, err)
+	require.Equal(t, "1", string(size))
+	version, err := Version(db)
+	require.NoError(t, err)
+	require.Equal(t, "v2", version)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"sync"
 	"time"
@@ -125,6 +126,14 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// WithMetrics option sets the metrics of the light client. Default: no
+// metrics.
+func WithMetrics(metrics *Metrics) Option {
+	return func(c *Client) {
+		c.metrics = metrics
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -145,6 +154,10 @@ type Client struct {
 	primary provider.Provider
 	// Providers used to "witness" new headers.
 	witnesses []provider.Provider
+	// Statistics of the requests to the providers.
+	stats *providerStats
+	// Connections opened for the providers, released by Close.
+	conns []io.Closer
 
 	// Where trusted light blocks are stored.
 	trustedStore store.Store
@@ -158,7 +171,8 @@ type Client struct {
 
 	quit chan struct{}
 
-	logger log.Logger
+	logger  log.Logger
+	metrics *Metrics
 }
 
 // NewClient returns a new light client. It returns an error if it fails to
@@ -232,6 +246,8 @@ func NewClientFromTrustedStore(
 		confirmationFn:   func(_ string) bool { return true },
 		quit:             make(chan struct{}),
 		logger:           log.NewNopLogger(),
+		metrics:          NopMetrics(),
+		stats:            newProviderStats(),
 	}
 
 	for _, o := range options {
@@ -255,6 +271,8 @@ func NewClientFromTrustedStore(
 		return nil, err
 	}
 
+	c.stats.setProviders(c.primary, c.witnesses)
+
 	return c, c.restoreTrustedLightBlock()
 }
 
@@ -273,6 +291,7 @@ func (c *Client) restoreTrustedLightBlock() error {
 		return ErrGetTrustedBlock{Err: err}
 	}
 	c.latestTrustedBlock = trustedBlock
+	c.metrics.TrustedHeight.Set(float64(lastHeight))
 	c.logger.Info("Restored trusted light block", "height", lastHeight)
 	return nil
 }
@@ -743,7 +762,7 @@ func (c *Client) verifySkipping(
 			if depth == len(blockCache)-1 {
 				pivotHeight := verifiedBlock.Height + (blockCache[depth].Height-verifiedBlock.
 					Height)*verifySkippingNumerator/verifySkippingDenominator
-				interimBlock, providerErr := source.LightBlock(ctx, pivotHeight)
+				interimBlock, providerErr := c.lightBlock(ctx, source, pivotHeight)
 				switch providerErr {
 				case nil:
 					blockCache = append(blockCache, interimBlock)
@@ -871,6 +890,20 @@ func (c *Client) Cleanup() error {
 	return c.trustedStore.Prune(0)
 }
 
+// Close releases the connections to the providers opened by NewGRPCClient and
+// NewGRPCClientFromTrustedStore. It does nothing for the clients created
+// with caller-owned providers.
+func (c *Client) Close() error {
+	var errs []error
+	for _, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	c.conns = nil
+	return errors.Join(errs...)
+}
+
 // cleanupAfter deletes all headers & validator sets after +height+. It also
 // resets latestTrustedBlock to the latest header.
 func (c *Client) cleanupAfter(height int64) error {
@@ -912,6 +945,7 @@ func (c *Client) updateTrustedLightBlock(l *types.LightBlock) error {
 
 	if c.latestTrustedBlock == nil || l.Height > c.latestTrustedBlock.Height {
 		c.latestTrustedBlock = l
+		c.metrics.TrustedHeight.Set(float64(l.Height))
 	}
 
 	return nil
@@ -979,7 +1013,7 @@ func (c *Client) backwards(
 //     any other error, the primary is permanently dropped and is replaced by a witness.
 func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*types.LightBlock, error) {
 	c.providerMutex.Lock()
-	l, err := c.primary.LightBlock(ctx, height)
+	l, err := c.lightBlock(ctx, c.primary, height)
 	c.providerMutex.Unlock()
 
 	switch err {
@@ -1019,6 +1053,7 @@ func (c *Client) removeWitnesses(indexes []int) error {
 		c.witnesses[indexes[i]] = c.witnesses[len(c.witnesses)-1]
 		c.witnesses = c.witnesses[:len(c.witnesses)-1]
 	}
+	c.stats.setProviders(c.primary, c.witnesses)
 
 	return nil
 }
@@ -1056,7 +1091,7 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 		go func(witnessIndex int, witnessResponsesC chan witnessResponse) {
 			defer wg.Done()
 
-			lb, err := c.witnesses[witnessIndex].LightBlock(subctx, height)
+			lb, err := c.lightBlock(subctx, c.witnesses[witnessIndex], height)
 			witnessResponsesC <- witnessResponse{lb, witnessIndex, err}
 		}(index, witnessResponsesC)
 	}
@@ -1080,6 +1115,9 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 			c.logger.Debug("found new primary", "primary", c.witnesses[response.witnessIndex])
 			c.primary = c.witnesses[response.witnessIndex]
 
+			c.metrics.PrimaryReplacements.Add(1)
+			c.metrics.WitnessesRemoved.Add(float64(len(witnessesToRemove)))
+
 			// add promoted witness to the list of witnesses to be removed
 			witnessesToRemove = append(witnessesToRemove, response.witnessIndex)
 
@@ -1111,6 +1149,8 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 	// remove witnesses marked as bad. Removal is done in descending order
 	if err := c.removeWitnesses(witnessesToRemove); err != nil {
 		c.logger.Error("failed to remove witnesses", "err", err, "witnessesToRemove", witnessesToRemove)
+	} else {
+		c.metrics.WitnessesRemoved.Add(float64(len(witnessesToRemove)))
 	}
 
 	return nil, lastError
@@ -1147,6 +1187,7 @@ func (c *Client) compareFirstLightBlockWithWitnesses(ctx context.Context, l *typ
 			c.logger.Error("Witness reports a conflicting header. "+
 				"Please check if the primary is correct or use a different witness.",
 				"witness", c.witnesses[e.WitnessIndex], "err", err)
+			c.recordDivergence(c.witnesses[e.WitnessIndex])
 			return err
 		case errBadWitness:
 			// If witness sent us an invalid header, then remove it
@@ -1158,6 +1199,7 @@ func (c *Client) compareFirstLightBlockWithWitnesses(ctx context.Context, l *typ
 			c.logger.Error("Witness reports conflicting proposer priorities. "+
 				"Please check if the primary is correct or use a different witness.",
 				"witness", c.witnesses[e.WitnessIndex], "err", err)
+			c.recordDivergence(c.witnesses[e.WitnessIndex])
 			return err
 		default: // benign errors can be ignored with the exception of context errors
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
@@ -1173,6 +1215,8 @@ func (c *Client) compareFirstLightBlockWithWitnesses(ctx context.Context, l *typ
 	// remove witnesses that have misbehaved
 	if err := c.removeWitnesses(witnessesToRemove); err != nil {
 		c.logger.Error("Failed to remove witnesses", "err", err, "witnessesToRemove", witnessesToRemove)
+	} else {
+		c.metrics.WitnessesRemoved.Add(float64(len(witnessesToRemove)))
 	}
 
 	return nil
@@ -778,6 +778,42 @@ func TestClientReplacesPrimaryWithWitnessIfPrimaryIsUnavailable(t *testing.T) {
 	assert.Len(t, c.Witnesses(), 2)
 }
 
+func TestClient_Status(t *testing.T) {
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		deadNode,
+		[]provider.Provider{fullNode, fullNode},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.MaxRetryAttempts(1),
+	)
+	require.NoError(t, err)
+
+	// The dead primary is replaced by a witness.
+	_, err = c.Update(ctx, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+
+	status := c.Status()
+	assert.Equal(t, chainID, status.ChainID)
+	assert.EqualValues(t, 3, status.LatestTrustedHeight)
+	assert.Positive(t, status.Primary.Requests)
+	assert.EqualValues(t, 0, status.Primary.Failures)
+	assert.Positive(t, status.Primary.Latency)
+
+	// The dead node is now a witness.
+	require.Len(t, status.Witnesses, 2)
+	var dead light.ProviderStatus
+	for _, w := range status.Witnesses {
+		if w.Failures > 0 {
+			dead = w
+		}
+	}
+	assert.Equal(t, "deadMock", dead.Address)
+	assert.Equal(t, provider.ErrNoResponse.Error(), dead.LastError)
+}
+
 func TestClient_BackwardsVerification(t *testing.T) {
 	{
 		trustHeader, _ := largeFullNode.LightBlock(ctx, 6)
@@ -65,6 +65,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 			//
 			// We combine these actions together, verifying the witnesses headers and outputting the trace
 			// which captures the bifurcation point and if successful provides the information to create valid evidence.
+			c.recordDivergence(c.witnesses[e.WitnessIndex])
 			err := c.handleConflictingHeaders(ctx, primaryTrace, e.Block, e.WitnessIndex, now)
 			if err != nil {
 				// return information of the attack
@@ -82,6 +83,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 		case ErrProposerPrioritiesDiverge:
 			c.logger.Info("witness reported validator set with different proposer priorities",
 				"witness", c.witnesses[e.WitnessIndex], "err", err)
+			c.recordDivergence(c.witnesses[e.WitnessIndex])
 			return e
 		default:
 			// Benign errors which can be ignored unless there was a context
@@ -97,6 +99,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 	if err := c.removeWitnesses(witnessesToRemove); err != nil {
 		return err
 	}
+	c.metrics.WitnessesRemoved.Add(float64(len(witnessesToRemove)))
 
 	// 1. If we had at least one witness that returned the same header then we
 	// conclude that we can trust the header
@@ -122,7 +125,7 @@ func (c *Client) compareNewLightBlockWithWitness(ctx context.Context, errc chan
 ) {
 	h := l.SignedHeader
 
-	lightBlock, err := witness.LightBlock(ctx, h.Height)
+	lightBlock, err := c.lightBlock(ctx, witness, h.Height)
 	switch err {
 	// no error means we move on to checking the hash of the two headers
 	case nil:
@@ -341,7 +344,7 @@ func (c *Client) examineConflictingHeaderAgainstTrace(
 		if traceBlock.Height == targetBlock.Height {
 			sourceBlock = targetBlock
 		} else {
-			sourceBlock, err = source.LightBlock(ctx, traceBlock.Height)
+			sourceBlock, err = c.lightBlock(ctx, source, traceBlock.Height)
 			if err != nil {
 				return nil, nil, ErrExamineTrace{Err: err}
 			}
@@ -382,12 +385,12 @@ func (c *Client) examineConflictingHeaderAgainstTrace(
 // getTargetBlockOrLatest gets the latest height, if it is greater than the target height then it queries
 // the target height else it returns the latest. returns true if it successfully managed to acquire the target
 // height.
-func (*Client) getTargetBlockOrLatest(
+func (c *Client) getTargetBlockOrLatest(
 	ctx context.Context,
 	height int64,
 	witness provider.Provider,
 ) (bool, *types.LightBlock, error) {
-	lightBlock, err := witness.LightBlock(ctx, 0)
+	lightBlock, err := c.lightBlock(ctx, witness, 0)
 	if err != nil {
 		return false, nil, err
 	}
@@ -402,7 +405,7 @@ func (*Client) getTargetBlockOrLatest(
 		// the witness has caught up. We recursively call the function again. However in order
 		// to avoid a wild goose chase where the witness sends us one header below and one header
 		// above the height we set a timeout to the context
-		lightBlock, err := witness.LightBlock(ctx, height)
+		lightBlock, err := c.lightBlock(ctx, witness, height)
 		return true, lightBlock, err
 	}
 
@@ -86,6 +86,13 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		CommonHeight: 4,
 	}
 	assert.True(t, primary.HasEvidence(evAgainstWitness))
+
+	// Check the divergence was recorded.
+	status := c.Status()
+	require.Len(t, status.Witnesses, 1)
+	assert.EqualValues(t, 1, status.Witnesses[0].Divergences)
+	assert.Positive(t, status.Witnesses[0].Requests)
+	assert.EqualValues(t, 0, status.Primary.Divergences)
 }
 
 func TestLightClientAttackEvidence_Equivocation(t *testing.T) {
@@ -0,0 +1,32 @@
+package light
+
+import (
+	"github.com/cometbft/cometbft/libs/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "light"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Height of the latest trusted light block.
+	TrustedHeight metrics.Gauge
+	// Duration of the light block requests to a provider, labeled by the
+	// provider address.
+	ProviderRequestDurationSeconds metrics.Histogram `metrics_bucketsizes:"0.01, 2, 11" metrics_buckettype:"exp" metrics_labels:"provider"`
+	// Number of light block requests to a provider that failed, labeled by
+	// the provider address.
+	ProviderFailures metrics.Counter `metrics_labels:"provider"`
+	// Number of times a witness reported a light block conflicting with the
+	// one of the primary, labeled by the witness address.
+	Divergences metrics.Counter `metrics_labels:"provider"`
+	// Number of times the primary was replaced by a witness.
+	PrimaryReplacements metrics.Counter
+	// Number of witnesses removed for misbehaving or being unresponsive.
+	WitnessesRemoved metrics.Counter
+}
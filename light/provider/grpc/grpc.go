@@ -0,0 +1,213 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/light/provider"
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	// The block service reports heights it does not have as invalid
+	// arguments, so they are told apart by their message.
+	regexpTooHigh  = regexp.MustCompile(`is higher than latest height`)
+	regexpTooLow   = regexp.MustCompile(`is below base height`)
+	requestTimeout = 5 * time.Second
+
+	// ErrEvidenceNotSupported is returned when reporting evidence, as nodes do
+	// not accept evidence over gRPC.
+	ErrEvidenceNotSupported = errors.New("reporting evidence is not supported over gRPC")
+)
+
+// grpc provider uses the gRPC BlockService of a node to obtain the necessary
+// information.
+//
+// The block service only serves the canonical commit for a height, found in
+// the block at the next height. Hence, the latest light block of a node is
+// the one below its latest block.
+type grpc struct {
+	chainID string
+	remote  string
+	client  grpcclient.BlockServiceClient
+	// the connection opened by New, if any
+	conn io.Closer
+}
+
+// Provider is a provider holding a connection to a node, which must be closed
+// once the provider is no longer used.
+type Provider interface {
+	provider.Provider
+	io.Closer
+}
+
+// New creates a gRPC provider connecting to the gRPC server of a node at the
+// given address, without transport security. The address may be prefixed with
+// the grpc:// scheme. The 5s timeout is used for all requests.
+//
+// The connection is released by Close.
+func New(chainID, remote string) (Provider, error) {
+	remote = strings.TrimPrefix(remote, "grpc://")
+	client, err := grpcclient.New(
+		context.Background(),
+		remote,
+		grpcclient.WithInsecure(),
+		grpcclient.WithVersionServiceEnabled(false),
+		grpcclient.WithBlockResultsServiceEnabled(false),
+		grpcclient.WithSnapshotServiceEnabled(false),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &grpc{
+		chainID: chainID,
+		remote:  remote,
+		client:  client,
+		conn:    client,
+	}, nil
+}
+
+// NewWithClient allows you to provide a custom client, which remains owned by
+// the caller. remote is only used to describe the provider.
+func NewWithClient(chainID, remote string, client grpcclient.BlockServiceClient) provider.Provider {
+	return &grpc{
+		chainID: chainID,
+		remote:  remote,
+		client:  client,
+	}
+}
+
+// ChainID returns a chainID this provider was configured with.
+func (p *grpc) ChainID() string {
+	return p.chainID
+}
+
+// Close closes the connection opened by New. It does nothing for the
+// providers created by NewWithClient.
+func (p *grpc) Close() error {
+	if p.conn == nil {
+		return nil
+	}
+	return p.conn.Close()
+}
+
+func (p *grpc) String() string {
+	return fmt.Sprintf("grpc{%s}", p.remote)
+}
+
+// LightBlock fetches a LightBlock at the given height and checks the
+// chainID matches.
+func (p *grpc) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, provider.ErrBadLightBlock{Reason: provider.ErrNegativeHeight{Height: height}}
+	}
+
+	if height == 0 {
+		latest, err := p.validators(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		height = latest.Height - 1
+		if height < 1 {
+			return nil, provider.ErrHeightTooHigh
+		}
+	}
+
+	block, err := p.block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	next, err := p.block(ctx, height+1)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := p.validators(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.Block.Height != height || vals.Height != height {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("heights %d and %d responded don't match height %d requested",
+				block.Block.Height, vals.Height, height),
+		}
+	}
+
+	lb := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &block.Block.Header,
+			Commit: next.Block.LastCommit,
+		},
+		ValidatorSet: vals.ValidatorSet,
+	}
+
+	err = lb.ValidateBasic(p.chainID)
+	if err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+
+	return lb, nil
+}
+
+// ReportEvidence implements provider.Provider. Nodes do not accept evidence
+// over gRPC, so ErrEvidenceNotSupported is returned.
+func (*grpc) ReportEvidence(context.Context, types.Evidence) error {
+	return ErrEvidenceNotSupported
+}
+
+func (p *grpc) block(ctx context.Context, height int64) (*grpcclient.Block, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	block, err := p.client.GetBlockByHeight(reqCtx, height)
+	if err != nil {
+		return nil, p.parseErr(ctx, err)
+	}
+	if block.Block == nil {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("no block returned for height %d", height)}
+	}
+	return block, nil
+}
+
+func (p *grpc) validators(ctx context.Context, height int64) (*grpcclient.Validators, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	vals, err := p.client.GetValidators(reqCtx, height)
+	if err != nil {
+		return nil, p.parseErr(ctx, err)
+	}
+	if vals.ValidatorSet == nil || vals.ValidatorSet.IsNilOrEmpty() {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("validator set is empty (height: %d)", height)}
+	}
+	return vals, nil
+}
+
+// parseErr maps the errors of the block service to the provider errors.
+func (*grpc) parseErr(ctx context.Context, err error) error {
+	// The request was canceled by the caller, rather than timing out.
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		if regexpTooHigh.MatchString(err.Error()) {
+			return provider.ErrHeightTooHigh
+		}
+		if regexpTooLow.MatchString(err.Error()) {
+			return provider.ErrLightBlockNotFound
+		}
+	case codes.NotFound:
+		return provider.ErrLightBlockNotFound
+	case codes.DeadlineExceeded, codes.Unavailable:
+		return provider.ErrNoResponse
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/light/provider"
+	lightgrpc "github.com/cometbft/cometbft/light/provider/grpc"
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
+	"github.com/cometbft/cometbft/types"
+)
+
+// blockService serves the blocks of a chain the way the block service of a
+// node does.
+type blockService struct {
+	grpcclient.BlockServiceClient
+
+	blocks map[int64]*types.Block
+	vals   *types.ValidatorSet
+	base   int64
+	height int64
+}
+
+func (s *blockService) GetBlockByHeight(_ context.Context, height int64) (*grpcclient.Block, error) {
+	switch {
+	case height > s.height:
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is higher than latest height %d", height, s.height)
+	case height < s.base:
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is below base height %d", height, s.base)
+	}
+	block := s.blocks[height]
+	blockID := types.BlockID{Hash: block.Hash()}
+	return &grpcclient.Block{BlockID: &blockID, Block: block}, nil
+}
+
+func (s *blockService) GetValidators(_ context.Context, height int64) (*grpcclient.Validators, error) {
+	if height == 0 {
+		height = s.height
+	}
+	if height > s.height {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is higher than latest height %d", height, s.height)
+	}
+	return &grpcclient.Validators{Height: height, ValidatorSet: s.vals}, nil
+}
+
+// newBlockService returns the block service of a node having the blocks from
+// height base to height.
+func newBlockService(t *testing.T, chainID string, base, height int64) *blockService {
+	t.Helper()
+	vals, privVals := test.ValidatorSet(context.Background(), t, 4, 10)
+	s := &blockService{
+		blocks: make(map[int64]*types.Block),
+		vals:   vals,
+		base:   base,
+		height: height,
+	}
+
+	lastBlockID := test.MakeBlockID()
+	var lastCommit *types.Commit
+	for h := int64(1); h <= height; h++ {
+		header := test.MakeHeader(t, &types.Header{
+			ChainID:            chainID,
+			Height:             h,
+			Time:               test.DefaultTestTime.Add(time.Duration(h) * time.Second),
+			LastBlockID:        lastBlockID,
+			ValidatorsHash:     vals.Hash(),
+			NextValidatorsHash: vals.Hash(),
+		})
+		s.blocks[h] = &types.Block{Header: *header, LastCommit: lastCommit}
+
+		lastBlockID = test.MakeBlockIDWithHash(header.Hash())
+		var err error
+		lastCommit, err = test.MakeCommit(lastBlockID, h, 0, vals, privVals, chainID, header.Time)
+		require.NoError(t, err)
+	}
+	return s
+}
+
+func TestNewProvider(t *testing.T) {
+	p, err := lightgrpc.New("chain-test", "grpc://192.168.0.1:26670")
+	require.NoError(t, err)
+	require.Equal(t, "grpc{192.168.0.1:26670}", fmt.Sprintf("%s", p))
+	require.Equal(t, "chain-test", p.ChainID())
+	require.NoError(t, p.Close())
+
+	p, err = lightgrpc.New("chain-test", "192.168.0.1:26670")
+	require.NoError(t, err)
+	require.Equal(t, "grpc{192.168.0.1:26670}", fmt.Sprintf("%s", p))
+	require.NoError(t, p.Close())
+}
+
+func TestProvider(t *testing.T) {
+	const chainID = "test-chain"
+	ctx := context.Background()
+	s := newBlockService(t, chainID, 3, 10)
+	p := lightgrpc.NewWithClient(chainID, "node", s)
+
+	// The latest light block is the one below the latest block, as its commit
+	// is in the latest block.
+	lb, err := p.LightBlock(ctx, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 9, lb.Height)
+	assert.Equal(t, s.blocks[10].LastCommit, lb.Commit)
+	assert.Equal(t, s.vals.Hash(), lb.ValidatorSet.Hash())
+	require.NoError(t, lb.ValidateBasic(chainID))
+
+	lb, err = p.LightBlock(ctx, 5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, lb.Height)
+	assert.Equal(t, s.blocks[5].Hash(), lb.Hash())
+
+	_, err = p.LightBlock(ctx, 10)
+	require.ErrorIs(t, err, provider.ErrHeightTooHigh)
+
+	_, err = p.LightBlock(ctx, 2)
+	require.ErrorIs(t, err, provider.ErrLightBlockNotFound)
+
+	_, err = p.LightBlock(ctx, -1)
+	require.ErrorAs(t, err, &provider.ErrBadLightBlock{})
+
+	_, err = lightgrpc.NewWithClient("other-chain", "node", s).LightBlock(ctx, 5)
+	require.ErrorAs(t, err, &provider.ErrBadLightBlock{})
+
+	require.ErrorIs(t, p.ReportEvidence(ctx, nil), lightgrpc.ErrEvidenceNotSupported)
+}
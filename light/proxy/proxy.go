@@ -15,11 +15,12 @@ import (
 
 // A Proxy defines parameters for running an HTTP server proxy.
 type Proxy struct {
-	Addr     string // TCP address to listen on, ":http" if empty
-	Config   *rpcserver.Config
-	Client   *lrpc.Client
-	Logger   log.Logger
-	Listener net.Listener
+	Addr        string // TCP address to listen on, ":http" if empty
+	Config      *rpcserver.Config
+	Client      *lrpc.Client
+	LightClient *light.Client
+	Logger      log.Logger
+	Listener    net.Listener
 }
 
 // NewProxy creates the struct used to run an HTTP server for serving light
@@ -37,10 +38,11 @@ func NewProxy(
 	}
 
 	return &Proxy{
-		Addr:   listenAddr,
-		Config: config,
-		Client: lrpc.NewClient(rpcClient, lightClient, opts...),
-		Logger: logger,
+		Addr:        listenAddr,
+		Config:      config,
+		Client:      lrpc.NewClient(rpcClient, lightClient, opts...),
+		LightClient: lightClient,
+		Logger:      logger,
 	}, nil
 }
 
@@ -86,8 +88,9 @@ func (p *Proxy) ListenAndServeTLS(certFile, keyFile string) error {
 func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
 	mux := http.NewServeMux()
 
-	// 1) Register regular routes.
+	// 1) Register regular routes, and the status of the light client.
 	r := RPCRoutes(p.Client)
+	r["light_status"] = rpcserver.NewRPCFunc(makeLightStatusFunc(p.LightClient, p.Client), "")
 	rpcserver.RegisterRPCFuncs(mux, r, p.Logger)
 
 	// 2) Allow websocket connections.
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/light"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -71,6 +72,24 @@ func makeStatusFunc(c *lrpc.Client) rpcStatusFunc {
 	}
 }
 
+// ResultLightStatus is the status of the light client and of its providers,
+// and the statistics of the cache of verified responses.
+type ResultLightStatus struct {
+	LightClient *light.Status   `json:"light_client"`
+	Cache       lrpc.CacheStats `json:"cache"`
+}
+
+type rpcLightStatusFunc func(ctx *rpctypes.Context) (*ResultLightStatus, error)
+
+func makeLightStatusFunc(lc *light.Client, c *lrpc.Client) rpcLightStatusFunc {
+	return func(*rpctypes.Context) (*ResultLightStatus, error) {
+		return &ResultLightStatus{
+			LightClient: lc.Status(),
+			Cache:       c.CacheStats(),
+		}, nil
+	}
+}
+
 type rpcNetInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultNetInfo, error)
 
 func makeNetInfoFunc(c *lrpc.Client) rpcNetInfoFunc {
@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// CacheStats are the statistics of the cache of verified responses.
+type CacheStats struct {
+	// Maximum number of entries of each kind of response.
+	Capacity int `json:"capacity"`
+	// Number of light blocks, blocks and block results in the cache.
+	LightBlocks  int `json:"light_blocks"`
+	Blocks       int `json:"blocks"`
+	BlockResults int `json:"block_results"`
+	// Number of lookups which found, respectively did not find, their
+	// response in the cache.
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+// verifiedCache holds the latest verified light blocks, blocks and block
+// results, keyed by height. As they never change once committed, they need
+// not be fetched from the primary nor verified again.
+type verifiedCache struct {
+	size         int
+	lightBlocks  *lru.Cache[int64, *types.LightBlock]
+	blocks       *lru.Cache[int64, *ctypes.ResultBlock]
+	blockResults *lru.Cache[int64, *ctypes.ResultBlockResults]
+
+	hits, misses atomic.Uint64
+}
+
+func newVerifiedCache(size int) (*verifiedCache, error) {
+	vc := &verifiedCache{size: size}
+	var err error
+	if vc.lightBlocks, err = lru.New[int64, *types.LightBlock](size); err != nil {
+		return nil, err
+	}
+	if vc.blocks, err = lru.New[int64, *ctypes.ResultBlock](size); err != nil {
+		return nil, err
+	}
+	if vc.blockResults, err = lru.New[int64, *ctypes.ResultBlockResults](size); err != nil {
+		return nil, err
+	}
+	return vc, nil
+}
+
+// The methods of verifiedCache do nothing if it is nil, i.e. if caching is
+// disabled.
+
+func (vc *verifiedCache) lightBlock(height int64) (*types.LightBlock, bool) {
+	if vc == nil {
+		return nil, false
+	}
+	return cacheGet(vc, vc.lightBlocks, height)
+}
+
+func (vc *verifiedCache) addLightBlock(l *types.LightBlock) {
+	if vc != nil {
+		vc.lightBlocks.Add(l.Height, l)
+	}
+}
+
+func (vc *verifiedCache) block(height int64) (*ctypes.ResultBlock, bool) {
+	if vc == nil {
+		return nil, false
+	}
+	return cacheGet(vc, vc.blocks, height)
+}
+
+func (vc *verifiedCache) addBlock(res *ctypes.ResultBlock) {
+	if vc != nil {
+		vc.blocks.Add(res.Block.Height, res)
+	}
+}
+
+func (vc *verifiedCache) blockResult(height int64) (*ctypes.ResultBlockResults, bool) {
+	if vc == nil {
+		return nil, false
+	}
+	return cacheGet(vc, vc.blockResults, height)
+}
+
+func (vc *verifiedCache) addBlockResult(res *ctypes.ResultBlockResults) {
+	if vc != nil {
+		vc.blockResults.Add(res.Height, res)
+	}
+}
+
+func cacheGet[V any](vc *verifiedCache, c *lru.Cache[int64, V], height int64) (V, bool) {
+	v, ok := c.Get(height)
+	if ok {
+		vc.hits.Add(1)
+	} else {
+		vc.misses.Add(1)
+	}
+	return v, ok
+}
+
+func (vc *verifiedCache) stats() CacheStats {
+	if vc == nil {
+		return CacheStats{}
+	}
+	return CacheStats{
+		Capacity:     vc.size,
+		LightBlocks:  vc.lightBlocks.Len(),
+		Blocks:       vc.blocks.Len(),
+		BlockResults: vc.blockResults.Len(),
+		Hits:         vc.hits.Load(),
+		Misses:       vc.misses.Load(),
+	}
+}
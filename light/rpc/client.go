@@ -47,6 +47,10 @@ type Client struct {
 	// proof runtime used to verify values returned by ABCIQuery
 	prt       *merkle.ProofRuntime
 	keyPathFn KeyPathFunc
+
+	// verified responses, nil if caching is disabled
+	cacheSize int
+	cache     *verifiedCache
 }
 
 var _ rpcclient.Client = (*Client)(nil)
@@ -63,6 +67,16 @@ func KeyPathFn(fn KeyPathFunc) Option {
 	}
 }
 
+// CacheSize option enables caching the given number of latest verified light
+// blocks, blocks and block results each, so that requests for them are
+// served without fetching them from the primary and verifying them again.
+// Default: 0, i.e. no caching.
+func CacheSize(size int) Option {
+	return func(c *Client) {
+		c.cacheSize = size
+	}
+}
+
 // DefaultMerkleKeyPathFn creates a function used to generate merkle key paths
 // from a path string and a key. This is the default used by the cosmos SDK.
 // This merkle key paths are required when verifying /abci_query calls.
@@ -95,9 +109,19 @@ func NewClient(next rpcclient.Client, lc LightClient, opts ...Option) *Client {
 	for _, o := range opts {
 		o(c)
 	}
+	if c.cacheSize > 0 {
+		// The size is positive, so no error can occur.
+		c.cache, _ = newVerifiedCache(c.cacheSize)
+	}
 	return c
 }
 
+// CacheStats returns the statistics of the cache of verified responses, all
+// zero if caching is disabled.
+func (c *Client) CacheStats() CacheStats {
+	return c.cache.stats()
+}
+
 func (c *Client) OnStart() error {
 	if !c.next.IsRunning() {
 		return c.next.Start()
@@ -228,6 +252,10 @@ func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusSta
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) ConsensusTimeline(ctx context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error) {
+	return c.next.ConsensusTimeline(ctx, height)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -310,33 +338,22 @@ func (c *Client) GenesisChunked(ctx context.Context, id uint) (*ctypes.ResultGen
 
 // Block calls rpcclient#Block and then verifies the result.
 func (c *Client) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
-	res, err := c.next.Block(ctx, height)
-	if err != nil {
-		return nil, err
-	}
-
-	// Validate res.
-	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
-	}
-	if err := res.Block.ValidateBasic(); err != nil {
-		return nil, err
-	}
-	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, ErrBlockIDMismatch{BlockID: bmH, Block: bH}
+	if height != nil {
+		if res, ok := c.cache.block(*height); ok {
+			return res, nil
+		}
 	}
 
-	// Update the light client if we're behind.
-	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
+	res, err := c.next.Block(ctx, height)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify block.
-	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, ErrBlockHeaderMismatch{BlockHeader: bH, TrustedHeader: tH}
+	if err := c.verifyBlock(ctx, res); err != nil {
+		return nil, err
 	}
 
+	c.cache.addBlock(res)
 	return res, nil
 }
 
@@ -347,34 +364,47 @@ func (c *Client) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBl
 		return nil, err
 	}
 
+	if err := c.verifyBlock(ctx, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// verifyBlock validates the block of res and checks it against the trusted
+// header at its height.
+func (c *Client) verifyBlock(ctx context.Context, res *ctypes.ResultBlock) error {
 	// Validate res.
 	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if err := res.Block.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, ErrBlockIDMismatch{BlockID: bmH, Block: bH}
+		return ErrBlockIDMismatch{BlockID: bmH, Block: bH}
 	}
 
 	// Update the light client if we're behind.
 	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Verify block.
 	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, ErrBlockHeaderMismatch{BlockHeader: bH, TrustedHeader: tH}
+		return ErrBlockHeaderMismatch{BlockHeader: bH, TrustedHeader: tH}
 	}
 
-	return res, nil
+	return nil
 }
 
 // BlockResults returns the block results for the given height. If no height is
 // provided, the results of the block preceding the latest are returned.
-// NOTE: Light client only verifies the tx results.
+// The tx results are verified against the LastResultsHash, and the app hash
+// against the AppHash, of the trusted header at the next height.
+// NOTE: Light client does not verify the events, validator updates and
+// consensus param updates.
 func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
 	var h int64
 	if height == nil {
@@ -389,6 +419,10 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 		h = *height
 	}
 
+	if res, ok := c.cache.blockResult(h); ok {
+		return res, nil
+	}
+
 	res, err := c.next.BlockResults(ctx, &h)
 	if err != nil {
 		return nil, err
@@ -398,6 +432,9 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 	if res.Height <= 0 {
 		return nil, ErrNegOrZeroHeight
 	}
+	if res.Height != h {
+		return nil, ErrHeightMismatch{Requested: h, Responded: res.Height}
+	}
 
 	// Update the light client if we're behind.
 	nextHeight := h + 1
@@ -413,7 +450,11 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 	if !bytes.Equal(rH, trustedBlock.LastResultsHash) {
 		return nil, ErrLastResultMismatch{ResultHash: rH, LastResultHash: trustedBlock.LastResultsHash}
 	}
+	if !bytes.Equal(res.AppHash, trustedBlock.AppHash) {
+		return nil, ErrAppHashMismatch{AppHash: res.AppHash, TrustedAppHash: trustedBlock.AppHash}
+	}
 
+	c.cache.addBlockResult(res)
 	return res, nil
 }
 
@@ -487,6 +528,11 @@ func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.Resul
 	return res, res.Proof.Validate(l.DataHash)
 }
 
+// TxSearch calls rpcclient#TxSearch and then verifies the inclusion of every
+// tx found in the block at its height. Proofs are always requested from the
+// primary, and removed from the result if they were not requested.
+// NOTE: Light client does not verify that the txs match the query, that no
+// tx is missing, nor the tx results.
 func (c *Client) TxSearch(
 	ctx context.Context,
 	query string,
@@ -494,16 +540,72 @@ func (c *Client) TxSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	res, err := c.next.TxSearch(ctx, query, true, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, tx := range res.Txs {
+		if err := c.verifyTx(ctx, tx); err != nil {
+			return nil, ErrInvalidTx{I: i, Err: err}
+		}
+		if !prove {
+			tx.Proof = types.TxProof{}
+		}
+	}
+
+	return res, nil
 }
 
+// verifyTx checks the tx of res is the tx with its hash, and verifies its
+// inclusion proof against the trusted header at its height.
+func (c *Client) verifyTx(ctx context.Context, res *ctypes.ResultTx) error {
+	// Validate res.
+	if res.Height <= 0 {
+		return ErrNegOrZeroHeight
+	}
+	if tH := res.Tx.Hash(); !bytes.Equal(res.Hash, tH) {
+		return ErrTxHashMismatch{Hash: res.Hash, TxHash: tH}
+	}
+	if !bytes.Equal(res.Proof.Data, res.Tx) {
+		return ErrTxProofMismatch
+	}
+	if res.Proof.Proof.Index != int64(res.Index) {
+		return ErrTxIndexMismatch{Index: res.Index, ProofIndex: res.Proof.Proof.Index}
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return err
+	}
+
+	// Validate the proof.
+	return res.Proof.Validate(l.DataHash)
+}
+
+// BlockSearch calls rpcclient#BlockSearch and then verifies every block found
+// against the trusted header at its height.
+// NOTE: Light client does not verify that the blocks match the query, nor that
+// no block is missing.
 func (c *Client) BlockSearch(
 	ctx context.Context,
 	query string,
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	res, err := c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, block := range res.Blocks {
+		if err := c.verifyBlock(ctx, block); err != nil {
+			return nil, ErrInvalidBlock{I: i, Err: err}
+		}
+	}
+
+	return res, nil
 }
 
 // Validators fetches and verifies validators.
@@ -563,11 +665,17 @@ func (c *Client) updateLightClientIfNeededTo(ctx context.Context, height *int64)
 	if height == nil {
 		l, err = c.lc.Update(ctx, cmttime.Now())
 	} else {
+		if l, ok := c.cache.lightBlock(*height); ok {
+			return l, nil
+		}
 		l, err = c.lc.VerifyLightBlockAtHeight(ctx, *height, cmttime.Now())
 	}
 	if err != nil {
 		return nil, ErrUpdateClient{Height: *height, Err: err}
 	}
+	if l != nil {
+		c.cache.addLightBlock(l)
+	}
 	return l, nil
 }
 
@@ -0,0 +1,254 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	rpcmock "github.com/cometbft/cometbft/rpc/client/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// testChain holds the block at height 5 of a chain, and the trusted light
+// blocks at heights 5 and 6.
+type testChain struct {
+	block     *types.Block
+	txResults []*abci.ExecTxResult
+	appHash   []byte
+	lc        *lcmock.LightClient
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+	vals, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	lastCommit, err := test.MakeCommit(test.MakeBlockID(), 4, 0, vals, privVals, test.DefaultTestChainID,
+		test.DefaultTestTime)
+	require.NoError(t, err)
+
+	block := types.MakeBlock(5, types.Txs{types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3")}, lastCommit, nil)
+	test.MakeHeader(t, &block.Header)
+
+	txResults := []*abci.ExecTxResult{{Code: 0, GasUsed: 10}, {Code: 1}, {Code: 0, Data: []byte("c")}}
+	appHash := test.RandomHash()
+	next := test.MakeHeader(t, &types.Header{
+		Height:          6,
+		LastResultsHash: state.TxResultsHash(txResults),
+		AppHash:         appHash,
+	})
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).Return(
+		&types.LightBlock{SignedHeader: &types.SignedHeader{Header: &block.Header}}, nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).Return(
+		&types.LightBlock{SignedHeader: &types.SignedHeader{Header: next}}, nil)
+
+	return &testChain{block: block, txResults: txResults, appHash: appHash, lc: lc}
+}
+
+func (tc *testChain) resultTx(i int) *ctypes.ResultTx {
+	tx := tc.block.Txs[i]
+	return &ctypes.ResultTx{
+		Hash:     tx.Hash(),
+		Height:   tc.block.Height,
+		Index:    uint32(i),
+		TxResult: *tc.txResults[i],
+		Tx:       tx,
+		Proof:    tc.block.Txs.Proof(i),
+	}
+}
+
+func TestTxSearch(t *testing.T) {
+	tc := newTestChain(t)
+	ctx := context.Background()
+
+	next := &rpcmock.Client{}
+	next.On("TxSearch", mock.Anything, "tx.height=5", true, mock.Anything, mock.Anything, "").Return(
+		&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tc.resultTx(0), tc.resultTx(2)}, TotalCount: 2}, nil)
+	c := NewClient(next, tc.lc)
+
+	res, err := c.TxSearch(ctx, "tx.height=5", true, nil, nil, "")
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	assert.Equal(t, tc.block.Txs.Proof(2), res.Txs[1].Proof)
+
+	// Proofs are requested and verified even if they are not returned.
+	res, err = c.TxSearch(ctx, "tx.height=5", false, nil, nil, "")
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	assert.Equal(t, types.TxProof{}, res.Txs[0].Proof)
+
+	wrongTx := tc.resultTx(1)
+	wrongTx.Tx = types.Tx("b=3")
+	wrongTx.Hash = wrongTx.Tx.Hash()
+	wrongHash := tc.resultTx(1)
+	wrongHash.Hash = tc.block.Txs[0].Hash()
+	wrongIndex := tc.resultTx(1)
+	wrongIndex.Index = 2
+	wrongProof := tc.resultTx(1)
+	wrongProof.Tx, wrongProof.Hash = tc.block.Txs[2], tc.block.Txs[2].Hash()
+	wrongProof.Proof.Data = wrongProof.Tx
+	otherBlock := tc.resultTx(0)
+	otherBlock.Proof = types.Txs{tc.block.Txs[0], types.Tx("d=4")}.Proof(0)
+
+	testCases := []struct {
+		name   string
+		tx     *ctypes.ResultTx
+		expErr string
+	}{
+		{"tx not matching proof", wrongTx, "tx does not match the data of its proof"},
+		{"hash not matching tx", wrongHash, "does not match with tx hash"},
+		{"index not matching proof", wrongIndex, "tx index 2 does not match with proof index 1"},
+		{"invalid proof", wrongProof, "proof is not internally consistent"},
+		{"proof of other block", otherBlock, "proof matches different data hash"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			next := &rpcmock.Client{}
+			next.On("TxSearch", mock.Anything, "tx.height=5", true, mock.Anything, mock.Anything, "").Return(
+				&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{testCase.tx}, TotalCount: 1}, nil)
+			c := NewClient(next, tc.lc)
+
+			_, err := c.TxSearch(ctx, "tx.height=5", true, nil, nil, "")
+			require.ErrorAs(t, err, &ErrInvalidTx{})
+			require.ErrorContains(t, err, testCase.expErr)
+		})
+	}
+}
+
+func TestBlockResults(t *testing.T) {
+	tc := newTestChain(t)
+	ctx := context.Background()
+	height := int64(5)
+
+	testCases := []struct {
+		name   string
+		res    *ctypes.ResultBlockResults
+		expErr string
+	}{
+		{"valid", &ctypes.ResultBlockResults{Height: 5, TxResults: tc.txResults, AppHash: tc.appHash}, ""},
+		{
+			"wrong height",
+			&ctypes.ResultBlockResults{Height: 4, TxResults: tc.txResults, AppHash: tc.appHash},
+			"height 4 responded doesn't match height 5 requested",
+		},
+		{
+			"wrong tx results",
+			&ctypes.ResultBlockResults{Height: 5, TxResults: tc.txResults[:2], AppHash: tc.appHash},
+			"does not match with trusted last results",
+		},
+		{
+			"wrong app hash",
+			&ctypes.ResultBlockResults{Height: 5, TxResults: tc.txResults, AppHash: test.RandomHash()},
+			"does not match with trusted app hash",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			next := &rpcmock.Client{}
+			next.On("BlockResults", mock.Anything, &height).Return(testCase.res, nil)
+			c := NewClient(next, tc.lc)
+
+			res, err := c.BlockResults(ctx, &height)
+			if testCase.expErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.res, res)
+			} else {
+				require.ErrorContains(t, err, testCase.expErr)
+			}
+		})
+	}
+}
+
+func TestBlockSearch(t *testing.T) {
+	tc := newTestChain(t)
+	ctx := context.Background()
+
+	parts, err := tc.block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: tc.block.Hash(), PartSetHeader: parts.Header()}
+
+	next := &rpcmock.Client{}
+	next.On("BlockSearch", mock.Anything, "block.height=5", mock.Anything, mock.Anything, "").Return(
+		&ctypes.ResultBlockSearch{Blocks: []*ctypes.ResultBlock{{BlockID: blockID, Block: tc.block}}, TotalCount: 1}, nil)
+	c := NewClient(next, tc.lc)
+
+	res, err := c.BlockSearch(ctx, "block.height=5", nil, nil, "")
+	require.NoError(t, err)
+	require.Len(t, res.Blocks, 1)
+
+	// A block of another chain at the same height.
+	other := newTestChain(t)
+	parts, err = other.block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	otherID := types.BlockID{Hash: other.block.Hash(), PartSetHeader: parts.Header()}
+
+	next = &rpcmock.Client{}
+	next.On("BlockSearch", mock.Anything, "block.height=5", mock.Anything, mock.Anything, "").Return(
+		&ctypes.ResultBlockSearch{Blocks: []*ctypes.ResultBlock{
+			{BlockID: blockID, Block: tc.block},
+			{BlockID: otherID, Block: other.block},
+		}, TotalCount: 2}, nil)
+	c = NewClient(next, tc.lc)
+
+	_, err = c.BlockSearch(ctx, "block.height=5", nil, nil, "")
+	var invalidBlock ErrInvalidBlock
+	require.ErrorAs(t, err, &invalidBlock)
+	assert.Equal(t, 1, invalidBlock.I)
+	require.ErrorAs(t, err, &ErrBlockHeaderMismatch{})
+}
+
+func TestCache(t *testing.T) {
+	tc := newTestChain(t)
+	ctx := context.Background()
+	height := int64(5)
+
+	parts, err := tc.block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: tc.block.Hash(), PartSetHeader: parts.Header()}
+	results := &ctypes.ResultBlockResults{Height: 5, TxResults: tc.txResults, AppHash: tc.appHash}
+
+	// Each response is fetched and verified only once.
+	next := &rpcmock.Client{}
+	next.On("Block", mock.Anything, &height).Return(&ctypes.ResultBlock{BlockID: blockID, Block: tc.block}, nil).Once()
+	next.On("BlockResults", mock.Anything, &height).Return(results, nil).Once()
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).Return(
+		&types.LightBlock{SignedHeader: &types.SignedHeader{Header: &tc.block.Header}}, nil).Once()
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).Return(
+		tc.lc.VerifyLightBlockAtHeight(ctx, 6, time.Time{})).Once()
+	c := NewClient(next, lc, CacheSize(10))
+
+	for i := 0; i < 2; i++ {
+		res, err := c.Block(ctx, &height)
+		require.NoError(t, err)
+		assert.Equal(t, tc.block, res.Block)
+
+		header, err := c.Header(ctx, &height)
+		require.NoError(t, err)
+		assert.Equal(t, &tc.block.Header, header.Header)
+
+		blockResults, err := c.BlockResults(ctx, &height)
+		require.NoError(t, err)
+		assert.Equal(t, results, blockResults)
+	}
+	next.AssertExpectations(t)
+	lc.AssertExpectations(t)
+
+	assert.Equal(t, CacheStats{
+		Capacity:     10,
+		LightBlocks:  2,
+		Blocks:       1,
+		BlockResults: 1,
+		Hits:         4,
+		Misses:       4,
+	}, c.CacheStats())
+}
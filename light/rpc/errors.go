@@ -12,6 +12,7 @@ var (
 	ErrNegOrZeroHeight = errors.New("negative or zero height")
 	ErrNoProofOps      = errors.New("no proof ops")
 	ErrNilKeyPathFn    = errors.New("please configure Client with KeyPathFn option")
+	ErrTxProofMismatch = errors.New("tx does not match the data of its proof")
 )
 
 type ErrMissingStoreName struct {
@@ -66,6 +67,42 @@ func (e ErrLastResultMismatch) Error() string {
 	return fmt.Sprintf("last results %X does not match with trusted last results %X", e.ResultHash, e.LastResultHash)
 }
 
+type ErrAppHashMismatch struct {
+	AppHash        cmtbytes.HexBytes
+	TrustedAppHash cmtbytes.HexBytes
+}
+
+func (e ErrAppHashMismatch) Error() string {
+	return fmt.Sprintf("app hash %X does not match with trusted app hash %X", e.AppHash, e.TrustedAppHash)
+}
+
+type ErrHeightMismatch struct {
+	Requested int64
+	Responded int64
+}
+
+func (e ErrHeightMismatch) Error() string {
+	return fmt.Sprintf("height %d responded doesn't match height %d requested", e.Responded, e.Requested)
+}
+
+type ErrTxHashMismatch struct {
+	Hash   cmtbytes.HexBytes
+	TxHash cmtbytes.HexBytes
+}
+
+func (e ErrTxHashMismatch) Error() string {
+	return fmt.Sprintf("hash %X does not match with tx hash %X", e.Hash, e.TxHash)
+}
+
+type ErrTxIndexMismatch struct {
+	Index      uint32
+	ProofIndex int64
+}
+
+func (e ErrTxIndexMismatch) Error() string {
+	return fmt.Sprintf("tx index %d does not match with proof index %d", e.Index, e.ProofIndex)
+}
+
 type ErrPrimaryHeaderMismatch struct {
 	PrimaryHeaderHash cmtbytes.HexBytes
 	TrustedHeaderHash cmtbytes.HexBytes
@@ -188,3 +225,29 @@ func (e ErrUpdateClient) Error() string {
 func (e ErrUpdateClient) Unwrap() error {
 	return e.Err
 }
+
+type ErrInvalidTx struct {
+	I   int
+	Err error
+}
+
+func (e ErrInvalidTx) Error() string {
+	return fmt.Sprintf("invalid tx %d: %v", e.I, e.Err)
+}
+
+func (e ErrInvalidTx) Unwrap() error {
+	return e.Err
+}
+
+type ErrInvalidBlock struct {
+	I   int
+	Err error
+}
+
+func (e ErrInvalidBlock) Error() string {
+	return fmt.Sprintf("invalid block %d: %v", e.I, e.Err)
+}
+
+func (e ErrInvalidBlock) Unwrap() error {
+	return e.Err
+}
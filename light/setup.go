@@ -2,9 +2,11 @@ package light
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/light/provider/grpc"
 	"github.com/cometbft/cometbft/light/provider/http"
 	"github.com/cometbft/cometbft/light/store"
 )
@@ -67,6 +69,72 @@ func NewHTTPClientFromTrustedStore(
 		options...)
 }
 
+// NewGRPCClient initiates an instance of a light client using the gRPC block
+// service of the nodes at the given addresses for both the primary provider
+// and witnesses of the light client. A trusted header and hash must be passed
+// to initialize the client.
+//
+// The connections to the nodes are released by Client.Close.
+//
+// See all Option(s) for the additional configuration.
+// See NewClient.
+func NewGRPCClient(
+	ctx context.Context,
+	chainID string,
+	trustOptions TrustOptions,
+	primaryAddress string,
+	witnessesAddresses []string,
+	trustedStore store.Store,
+	options ...Option,
+) (*Client, error) {
+	providers, conns, err := grpcProvidersFromAddresses(append(witnessesAddresses, primaryAddress), chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		providers[len(providers)-1],
+		providers[:len(providers)-1],
+		trustedStore,
+		options...)
+	return withConns(c, conns, err)
+}
+
+// NewGRPCClientFromTrustedStore initiates an instance of a light client using
+// the gRPC block service of the nodes at the given addresses for both the
+// primary provider and witnesses and uses a trusted store as the root of
+// trust.
+//
+// The connections to the nodes are released by Client.Close.
+//
+// See all Option(s) for the additional configuration.
+// See NewClientFromTrustedStore.
+func NewGRPCClientFromTrustedStore(
+	chainID string,
+	trustingPeriod time.Duration,
+	primaryAddress string,
+	witnessesAddresses []string,
+	trustedStore store.Store,
+	options ...Option,
+) (*Client, error) {
+	providers, conns, err := grpcProvidersFromAddresses(append(witnessesAddresses, primaryAddress), chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := NewClientFromTrustedStore(
+		chainID,
+		trustingPeriod,
+		providers[len(providers)-1],
+		providers[:len(providers)-1],
+		trustedStore,
+		options...)
+	return withConns(c, conns, err)
+}
+
 func providersFromAddresses(addrs []string, chainID string) ([]provider.Provider, error) {
 	providers := make([]provider.Provider, len(addrs))
 	for idx, address := range addrs {
@@ -78,3 +146,35 @@ func providersFromAddresses(addrs []string, chainID string) ([]provider.Provider
 	}
 	return providers, nil
 }
+
+func grpcProvidersFromAddresses(addrs []string, chainID string) ([]provider.Provider, []io.Closer, error) {
+	providers := make([]provider.Provider, len(addrs))
+	conns := make([]io.Closer, 0, len(addrs))
+	for idx, address := range addrs {
+		p, err := grpc.New(chainID, address)
+		if err != nil {
+			closeAll(conns)
+			return nil, nil, err
+		}
+		providers[idx] = p
+		conns = append(conns, p)
+	}
+	return providers, conns, nil
+}
+
+// withConns hands the connections over to the client, or closes them if the
+// client could not be created.
+func withConns(c *Client, conns []io.Closer, err error) (*Client, error) {
+	if err != nil {
+		closeAll(conns)
+		return nil, err
+	}
+	c.conns = conns
+	return c, nil
+}
+
+func closeAll(conns []io.Closer) {
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+}
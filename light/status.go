@@ -0,0 +1,176 @@
+package light
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Weight of the latest request in the moving average of the latency of a
+// provider.
+const providerLatencySmoothing = 0.2
+
+// ProviderStatus is the health of a provider, as observed by the light client.
+type ProviderStatus struct {
+	Address string `json:"address"`
+	// Number of light blocks requested to the provider.
+	Requests uint64 `json:"requests"`
+	// Number of requests which failed, excluding the ones canceled by the
+	// light client.
+	Failures uint64 `json:"failures"`
+	// Number of times the provider, as a witness, reported a light block
+	// conflicting with the one of the primary.
+	Divergences uint64 `json:"divergences"`
+	// Moving average of the duration of the successful requests.
+	Latency   time.Duration `json:"latency"`
+	LastError string        `json:"last_error,omitempty"`
+}
+
+// Status is the state of the light client and of its providers.
+type Status struct {
+	ChainID             string           `json:"chain_id"`
+	LatestTrustedHeight int64            `json:"latest_trusted_height"`
+	LatestTrustedTime   time.Time        `json:"latest_trusted_time"`
+	Primary             ProviderStatus   `json:"primary"`
+	Witnesses           []ProviderStatus `json:"witnesses"`
+}
+
+// providerStats tracks the requests to the providers of the light client. It
+// has its own lock, so that the status can be reported while the providers
+// are in use.
+type providerStats struct {
+	mtx       cmtsync.Mutex
+	stats     map[provider.Provider]*ProviderStatus
+	primary   provider.Provider
+	witnesses []provider.Provider
+}
+
+func newProviderStats() *providerStats {
+	return &providerStats{stats: make(map[provider.Provider]*ProviderStatus)}
+}
+
+// NOTE: requires a lock.
+func (ps *providerStats) get(p provider.Provider) *ProviderStatus {
+	s, ok := ps.stats[p]
+	if !ok {
+		s = &ProviderStatus{}
+		ps.stats[p] = s
+	}
+	return s
+}
+
+// providerAddress returns the description of a provider, which includes its
+// address for the providers of this module.
+func providerAddress(p provider.Provider) string {
+	return fmt.Sprint(p)
+}
+
+// setProviders records the current primary and witnesses, and forgets the
+// statistics of the providers that were removed.
+func (ps *providerStats) setProviders(primary provider.Provider, witnesses []provider.Provider) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	ps.primary = primary
+	ps.witnesses = append([]provider.Provider(nil), witnesses...)
+
+	for p := range ps.stats {
+		if p != primary && !slices.Contains(witnesses, p) {
+			delete(ps.stats, p)
+		}
+	}
+}
+
+func (ps *providerStats) recordRequest(p provider.Provider, latency time.Duration, err error) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	s := ps.get(p)
+	s.Requests++
+	if err != nil {
+		s.Failures++
+		s.LastError = err.Error()
+		return
+	}
+	if s.Latency == 0 {
+		s.Latency = latency
+	} else {
+		s.Latency = time.Duration(providerLatencySmoothing*float64(latency) +
+			(1-providerLatencySmoothing)*float64(s.Latency))
+	}
+}
+
+func (ps *providerStats) recordDivergence(p provider.Provider) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	ps.get(p).Divergences++
+}
+
+// NOTE: requires a lock.
+func (ps *providerStats) status(p provider.Provider) ProviderStatus {
+	s := *ps.get(p)
+	s.Address = providerAddress(p)
+	return s
+}
+
+func (ps *providerStats) providersStatus() (ProviderStatus, []ProviderStatus) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	witnesses := make([]ProviderStatus, len(ps.witnesses))
+	for i, w := range ps.witnesses {
+		witnesses[i] = ps.status(w)
+	}
+	return ps.status(ps.primary), witnesses
+}
+
+// lightBlock requests the light block at the given height from provider p,
+// recording the outcome in the provider statistics and metrics.
+func (c *Client) lightBlock(ctx context.Context, p provider.Provider, height int64) (*types.LightBlock, error) {
+	start := time.Now()
+	l, err := p.LightBlock(ctx, height)
+	if err != nil && ctx.Err() != nil {
+		// The request was canceled by the light client.
+		return l, err
+	}
+
+	latency := time.Since(start)
+	c.stats.recordRequest(p, latency, err)
+	if err != nil {
+		c.metrics.ProviderFailures.With("provider", providerAddress(p)).Add(1)
+	} else {
+		c.metrics.ProviderRequestDurationSeconds.With("provider", providerAddress(p)).Observe(latency.Seconds())
+	}
+	return l, err
+}
+
+// recordDivergence records that the witness reported a light block
+// conflicting with the one of the primary.
+func (c *Client) recordDivergence(witness provider.Provider) {
+	c.stats.recordDivergence(witness)
+	c.metrics.Divergences.With("provider", providerAddress(witness)).Add(1)
+}
+
+// Status returns the latest trusted light block height and time, and the
+// health of the primary and witnesses, as observed by the light client.
+//
+// Safe for concurrent use by multiple goroutines. It does not wait for the
+// ongoing requests to the providers.
+func (c *Client) Status() *Status {
+	primary, witnesses := c.stats.providersStatus()
+	status := &Status{
+		ChainID:             c.chainID,
+		LatestTrustedHeight: -1,
+		Primary:             primary,
+		Witnesses:           witnesses,
+	}
+	if height, err := c.LastTrustedHeight(); err == nil && height > 0 {
+		if l, err := c.trustedStore.LightBlock(height); err == nil {
+			status.LatestTrustedHeight = l.Height
+			status.LatestTrustedTime = l.Time
+		}
+	}
+	return status
+}
@@ -0,0 +1,32 @@
+package light
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/light/provider"
+	mockp "github.com/cometbft/cometbft/light/provider/mock"
+)
+
+func TestProviderStats_SetProviders(t *testing.T) {
+	primary, witness, removed := mockp.NewDeadMock("a"), mockp.NewDeadMock("b"), mockp.NewDeadMock("c")
+	ps := newProviderStats()
+	ps.setProviders(primary, []provider.Provider{witness, removed})
+	for _, p := range []provider.Provider{primary, witness, removed} {
+		ps.recordRequest(p, 0, errors.New("no response"))
+	}
+	require.Len(t, ps.stats, 3)
+
+	// The statistics of a removed witness are forgotten, while the ones of
+	// the providers still in use are kept.
+	ps.setProviders(witness, []provider.Provider{primary})
+	assert.Len(t, ps.stats, 2)
+	assert.NotContains(t, ps.stats, removed)
+	primaryStatus, witnesses := ps.providersStatus()
+	assert.EqualValues(t, 1, primaryStatus.Failures)
+	require.Len(t, witnesses, 1)
+	assert.EqualValues(t, 1, witnesses[0].Failures)
+}
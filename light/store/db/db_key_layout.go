@@ -3,10 +3,14 @@ package db
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 
 	"github.com/google/orderedcode"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/internal/keymigrate"
 )
 
 type LightStoreKeyLayout interface {
@@ -125,3 +129,66 @@ func (v2Layout) SizeKey(prefix string) []byte {
 }
 
 var _ LightStoreKeyLayout = v2Layout{}
+
+// MigrateKeyLayoutToV2 rewrites the keys of a light store database from the
+// v1 to the v2 layout. It returns the number of keys rewritten, and reports
+// the progress to progress if it is not nil. The database must not be in use
+// while it is migrated; an interrupted migration is resumed by calling
+// MigrateKeyLayoutToV2 again.
+func MigrateKeyLayoutToV2(db dbm.DB, progress func(migrated int64)) (int64, error) {
+	return keymigrate.MigrateV1ToV2(db, v1KeyToV2,
+		keymigrate.WithProgress(progress),
+		keymigrate.WithFinalize(recomputeSizes))
+}
+
+// recomputeSizes replaces the v1 size key, which is shared by all the
+// prefixes, with the v2 size key of each prefix, computed from the light
+// blocks.
+func recomputeSizes(db dbm.DB, b dbm.Batch) error {
+	sizes, err := countLightBlocks(db)
+	if err != nil {
+		return err
+	}
+	var v2 v2Layout
+	for prefix, size := range sizes {
+		if err := b.Set(v2.SizeKey(prefix), marshalSize(size)); err != nil {
+			return err
+		}
+	}
+	return b.Delete(v1LegacyLayout{}.SizeKey(""))
+}
+
+// v1KeyToV2 translates a light block key of the v1 layout to the v2 layout.
+func v1KeyToV2(key []byte) ([]byte, bool, error) {
+	part, prefix, height, err := parseKey(key)
+	if err != nil || part != "lb" {
+		return nil, false, nil //nolint:nilerr // not a v1 light block key
+	}
+	return v2Layout{}.LBKey(height, prefix), true, nil
+}
+
+// countLightBlocks returns the number of v2 light block keys in db per prefix.
+func countLightBlocks(db dbm.DB) (map[string]uint16, error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	sizes := make(map[string]uint16)
+	for ; it.Valid(); it.Next() {
+		var (
+			prefix           string
+			lightBlockPrefix int64
+			height           int64
+		)
+		remaining, err := orderedcode.Parse(string(it.Key()), &prefix, &lightBlockPrefix, &height)
+		if err != nil || len(remaining) != 0 || lightBlockPrefix != prefixLightBlock {
+			continue
+		}
+		if sizes[prefix] < math.MaxUint16 {
+			sizes[prefix]++
+		}
+	}
+	return sizes, it.Error()
+}
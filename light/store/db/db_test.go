@@ -133,6 +133,47 @@ func TestDBKeyLayoutVersioning(t *testing.T) {
 	require.Equal(t, lightBlock.ConsensusHash, lb.ConsensusHash)
 }
 
+func TestMigrateKeyLayoutToV2(t *testing.T) {
+	db := dbm.NewMemDB()
+	prefixes := map[string]int64{"MigrateA": 3, "MigrateB": 5}
+	for prefix, n := range prefixes {
+		dbStore := New(db, prefix)
+		for h := int64(1); h <= n; h++ {
+			require.NoError(t, dbStore.SaveLightBlock(randLightBlock(h)))
+		}
+	}
+
+	migrated, err := MigrateKeyLayoutToV2(db, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 8, migrated)
+
+	has, err := db.Has(v1LegacyLayout{}.SizeKey(""))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	for prefix, n := range prefixes {
+		// The recorded layout takes precedence over the requested one.
+		dbStore := New(db, prefix)
+		assert.EqualValues(t, n, dbStore.Size())
+
+		first, err := dbStore.FirstLightBlockHeight()
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, first)
+		last, err := dbStore.LastLightBlockHeight()
+		require.NoError(t, err)
+		assert.EqualValues(t, n, last)
+
+		lb, err := dbStore.LightBlock(n)
+		require.NoError(t, err)
+		assert.EqualValues(t, n, lb.Height)
+	}
+
+	// Migrating again does nothing.
+	migrated, err = MigrateKeyLayoutToV2(db, nil)
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+}
+
 func TestLast_FirstLightBlockHeight(t *testing.T) {
 	dbStore := New(dbm.NewMemDB(), "TestLast_FirstLightBlockHeight")
 
@@ -54,7 +54,15 @@ func VerifyNonAdjacent(
 
 	verifiedSignatureCache := types.NewSignatureCache()
 	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
-	err := trustedVals.VerifyCommitLightTrustingWithCache(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel, verifiedSignatureCache)
+	var err error
+	if untrustedHeader.Commit.IsAggregated() {
+		// The aggregated signature can only be verified with the keys of the
+		// validator set that signed the commit.
+		err = types.VerifyAggregatedCommitLightTrusting(trustedHeader.ChainID, trustedVals, untrustedVals,
+			untrustedHeader.Commit, trustLevel)
+	} else {
+		err = trustedVals.VerifyCommitLightTrustingWithCache(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel, verifiedSignatureCache)
+	}
 	if err != nil {
 		switch e := err.(type) {
 		case types.ErrNotEnoughVotingPowerSigned:
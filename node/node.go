@@ -1,14 +1,19 @@
 package node
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -17,7 +22,9 @@ import (
 
 	_ "net/http/pprof" //nolint: gosec
 
+	dbm "github.com/cometbft/cometbft-db"
 	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	bc "github.com/cometbft/cometbft/internal/blocksync"
 	cs "github.com/cometbft/cometbft/internal/consensus"
@@ -32,6 +39,7 @@ import (
 	na "github.com/cometbft/cometbft/p2p/netaddr"
 	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/p2p/transport/tcp"
+	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
 	grpcserver "github.com/cometbft/cometbft/rpc/grpc/server"
@@ -57,6 +65,7 @@ type Node struct {
 	config        *cfg.Config
 	genesisTime   time.Time
 	privValidator types.PrivValidator // local node's validator key
+	auditLog      *privval.AuditLog   // audit log of privValidator, if any
 
 	// network
 	transport   *tcp.MultiplexTransport
@@ -172,7 +181,10 @@ func BootstrapState(ctx context.Context, config *cfg.Config, dbProvider cfg.DBPr
 	if dbProvider == nil {
 		dbProvider = cfg.DefaultDBProvider
 	}
-	blockStoreDB, stateDB, err := initDBs(config, dbProvider)
+	blockStoreDB, stateDB, err := initDBs(config, dbProvider, logger)
+	if err != nil {
+		return err
+	}
 
 	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(store.NopMetrics()), store.WithCompaction(config.Storage.Compact, config.Storage.CompactionInterval), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
 	logger.Info("Blockstore version", "version", blockStore.GetVersion())
@@ -255,25 +267,304 @@ func BootstrapState(ctx context.Context, config *cfg.Config, dbProvider cfg.DBPr
 		return err
 	}
 
-	if err = stateStore.Bootstrap(state); err != nil {
+	return bootstrapStores(stateStore, blockStore, state, commit)
+}
+
+// BootstrapStateFromSnapshotFile restores the application from the snapshot
+// file at path and synchronizes the stores with it, without any peer or RPC
+// server. The snapshot is verified from the trusted header given by the trust
+// options in the [statesync] section of the config. It is expected that the
+// block store and state store are empty at the time the function is called.
+//
+// If the block store is not empty, the function returns an error.
+func BootstrapStateFromSnapshotFile(
+	config *cfg.Config,
+	dbProvider cfg.DBProvider,
+	genProvider GenesisDocProvider,
+	clientCreator proxy.ClientCreator,
+	path string,
+) (err error) {
+	logger := log.NewLogger(os.Stdout)
+	if config == nil {
+		logger.Info("no config provided, using default configuration")
+		config = cfg.DefaultConfig()
+	}
+
+	if dbProvider == nil {
+		dbProvider = cfg.DefaultDBProvider
+	}
+	blockStoreDB, stateDB, err := initDBs(config, dbProvider, logger)
+	if err != nil {
 		return err
 	}
 
-	err = blockStore.SaveSeenCommit(state.LastBlockHeight, commit)
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(store.NopMetrics()), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	defer func() {
+		if derr := blockStore.Close(); derr != nil {
+			logger.Error("Failed to close blockstore", "err", derr)
+			// Set the return value
+			err = derr
+		}
+	}()
+
+	if !blockStore.IsEmpty() {
+		return ErrNonEmptyBlockStore
+	}
+
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		Logger:               logger,
+		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
+	})
+	defer func() {
+		if derr := stateStore.Close(); derr != nil {
+			logger.Error("Failed to close statestore", "err", derr)
+			// Set the return value
+			err = derr
+		}
+	}()
+
+	state, err := stateStore.Load()
 	if err != nil {
 		return err
 	}
+	if !state.IsEmpty() {
+		return ErrNonEmptyState
+	}
+
+	genState, _, err := LoadStateFromDBOrGenesisDocProvider(stateDB, genProvider, "")
+	if err != nil {
+		return err
+	}
+
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, proxy.NopMetrics())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if derr := proxyApp.Stop(); derr != nil {
+			logger.Error("Failed to stop proxy app connections", "err", derr)
+		}
+	}()
+
+	_, err = restoreSnapshotFile(path, config, stateStore, blockStore, proxyApp, genState, logger)
+	return err
+}
+
+// restoreSnapshotFile restores the application from the snapshot file at path
+// and bootstraps the stores with the resulting state.
+func restoreSnapshotFile(
+	path string,
+	config *cfg.Config,
+	stateStore sm.Store,
+	blockStore *store.BlockStore,
+	proxyApp proxy.AppConns,
+	genState sm.State,
+	logger log.Logger,
+) (sm.State, error) {
+	logger.Info("Restoring snapshot file", "path", path)
+	state, commit, err := statesync.RestoreSnapshotFile(path, *config.StateSync,
+		proxyApp.Snapshot(), proxyApp.Query(),
+		genState.ChainID, genState.Version, genState.InitialHeight,
+		light.TrustOptions{
+			Period: config.StateSync.TrustPeriod,
+			Height: config.StateSync.TrustHeight,
+			Hash:   config.StateSync.TrustHashBytes(),
+		}, logger.With("module", "statesync"))
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	if err := bootstrapStores(stateStore, blockStore, state, commit); err != nil {
+		return sm.State{}, err
+	}
+	logger.Info("Restored snapshot file", "height", state.LastBlockHeight, "app_hash", state.AppHash)
+	return state, nil
+}
+
+// ExportSnapshotFile writes the snapshot of the application at the given
+// height to a file at path, in the format read by BootstrapStateFromSnapshotFile.
+// If height is 0, the latest snapshot of the application is exported. The file
+// includes the light blocks needed to verify the snapshot from trustHeight,
+// which defaults to the snapshot height if 0.
+//
+// The block store must contain the blocks from the lower of the snapshot and
+// trusted heights up to two blocks above the higher one.
+func ExportSnapshotFile(
+	config *cfg.Config,
+	dbProvider cfg.DBProvider,
+	clientCreator proxy.ClientCreator,
+	path string,
+	height uint64,
+	trustHeight int64,
+) (err error) {
+	logger := log.NewLogger(os.Stdout)
+	if config == nil {
+		logger.Info("no config provided, using default configuration")
+		config = cfg.DefaultConfig()
+	}
+
+	if dbProvider == nil {
+		dbProvider = cfg.DefaultDBProvider
+	}
+	blockStoreDB, stateDB, err := initDBs(config, dbProvider, logger)
+	if err != nil {
+		return err
+	}
+
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(store.NopMetrics()), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	defer func() {
+		if derr := blockStore.Close(); derr != nil {
+			logger.Error("Failed to close blockstore", "err", derr)
+			// Set the return value
+			err = derr
+		}
+	}()
+
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		Logger:               logger,
+		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
+	})
+	defer func() {
+		if derr := stateStore.Close(); derr != nil {
+			logger.Error("Failed to close statestore", "err", derr)
+			// Set the return value
+			err = derr
+		}
+	}()
+
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, proxy.NopMetrics())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if derr := proxyApp.Stop(); derr != nil {
+			logger.Error("Failed to stop proxy app connections", "err", derr)
+		}
+	}()
+
+	ctx := context.TODO()
+	res, err := proxyApp.Snapshot().ListSnapshots(ctx, &abci.ListSnapshotsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	var snapshot *abci.Snapshot
+	for _, s := range res.Snapshots {
+		if (height == 0 || s.Height == height) &&
+			(snapshot == nil || s.Height > snapshot.Height || (s.Height == snapshot.Height && s.Format > snapshot.Format)) {
+			snapshot = s
+		}
+	}
+	if snapshot == nil {
+		if height == 0 {
+			return errors.New("the application has no snapshot")
+		}
+		return fmt.Errorf("the application has no snapshot at height %d", height)
+	}
+
+	// The light blocks below the trusted height must be adjacent, as they are
+	// verified backwards through the hashes of the headers.
+	snapshotHeight := int64(snapshot.Height)
+	if trustHeight == 0 {
+		trustHeight = snapshotHeight
+	}
+	heights := []int64{snapshotHeight, snapshotHeight + 1, snapshotHeight + 2}
+	if trustHeight < snapshotHeight {
+		heights = append([]int64{trustHeight}, heights...)
+	}
+	for h := snapshotHeight + 3; h <= trustHeight; h++ {
+		heights = append(heights, h)
+	}
+	lightBlocks := make([]*types.LightBlock, 0, len(heights))
+	for _, h := range heights {
+		lb, err := loadLightBlock(blockStore, stateStore, h)
+		if err != nil {
+			return err
+		}
+		lightBlocks = append(lightBlocks, lb)
+	}
+	params, err := stateStore.LoadConsensusParams(snapshotHeight + 1)
+	if err != nil {
+		return fmt.Errorf("failed to load consensus params at height %d: %w", snapshotHeight+1, err)
+	}
+
+	logger.Info("Exporting snapshot file", "path", path, "height", snapshot.Height,
+		"format", snapshot.Format, "chunks", snapshot.Chunks, "trust_height", trustHeight)
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	err = statesync.WriteSnapshotFile(w, snapshot, params, lightBlocks, func(index uint32) ([]byte, error) {
+		res, err := proxyApp.Snapshot().LoadSnapshotChunk(ctx, &abci.LoadSnapshotChunkRequest{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if res.Chunk == nil {
+			return nil, errors.New("the application returned no chunk")
+		}
+		return res.Chunk, nil
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot file: %w", err)
+	}
+	logger.Info("Exported snapshot file", "path", path, "height", snapshot.Height)
+	return nil
+}
+
+// loadLightBlock loads the light block at the given height from the stores.
+func loadLightBlock(blockStore *store.BlockStore, stateStore sm.Store, height int64) (*types.LightBlock, error) {
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("no block at height %d in the block store", height)
+	}
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil, fmt.Errorf("no commit at height %d in the block store", height)
+	}
+	vals, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load validators at height %d: %w", height, err)
+	}
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &meta.Header, Commit: commit},
+		ValidatorSet: vals,
+	}, nil
+}
+
+// bootstrapStores saves the state and commit obtained by syncing the
+// application offline to the empty stores.
+func bootstrapStores(stateStore sm.Store, blockStore *store.BlockStore, state sm.State, commit *types.Commit) error {
+	if err := stateStore.Bootstrap(state); err != nil {
+		return err
+	}
+
+	if err := blockStore.SaveSeenCommit(state.LastBlockHeight, commit); err != nil {
+		return err
+	}
 
 	// Once the stores are bootstrapped, we need to set the height at which the node has finished
 	// statesyncing. This will allow the blocksync reactor to fetch blocks at a proper height.
 	// In case this operation fails, it is equivalent to a failure in  online state sync where the operator
 	// needs to manually delete the state and blockstores and rerun the bootstrapping process.
-	err = stateStore.SetOfflineStateSyncHeight(state.LastBlockHeight)
-	if err != nil {
+	if err := stateStore.SetOfflineStateSyncHeight(state.LastBlockHeight); err != nil {
 		return ErrSetSyncHeight{Err: err}
 	}
-
-	return err
+	return nil
 }
 
 // ------------------------------------------------------------------------------
@@ -319,7 +610,7 @@ func NewNodeWithCliParams(ctx context.Context,
 	cliParams CliParams,
 	options ...Option,
 ) (*Node, error) {
-	blockStoreDB, stateDB, err := initDBs(config, dbProvider)
+	blockStoreDB, stateDB, err := initDBs(config, dbProvider, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -379,8 +670,13 @@ func NewNodeWithCliParams(ctx context.Context,
 	}
 
 	// If an address is provided, listen on the socket for a connection from an
-	// external signing process.
-	if config.PrivValidatorListenAddr != "" {
+	// external signing process, or connect to a gRPC remote signer.
+	if strings.HasPrefix(config.PrivValidatorListenAddr, "grpc://") {
+		privValidator, err = createPrivValidatorGRPCClient(config, genDoc.ChainID, logger)
+		if err != nil {
+			return nil, ErrPrivValidatorSocketClient{Err: err}
+		}
+	} else if config.PrivValidatorListenAddr != "" {
 		// FIXME: we should start services inside OnStart
 		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
 		if err != nil {
@@ -401,6 +697,19 @@ func NewNodeWithCliParams(ctx context.Context,
 		stateSync = false
 	}
 
+	// Restore the snapshot file before the handshake, so that the handshake
+	// finds the app and the stores at the height of the snapshot.
+	if config.StateSync.SnapshotFile != "" {
+		if state.LastBlockHeight > 0 || !blockStore.IsEmpty() {
+			logger.Info("Found local state with non-zero height, skipping snapshot file restore")
+		} else {
+			state, err = restoreSnapshotFile(config.StateSync.SnapshotFile, config, stateStore, blockStore, proxyApp, state, logger)
+			if err != nil {
+				return nil, fmt.Errorf("failed to restore snapshot file: %w", err)
+			}
+		}
+	}
+
 	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
 	// and replays any blocks as necessary to sync CometBFT with the app.
 	consensusLogger := logger.With("module", "consensus")
@@ -473,6 +782,15 @@ func NewNodeWithCliParams(ctx context.Context,
 	if err != nil {
 		return nil, ErrCreateBlockSyncReactor{Err: err}
 	}
+	// Sync the blocks found in the local block archive, if any, before block
+	// syncing the remaining ones from peers.
+	if config.BlockSync.ArchivePath != "" {
+		if blockSync {
+			bcReactor.(*bc.Reactor).SetArchive(config.BlockSync.ArchivePath, config.DBBackend)
+		} else {
+			logger.Info("Block sync disabled, skipping block archive sync")
+		}
+	}
 
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
@@ -487,11 +805,17 @@ func NewNodeWithCliParams(ctx context.Context,
 	// FIXME The way we do phased startups (e.g. replay -> block sync -> consensus) is very messy,
 	// we should clean this whole thing up. See:
 	// https://github.com/tendermint/tendermint/issues/4644
+	stateSyncOptions := []statesync.ReactorOption{statesync.ReactorStores(stateStore, blockStore)}
+	if config.StateSync.PersistProgress {
+		stateSyncOptions = append(stateSyncOptions,
+			statesync.ReactorProgressDir(filepath.Join(config.DBDir(), "statesync")))
+	}
 	stateSyncReactor := statesync.NewReactor(
 		*config.StateSync,
 		proxyApp.Snapshot(),
 		proxyApp.Query(),
 		ssMetrics,
+		stateSyncOptions...,
 	)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
@@ -694,10 +1018,18 @@ func (n *Node) OnStop() {
 		}
 	}
 
-	if pvsc, ok := n.privValidator.(service.Service); ok {
+	switch pvsc := n.privValidator.(type) {
+	case service.Service:
 		if err := pvsc.Stop(); err != nil {
 			n.Logger.Error("Error closing private validator", "err", err)
 		}
+	case io.Closer:
+		if err := pvsc.Close(); err != nil {
+			n.Logger.Error("Error closing private validator", "err", err)
+		}
+	}
+	if err := n.auditLog.Close(); err != nil {
+		n.Logger.Error("Error closing the audit log", "err", err)
 	}
 
 	if n.prometheusSrv != nil {
@@ -880,11 +1212,18 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 			opts = append(opts, grpcserver.WithVersionService())
 		}
 		if n.config.GRPC.BlockService.Enabled {
-			opts = append(opts, grpcserver.WithBlockService(n.blockStore, n.eventBus, n.Logger))
+			opts = append(opts, grpcserver.WithBlockServiceValidators(n.blockStore, n.stateStore, n.eventBus, n.Logger))
 		}
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.SnapshotService.Enabled {
+			opts = append(opts, grpcserver.WithSnapshotService(n.proxyApp.Snapshot(),
+				n.config.GRPC.SnapshotService.SendRate, n.Logger))
+		}
+		if n.config.GRPC.ConsensusTimelineService.Enabled {
+			opts = append(opts, grpcserver.WithConsensusTimelineService(n.consensusState, n.Logger))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -1134,9 +1473,46 @@ func createPruner(
 		prunerOpts = append(prunerOpts, sm.WithPrunerCompanionEnabled())
 	}
 
+	if maxAge := config.Storage.Pruning.MaxBlockAge; maxAge > 0 {
+		prunerOpts = append(prunerOpts, sm.WithPrunerMaxBlockAge(maxAge))
+	}
+	if maxBytes := config.Storage.Pruning.MaxBlockStoreBytes; maxBytes > 0 {
+		if dbm.BackendType(config.DBBackend) == dbm.MemDBBackend {
+			return nil, errors.New("max_block_store_bytes is not supported with the memdb backend")
+		}
+		blockStorePath := filepath.Join(config.DBDir(), "blockstore.db")
+		// Badger does not support database names and uses a directory per database.
+		if dbm.BackendType(config.DBBackend) == dbm.BadgerDBBackend {
+			blockStorePath = filepath.Join(config.DBDir(), "blockstore")
+		}
+		prunerOpts = append(prunerOpts, sm.WithPrunerMaxBlockStoreSize(maxBytes, func() (int64, error) {
+			return dirSize(blockStorePath)
+		}))
+	}
+
 	return sm.NewPruner(stateStore, blockStore, blockIndexer, txIndexer, logger, prunerOpts...), nil
 }
 
+// dirSize returns the total size of the files under path.
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
 // Set the initial application retain height to 0 to avoid the data companion
 // pruning blocks before the application indicates it is OK. We set this to 0
 // only if the retain height was not set before by the application.
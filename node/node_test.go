@@ -721,11 +721,35 @@ func TestNodeGenesisHashFlagMismatch(t *testing.T) {
 	require.False(t, genHashMismatch)
 }
 
+func TestInitDBsMigratesLightDBKeyLayout(t *testing.T) {
+	config := test.ResetTestRoot(t.Name())
+	config.DBBackend = string(dbm.PebbleDBBackend)
+	config.Storage.ExperimentalKeyLayout = "v2"
+	config.Storage.ExperimentalMigrateKeyLayout = true
+
+	lightDB, err := dbm.NewDB("light", dbm.PebbleDBBackend, config.DBDir())
+	require.NoError(t, err)
+	require.NoError(t, lightDB.SetSync([]byte("version"), []byte("v1")))
+	require.NoError(t, lightDB.Close())
+
+	bsDB, stateDB, err := initDBs(config, cfg.DefaultDBProvider, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, bsDB.Close())
+	require.NoError(t, stateDB.Close())
+
+	lightDB, err = dbm.NewDB("light", dbm.PebbleDBBackend, config.DBDir())
+	require.NoError(t, err)
+	defer lightDB.Close()
+	version, err := lightDB.Get([]byte("version"))
+	require.NoError(t, err)
+	require.Equal(t, "v2", string(version))
+}
+
 func TestLoadStateFromDBOrGenesisDocProviderWithConfig(t *testing.T) {
 	config := test.ResetTestRoot(t.Name())
 	config.DBBackend = string(dbm.PebbleDBBackend)
 
-	_, stateDB, err := initDBs(config, cfg.DefaultDBProvider)
+	_, stateDB, err := initDBs(config, cfg.DefaultDBProvider, log.TestingLogger())
 	require.NoErrorf(t, err, "state DB setup: %s", err)
 
 	genDocProviderFunc := func(sha256Checksum []byte) GenesisDocProvider {
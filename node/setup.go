@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"strings"
@@ -22,8 +24,10 @@ import (
 	"github.com/cometbft/cometbft/internal/blocksync"
 	cs "github.com/cometbft/cometbft/internal/consensus"
 	"github.com/cometbft/cometbft/internal/evidence"
+	"github.com/cometbft/cometbft/internal/keymigrate"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/light"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
 	na "github.com/cometbft/cometbft/p2p/netaddr"
@@ -122,8 +126,19 @@ func DefaultNewNode(
 			StateFile: config.PrivValidatorStateFile(),
 		}
 	}
+	// The audit log is kept by the file-based private validator, which is
+	// only used when no remote signer is set. It is closed when the node
+	// stops.
+	var auditLog *privval.AuditLog
+	if path := config.PrivValidatorAuditLogFile(); path != "" && config.PrivValidatorListenAddr == "" {
+		auditLog, err = privval.OpenAuditLog(path, logger.With("module", "privval"))
+		if err != nil {
+			return nil, err
+		}
+		pv.SetAuditLog(auditLog)
+	}
 
-	return NewNodeWithCliParams(context.Background(), config,
+	n, err := NewNodeWithCliParams(context.Background(), config,
 		pv,
 		nodeKey,
 		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
@@ -132,7 +147,15 @@ func DefaultNewNode(
 		DefaultMetricsProvider(config.Instrumentation),
 		logger,
 		cliParams,
+		func(n *Node) { n.auditLog = auditLog },
 	)
+	if err != nil {
+		if cerr := auditLog.Close(); cerr != nil {
+			logger.Error("Error closing the audit log", "err", cerr)
+		}
+		return nil, err
+	}
+	return n, nil
 }
 
 // MetricsProvider returns a consensus, p2p and mempool Metrics.
@@ -162,20 +185,92 @@ type blockSyncReactor interface {
 
 // ------------------------------------------------------------------------------
 
-func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (bsDB dbm.DB, stateDB dbm.DB, err error) {
+func initDBs(config *cfg.Config, dbProvider cfg.DBProvider, logger log.Logger) (bsDB dbm.DB, stateDB dbm.DB, err error) {
 	bsDB, err = dbProvider(&cfg.DBContext{ID: "blockstore", Config: config})
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := migrateDBKeyLayout(config, "blockstore", bsDB, store.MigrateKeyLayoutToV2, logger); err != nil {
+		return nil, nil, err
+	}
 
 	stateDB, err = dbProvider(&cfg.DBContext{ID: "state", Config: config})
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := migrateDBKeyLayout(config, "state", stateDB, sm.MigrateKeyLayoutToV2, logger); err != nil {
+		return nil, nil, err
+	}
+	if err := migrateLightDBKeyLayout(config, dbProvider, logger); err != nil {
+		return nil, nil, err
+	}
 
 	return bsDB, stateDB, nil
 }
 
+// migrateLightDBKeyLayout migrates the light client database kept in the data
+// directory, if any, to the v2 key layout if experimental_migrate_db_key_layout
+// is enabled. The node does not use this database itself, but light clients
+// run along with it do.
+func migrateLightDBKeyLayout(config *cfg.Config, dbProvider cfg.DBProvider, logger log.Logger) error {
+	backend := dbm.BackendType(config.DBBackend)
+	if !config.Storage.ExperimentalMigrateKeyLayout || backend == dbm.MemDBBackend ||
+		!cfg.DBExists("light", backend, config.DBDir()) {
+		return nil
+	}
+
+	lightDB, err := dbProvider(&cfg.DBContext{ID: "light", Config: config})
+	if err != nil {
+		return err
+	}
+	err = migrateDBKeyLayout(config, "light", lightDB, lightdb.MigrateKeyLayoutToV2, logger)
+	if closeErr := lightDB.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// migrateDBKeyLayout migrates db to the v2 key layout if
+// experimental_migrate_db_key_layout is enabled. This is a startup migration:
+// the stores only read keys of a single layout, so it blocks until the
+// migration is complete, logging its progress after every batch of keys.
+func migrateDBKeyLayout(
+	config *cfg.Config,
+	name string,
+	db dbm.DB,
+	migrate func(db dbm.DB, progress func(migrated int64)) (int64, error),
+	logger log.Logger,
+) error {
+	if !config.Storage.ExperimentalMigrateKeyLayout {
+		return nil
+	}
+
+	version, err := keymigrate.Version(db)
+	if err != nil {
+		return fmt.Errorf("failed to read the %s key layout version: %w", name, err)
+	}
+	if version == "v2" {
+		return nil
+	}
+
+	start := time.Now()
+	logger.Info("Migrating database to the v2 key layout before starting the node; "+
+		"use the migrate-db-key-layout command to migrate offline",
+		"db", name)
+	migrated, err := migrate(db, func(migrated int64) {
+		logger.Info("Migrating database to the v2 key layout",
+			"db", name, "keys", migrated, "elapsed", time.Since(start))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate the %s key layout to v2: %w", name, err)
+	}
+	if migrated > 0 {
+		logger.Info("Migrated database to the v2 key layout",
+			"db", name, "keys", migrated, "duration", time.Since(start))
+	}
+	return nil
+}
+
 func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger, metrics *proxy.Metrics) (proxy.AppConns, error) {
 	proxyApp := proxy.NewAppConns(clientCreator, metrics)
 	proxyApp.SetLogger(logger.With("module", "proxy"))
@@ -340,6 +435,9 @@ func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := migrateDBKeyLayout(config, "evidence", evidenceDB, evidence.MigrateKeyLayoutToV2, logger); err != nil {
+		return nil, nil, err
+	}
 	evidenceLogger := logger.With("module", "evidence")
 	evidencePool, err := evidence.NewPool(evidenceDB, stateStore, blockStore, evidence.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
 	if err != nil {
@@ -386,6 +484,14 @@ func createConsensusReactor(config *cfg.Config,
 	consensusLogger log.Logger,
 	offlineStateSyncHeight int64,
 ) (*cs.Reactor, *cs.State) {
+	stateOptions := []cs.StateOption{
+		cs.StateMetrics(csMetrics),
+		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+	}
+	if timelineDir := config.Consensus.TimelineDir(); timelineDir != "" {
+		stateOptions = append(stateOptions,
+			cs.StateTimelineDir(timelineDir, config.Consensus.TimelineRetainHeights))
+	}
 	consensusState := cs.NewState(
 		config.Consensus,
 		state.Copy(),
@@ -393,8 +499,7 @@ func createConsensusReactor(config *cfg.Config,
 		blockStore,
 		mempool,
 		evidencePool,
-		cs.StateMetrics(csMetrics),
-		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+		stateOptions...,
 	)
 	consensusState.SetLogger(consensusLogger)
 	if privValidator != nil {
@@ -578,6 +683,21 @@ func startStateSync(
 ) error {
 	ssR.Logger.Info("Starting state sync")
 
+	if stateProvider == nil && config.UseP2P {
+		var err error
+		stateProvider, err = statesync.NewP2PStateProvider(
+			ssR,
+			state.ChainID, state.Version, state.InitialHeight,
+			light.TrustOptions{
+				Period: config.TrustPeriod,
+				Height: config.TrustHeight,
+				Hash:   config.TrustHashBytes(),
+			}, ssR.Logger.With("module", "light"),
+			dbKeyLayoutVersion)
+		if err != nil {
+			return fmt.Errorf("failed to set up p2p light client state provider: %w", err)
+		}
+	}
 	if stateProvider == nil {
 		var err error
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -742,6 +862,75 @@ func createAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+func createPrivValidatorGRPCClient(
+	config *cfg.Config,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	var opts []privval.GRPCSignerClientOption
+	if config.PrivValidatorGRPCTLSEnabled() {
+		creds, err := privval.GRPCTLSCredentials(
+			config.PrivValidatorGRPCCertFile(),
+			config.PrivValidatorGRPCKeyFile(),
+			config.PrivValidatorGRPCRootCAFile(),
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, privval.GRPCTransportCredentials(creds))
+	} else {
+		if !config.PrivValidatorGRPCInsecure {
+			return nil, errors.New("no TLS files set for the gRPC remote signer, " +
+				"and priv_validator_grpc_insecure is not set")
+		}
+		logger.Warn("Connecting to the gRPC remote signer without transport security",
+			"addr", config.PrivValidatorListenAddr)
+		opts = append(opts, privval.GRPCInsecure())
+	}
+
+	// Several signers sharing their last sign state may be listed, for
+	// failover. The socket signers of the other schemes do not support it,
+	// which BaseConfig.ValidateBasic enforces.
+	addrs := splitAndTrimEmpty(config.PrivValidatorListenAddr, ",", " ")
+	signers := make([]types.PrivValidator, 0, len(addrs))
+	for _, addr := range addrs {
+		sc, err := privval.NewGRPCSignerClient(addr, chainID, opts...)
+		if err != nil {
+			closeSigners(signers)
+			return nil, fmt.Errorf("failed to start private validator: %w", err)
+		}
+		signers = append(signers, sc)
+	}
+
+	var pvsc types.PrivValidator = signers[0]
+	if len(signers) > 1 {
+		fc, err := privval.NewFailoverSignerClient(logger.With("module", "privval"), signers...)
+		if err != nil {
+			closeSigners(signers)
+			return nil, fmt.Errorf("failed to start private validator: %w", err)
+		}
+		pvsc = fc
+	}
+
+	// try to get a pubkey from private validate first time
+	_, err := pvsc.GetPubKey()
+	if err != nil {
+		closeSigners(signers)
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvsc, nil
+}
+
+func closeSigners(signers []types.PrivValidator) {
+	for _, signer := range signers {
+		if c, ok := signer.(io.Closer); ok {
+			_ = c.Close()
+		}
+	}
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/internal/keyfile"
 	cmtos "github.com/cometbft/cometbft/internal/os"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 )
@@ -21,7 +22,9 @@ const IDByteLength = crypto.AddressSize
 
 // ------------------------------------------------------------------------------
 // Persistent peer ID
-// TODO: encrypt on disk
+
+// BlockType is the armor block type of an encrypted NodeKey file.
+const BlockType = "COMETBFT NODE KEY"
 
 // NodeKey is the persistent peer key.
 // It contains the nodes private key for authentication.
@@ -68,9 +71,25 @@ func LoadOrGen(filePath string) (*NodeKey, error) {
 	return nodeKey, nil
 }
 
-// Load loads NodeKey located in filePath.
+// Gen generates a new NodeKey and saves it to filePath, encrypted with the
+// passphrase if it is not empty.
+func Gen(filePath, passphrase string) (*NodeKey, error) {
+	nodeKey := &NodeKey{
+		PrivKey: ed25519.GenPrivKey(),
+	}
+
+	if err := nodeKey.SaveAsEncrypted(filePath, passphrase); err != nil {
+		return nil, err
+	}
+
+	return nodeKey, nil
+}
+
+// Load loads NodeKey located in filePath. If the file is encrypted, its
+// passphrase is read from the environment or the terminal (see package
+// internal/keyfile).
 func Load(filePath string) (*NodeKey, error) {
-	jsonBytes, err := os.ReadFile(filePath)
+	jsonBytes, _, err := keyfile.Read(filePath, BlockType)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +114,16 @@ func (nk *NodeKey) SaveAs(filePath string) error {
 	return nil
 }
 
+// SaveAsEncrypted persists the NodeKey to filePath, encrypted with the
+// passphrase. If the passphrase is empty, the NodeKey is saved in plaintext.
+func (nk *NodeKey) SaveAsEncrypted(filePath, passphrase string) error {
+	jsonBytes, err := cmtjson.Marshal(nk)
+	if err != nil {
+		return err
+	}
+	return keyfile.Write(filePath, jsonBytes, BlockType, passphrase)
+}
+
 // ------------------------------------------------------------------------------
 
 // MakePoWTarget returns the big-endian encoding of 2^(targetBits - difficulty) - 1.
@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/crypto/armor"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/internal/keyfile"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
 )
 
@@ -53,6 +55,28 @@ func TestNodeKey_SaveAs(t *testing.T) {
 	assert.FileExists(t, filePath)
 }
 
+func TestEncrypted(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "node_key.json")
+
+	nodeKey, err := Gen(filePath, "passphrase")
+	require.NoError(t, err)
+
+	t.Setenv(keyfile.PassphraseEnvVar, "wrong passphrase")
+	_, err = Load(filePath)
+	require.ErrorIs(t, err, armor.ErrWrongPassphrase)
+
+	t.Setenv(keyfile.PassphraseEnvVar, "passphrase")
+	nodeKey2, err := Load(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, nodeKey, nodeKey2)
+
+	// A private validator key file is not a node key file.
+	err = keyfile.Write(filePath, []byte("{}"), "COMETBFT PRIVATE VALIDATOR KEY", "passphrase")
+	require.NoError(t, err)
+	_, err = Load(filePath)
+	require.ErrorContains(t, err, "expected a \"COMETBFT NODE KEY\" key file")
+}
+
 // ----------------------------------------------------------
 
 func padBytes(bz []byte) []byte {
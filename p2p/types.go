@@ -76,7 +76,13 @@ func LoadOrGenNodeKey(path string) (*nodekey.NodeKey, error) {
 	return nodekey.LoadOrGen(path)
 }
 
-// LoadNodeKey loads a node key from the given path.
+// LoadNodeKey loads a node key from the given path, which may be encrypted.
 func LoadNodeKey(path string) (*nodekey.NodeKey, error) {
 	return nodekey.Load(path)
 }
+
+// GenNodeKey generates a new node key and saves it to the given path,
+// encrypted with the passphrase if it is not empty.
+func GenNodeKey(path, passphrase string) (*nodekey.NodeKey, error) {
+	return nodekey.Gen(path, passphrase)
+}
@@ -0,0 +1,235 @@
+package privval
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/internal/autofile"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Outcomes of a signing request, as recorded in the audit log.
+const (
+	AuditSigned  = "signed"
+	AuditRefused = "refused"
+)
+
+// AuditEntry is a record of the audit log: a signature, or the refusal to
+// sign, of a vote or proposal.
+type AuditEntry struct {
+	// Time at which the entry was recorded.
+	Time    time.Time `json:"time"`
+	Outcome string    `json:"outcome"`
+	// Error is the reason of a refusal.
+	Error   string `json:"error,omitempty"`
+	ChainID string `json:"chain_id"`
+	// Type is "proposal", "prevote" or "precommit".
+	Type   string `json:"type"`
+	Height int64  `json:"height"`
+	Round  int32  `json:"round"`
+	// Step is the step of the height/round/step checked against the last sign
+	// state.
+	Step          int8              `json:"step"`
+	BlockHash     cmtbytes.HexBytes `json:"block_hash"`
+	PartSetHash   cmtbytes.HexBytes `json:"part_set_hash,omitempty"`
+	ExtensionHash cmtbytes.HexBytes `json:"extension_hash,omitempty"`
+	// Timestamp of the vote or proposal.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AuditLog is an append-only log of the votes and proposals signed, or
+// refused to be signed, by a signer. Each entry is a JSON line, synced to disk
+// before the signature is returned. The log is rotated by an autofile.Group,
+// which also removes the oldest files beyond its total size limit.
+//
+// An AuditLog is safe for concurrent use. The methods of a nil AuditLog do
+// nothing.
+type AuditLog struct {
+	group  *autofile.Group
+	logger log.Logger
+}
+
+// OpenAuditLog opens or creates the audit log at path, and starts its
+// rotation. The group options set the size limits of the log (default: 10MB
+// per file and 1GB in total).
+func OpenAuditLog(path string, logger log.Logger, opts ...func(*autofile.Group)) (*AuditLog, error) {
+	group, err := autofile.OpenGroup(path, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	group.SetLogger(logger)
+	if err := group.Start(); err != nil {
+		return nil, fmt.Errorf("starting audit log: %w", err)
+	}
+	return &AuditLog{group: group, logger: logger}, nil
+}
+
+// Close flushes the audit log and closes it.
+func (l *AuditLog) Close() error {
+	if l == nil {
+		return nil
+	}
+	if err := l.group.Stop(); err != nil {
+		return err
+	}
+	l.group.Wait()
+	l.group.Close()
+	return nil
+}
+
+// Record appends the entry to the audit log and syncs it to disk. Errors are
+// logged, as they must not prevent signing.
+func (l *AuditLog) Record(entry AuditEntry) {
+	if l == nil {
+		return
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		l.logger.Error("Failed to encode audit log entry", "err", err)
+		return
+	}
+	if err := l.group.WriteLine(string(bz)); err != nil {
+		l.logger.Error("Failed to write audit log entry", "err", err)
+		return
+	}
+	if err := l.group.FlushAndSync(); err != nil {
+		l.logger.Error("Failed to sync audit log", "err", err)
+	}
+}
+
+// RecordVote records the signature of the vote, or the refusal to sign it if
+// err is not nil.
+func (l *AuditLog) RecordVote(chainID string, vote *cmtproto.Vote, err error) {
+	if l == nil || vote == nil {
+		return
+	}
+	entry := newAuditEntry(chainID, err)
+	entry.Type = voteTypeName(vote.Type)
+	entry.Height, entry.Round, entry.Step = vote.Height, vote.Round, voteToStepOrNone(vote)
+	entry.BlockHash, entry.PartSetHash = vote.BlockID.Hash, vote.BlockID.PartSetHeader.Hash
+	if len(vote.Extension) > 0 || len(vote.NonRpExtension) > 0 {
+		h := sha256.New()
+		h.Write(vote.Extension)
+		h.Write(vote.NonRpExtension)
+		entry.ExtensionHash = h.Sum(nil)
+	}
+	entry.Timestamp = vote.Timestamp
+	l.Record(entry)
+}
+
+// RecordProposal records the signature of the proposal, or the refusal to
+// sign it if err is not nil.
+func (l *AuditLog) RecordProposal(chainID string, proposal *cmtproto.Proposal, err error) {
+	if l == nil || proposal == nil {
+		return
+	}
+	entry := newAuditEntry(chainID, err)
+	entry.Type = "proposal"
+	entry.Height, entry.Round, entry.Step = proposal.Height, proposal.Round, stepPropose
+	entry.BlockHash, entry.PartSetHash = proposal.BlockID.Hash, proposal.BlockID.PartSetHeader.Hash
+	entry.Timestamp = proposal.Timestamp
+	l.Record(entry)
+}
+
+func newAuditEntry(chainID string, err error) AuditEntry {
+	entry := AuditEntry{ChainID: chainID, Outcome: AuditSigned}
+	if err != nil {
+		entry.Outcome = AuditRefused
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+func voteTypeName(t types.SignedMsgType) string {
+	switch t {
+	case types.PrevoteType:
+		return "prevote"
+	case types.PrecommitType:
+		return "precommit"
+	default:
+		return t.String()
+	}
+}
+
+// voteToStepOrNone is like voteToStep, without panicking on unknown types.
+func voteToStepOrNone(vote *cmtproto.Vote) int8 {
+	switch vote.Type {
+	case types.PrevoteType:
+		return stepPrevote
+	case types.PrecommitType:
+		return stepPrecommit
+	default:
+		return stepNone
+	}
+}
+
+// AuditFilter selects entries of the audit log. Zero fields match all
+// entries.
+type AuditFilter struct {
+	ChainID     string
+	MinHeight   int64
+	MaxHeight   int64
+	RefusedOnly bool
+}
+
+func (f AuditFilter) match(e AuditEntry) bool {
+	switch {
+	case f.ChainID != "" && e.ChainID != f.ChainID,
+		f.MinHeight != 0 && e.Height < f.MinHeight,
+		f.MaxHeight != 0 && e.Height > f.MaxHeight,
+		f.RefusedOnly && e.Outcome != AuditRefused:
+		return false
+	}
+	return true
+}
+
+// ReadAuditLog reads the entries of the audit log at path, from the oldest to
+// the newest, and calls fn with those matching the filter, until fn returns
+// false.
+func ReadAuditLog(path string, filter AuditFilter, fn func(AuditEntry) bool) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	group, err := autofile.OpenGroup(path)
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	defer group.Close()
+
+	r, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// Ignore an incomplete last line, left by a crash.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var entry AuditEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("decoding audit log entry %q: %w", line, err)
+		}
+		if filter.match(entry) && !fn(entry) {
+			return nil
+		}
+	}
+}
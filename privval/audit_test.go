@@ -0,0 +1,118 @@
+package privval
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+func readAuditLog(t *testing.T, path string, filter AuditFilter) []AuditEntry {
+	t.Helper()
+	var entries []AuditEntry
+	err := ReadAuditLog(path, filter, func(entry AuditEntry) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	require.NoError(t, err)
+	return entries
+}
+
+func TestFilePVAuditLog(t *testing.T) {
+	chainID := "mychainid"
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := OpenAuditLog(path, log.TestingLogger())
+	require.NoError(t, err)
+
+	privVal, _, _ := newTestFilePV(t, nil)
+	privVal.SetAuditLog(auditLog)
+
+	hash := cmtrand.Bytes(tmhash.Size)
+	blockID := types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Total: 5, Hash: hash}}
+
+	proposal := newProposal(10, 1, blockID).ToProto()
+	require.NoError(t, privVal.SignProposal(chainID, proposal))
+
+	vote := newVote(privVal.Key.Address, 10, 1, types.PrecommitType, blockID)
+	vote.Extension = []byte("extension")
+	require.NoError(t, privVal.SignVote(chainID, vote.ToProto(), true))
+
+	// Height regression.
+	vote = newVote(privVal.Key.Address, 9, 0, types.PrevoteType, blockID)
+	require.Error(t, privVal.SignVote(chainID, vote.ToProto(), false))
+
+	require.NoError(t, auditLog.Close())
+
+	entries := readAuditLog(t, path, AuditFilter{})
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, AuditSigned, entries[0].Outcome)
+	assert.Equal(t, "proposal", entries[0].Type)
+	assert.Equal(t, chainID, entries[0].ChainID)
+	assert.EqualValues(t, 10, entries[0].Height)
+	assert.EqualValues(t, 1, entries[0].Round)
+	assert.Equal(t, stepPropose, entries[0].Step)
+	assert.EqualValues(t, hash, entries[0].BlockHash)
+	assert.Equal(t, proposal.Timestamp, entries[0].Timestamp)
+
+	assert.Equal(t, AuditSigned, entries[1].Outcome)
+	assert.Equal(t, "precommit", entries[1].Type)
+	assert.Equal(t, stepPrecommit, entries[1].Step)
+	assert.NotEmpty(t, entries[1].ExtensionHash)
+
+	assert.Equal(t, AuditRefused, entries[2].Outcome)
+	assert.Equal(t, "prevote", entries[2].Type)
+	assert.EqualValues(t, 9, entries[2].Height)
+	assert.Contains(t, entries[2].Error, "height regression")
+
+	// Filters.
+	assert.Len(t, readAuditLog(t, path, AuditFilter{RefusedOnly: true}), 1)
+	assert.Len(t, readAuditLog(t, path, AuditFilter{MinHeight: 10}), 2)
+	assert.Len(t, readAuditLog(t, path, AuditFilter{MaxHeight: 9}), 1)
+	assert.Empty(t, readAuditLog(t, path, AuditFilter{ChainID: "other-chain"}))
+
+	// Reading stops when fn returns false.
+	n := 0
+	err = ReadAuditLog(path, AuditFilter{}, func(AuditEntry) bool {
+		n++
+		return false
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	require.Error(t, ReadAuditLog(filepath.Join(t.TempDir(), "missing.log"), AuditFilter{}, nil))
+}
+
+func TestGRPCSignerServerAuditLog(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := OpenAuditLog(path, log.TestingLogger())
+	require.NoError(t, err)
+
+	// The signatures of a FilePV served by a signer server are recorded once,
+	// by the FilePV. The requests refused by the server do not reach it.
+	privVal, _, _ := newTestFilePV(t, nil)
+	privVal.SetAuditLog(auditLog)
+	_, sc := newGRPCSigner(t, chainID, privVal, nil)
+
+	hash := cmtrand.Bytes(tmhash.Size)
+	blockID := types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Total: 2, Hash: hash}}
+	vote := newVote(privVal.Key.Address, 3, 0, types.PrevoteType, blockID)
+	require.NoError(t, sc.SignVote(chainID, vote.ToProto(), false))
+	require.Error(t, sc.SignVote("other-chain", vote.ToProto(), false))
+	_, err = sc.GetPubKey()
+	require.NoError(t, err)
+
+	require.NoError(t, auditLog.Close())
+
+	entries := readAuditLog(t, path, AuditFilter{})
+	require.Len(t, entries, 1)
+	assert.Equal(t, AuditSigned, entries[0].Outcome)
+	assert.Equal(t, chainID, entries[0].ChainID)
+}
@@ -0,0 +1,140 @@
+package privval
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// FailoverSignerClient sends the requests to one of several remote signers of
+// the same key, and fails over to the next one when the current one cannot be
+// reached. Other errors, like a refusal to sign or a request rejected by the
+// signer, are not retried with another signer.
+//
+// Failing over is only safe if the signers share their last sign state (see
+// FilePV.ShareLastSignState): a signer which timed out may still have signed
+// the vote or proposal, and the next signer must then return the same
+// signature rather than sign a conflicting one.
+type FailoverSignerClient struct {
+	signers []types.PrivValidator
+	logger  log.Logger
+
+	mtx     cmtsync.Mutex
+	current int
+}
+
+var _ types.PrivValidator = (*FailoverSignerClient)(nil)
+
+// NewFailoverSignerClient returns a FailoverSignerClient sending the requests
+// to the signers, starting with the first one.
+func NewFailoverSignerClient(logger log.Logger, signers ...types.PrivValidator) (*FailoverSignerClient, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("no signers")
+	}
+	return &FailoverSignerClient{signers: signers, logger: logger}, nil
+}
+
+// Close closes the signers which can be closed.
+func (fc *FailoverSignerClient) Close() error {
+	var errs []error
+	for _, signer := range fc.signers {
+		if c, ok := signer.(interface{ Close() error }); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Current returns the index of the signer the requests are sent to first.
+func (fc *FailoverSignerClient) Current() int {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	return fc.current
+}
+
+// --------------------------------------------------------
+// Implement PrivValidator
+
+func (fc *FailoverSignerClient) GetPubKey() (crypto.PubKey, error) {
+	var pubKey crypto.PubKey
+	err := fc.do("get pubkey", func(signer types.PrivValidator) (err error) {
+		pubKey, err = signer.GetPubKey()
+		return err
+	})
+	return pubKey, err
+}
+
+func (fc *FailoverSignerClient) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	return fc.do("sign vote", func(signer types.PrivValidator) error {
+		return signer.SignVote(chainID, vote, signExtension)
+	})
+}
+
+func (fc *FailoverSignerClient) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
+	return fc.do("sign proposal", func(signer types.PrivValidator) error {
+		return signer.SignProposal(chainID, proposal)
+	})
+}
+
+func (fc *FailoverSignerClient) SignBytes(bytes []byte) ([]byte, error) {
+	var sig []byte
+	err := fc.do("sign bytes", func(signer types.PrivValidator) (err error) {
+		sig, err = signer.SignBytes(bytes)
+		return err
+	})
+	return sig, err
+}
+
+// do calls fn with the current signer, then with the next ones as long as the
+// signers fail with a transport error (see isTransportError). The first
+// signer which succeeds becomes the current one.
+func (fc *FailoverSignerClient) do(op string, fn func(types.PrivValidator) error) error {
+	first := fc.Current()
+	errs := make([]error, 0, len(fc.signers))
+	for i := range fc.signers {
+		idx := (first + i) % len(fc.signers)
+		err := fn(fc.signers[idx])
+		if err == nil {
+			if idx != first {
+				fc.logger.Info("Failed over to another signer", "signer", idx)
+				fc.mtx.Lock()
+				fc.current = idx
+				fc.mtx.Unlock()
+			}
+			return nil
+		}
+		if !isTransportError(err) {
+			return err
+		}
+		fc.logger.Error("Signer failed", "signer", idx, "op", op, "err", err)
+		errs = append(errs, fmt.Errorf("signer %d: %w", idx, err))
+	}
+	return fmt.Errorf("all signers failed to %s: %w", op, errors.Join(errs...))
+}
+
+// isTransportError returns true if err is an error of the connection to a
+// signer, after which the request may not have reached it or its response may
+// have been lost. The answers of a signer, like a RemoteSignerError, a refusal
+// of a FilePV to sign conflicting data or a gRPC status other than Unavailable
+// or DeadlineExceeded, are not transport errors.
+func isTransportError(err error) bool {
+	if s, ok := status.FromError(err); ok {
+		return s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded
+	}
+	var netErr net.Error
+	return errors.Is(err, ErrNoConnection) ||
+		errors.Is(err, ErrReadTimeout) ||
+		errors.Is(err, ErrWriteTimeout) ||
+		errors.Is(err, io.EOF) ||
+		errors.As(err, &netErr)
+}
@@ -12,6 +12,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/internal/keyfile"
 	cmtos "github.com/cometbft/cometbft/internal/os"
 	"github.com/cometbft/cometbft/internal/tempfile"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
@@ -50,10 +51,24 @@ type FilePVKey struct {
 	PrivKey crypto.PrivKey `json:"priv_key"`
 
 	filePath string
+	// The passphrase of the key file, if it was loaded from an encrypted file.
+	passphrase string
 }
 
-// Save persists the FilePVKey to its filePath.
+// KeyFileBlockType is the armor block type of an encrypted FilePVKey file.
+const KeyFileBlockType = "COMETBFT PRIVATE VALIDATOR KEY"
+
+// Save persists the FilePVKey to its filePath. If it was loaded from an
+// encrypted file, it is encrypted with the same passphrase.
 func (pvKey FilePVKey) Save() {
+	pvKey.SaveEncrypted(pvKey.passphrase)
+}
+
+// SaveEncrypted persists the FilePVKey to its filePath, encrypted with the
+// passphrase. If the passphrase is empty, the key is saved in plaintext, like
+// with Save. An encrypted key file is decrypted by LoadFilePV, which reads the
+// passphrase from the environment or the terminal.
+func (pvKey FilePVKey) SaveEncrypted(passphrase string) {
 	outFile := pvKey.filePath
 	if outFile == "" {
 		panic("cannot save PrivValidator key: filePath not set")
@@ -64,7 +79,7 @@ func (pvKey FilePVKey) Save() {
 		panic(err)
 	}
 
-	if err := tempfile.WriteFileAtomic(outFile, jsonBytes, 0o600); err != nil {
+	if err := keyfile.Write(outFile, jsonBytes, KeyFileBlockType, passphrase); err != nil {
 		panic(err)
 	}
 }
@@ -164,6 +179,11 @@ func (lss *FilePVLastSignState) Save() {
 type FilePV struct {
 	Key           FilePVKey
 	LastSignState FilePVLastSignState
+
+	auditLog *AuditLog
+	// stateLock is set if the last sign state is shared (see
+	// ShareLastSignState).
+	stateLock *fileLock
 }
 
 // NewFilePV generates a new validator from the given key and paths.
@@ -198,7 +218,9 @@ func GenFilePV(keyFilePath, stateFilePath string, keyGen func() (crypto.PrivKey,
 
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
-// does not exist, the program will exit.
+// does not exist, the program will exit. If the key file is encrypted, its
+// passphrase is read from the environment or the terminal (see package
+// internal/keyfile).
 func LoadFilePV(keyFilePath, stateFilePath string) *FilePV {
 	return loadFilePV(keyFilePath, stateFilePath, true)
 }
@@ -211,9 +233,9 @@ func LoadFilePVEmptyState(keyFilePath, stateFilePath string) *FilePV {
 
 // If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
 func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
-	keyJSONBytes, err := os.ReadFile(keyFilePath)
+	keyJSONBytes, passphrase, err := keyfile.Read(keyFilePath, KeyFileBlockType)
 	if err != nil {
-		cmtos.Exit(err.Error())
+		cmtos.Exit(fmt.Sprintf("Error reading PrivValidator key from %v: %v\n", keyFilePath, err))
 	}
 	pvKey := FilePVKey{}
 	err = cmtjson.Unmarshal(keyJSONBytes, &pvKey)
@@ -225,6 +247,7 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
 	pvKey.PubKey = pvKey.PrivKey.PubKey()
 	pvKey.Address = pvKey.PubKey.Address()
 	pvKey.filePath = keyFilePath
+	pvKey.passphrase = passphrase
 
 	pvState := FilePVLastSignState{}
 
@@ -276,10 +299,33 @@ func (pv *FilePV) GetPubKey() (crypto.PubKey, error) {
 	return pv.Key.PubKey, nil
 }
 
+// ProofOfPossession returns the proof of the possession of the BLS12-381 key
+// of the validator, which it needs to join a validator set whose commits are
+// aggregated, or nil if its key is of another type.
+func (pv *FilePV) ProofOfPossession() ([]byte, error) {
+	privKey, ok := pv.Key.PrivKey.(interface{ ProofOfPossession() ([]byte, error) })
+	if !ok {
+		return nil, nil
+	}
+	return privKey.ProofOfPossession()
+}
+
+// SetAuditLog sets the audit log recording the votes and proposals signed by
+// the validator, and the ones it refused to sign. The signatures are only
+// recorded by the FilePV, so that a FilePV served by a signer server is
+// recorded once. The audit log is not closed by the FilePV.
+func (pv *FilePV) SetAuditLog(auditLog *AuditLog) {
+	pv.auditLog = auditLog
+}
+
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
 func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
-	if err := pv.signVote(chainID, vote, signExtension); err != nil {
+	err := pv.withLastSignState(func() error {
+		return pv.signVote(chainID, vote, signExtension)
+	})
+	pv.auditLog.RecordVote(chainID, vote, err)
+	if err != nil {
 		return fmt.Errorf("error signing vote: %v", err)
 	}
 	return nil
@@ -288,7 +334,11 @@ func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bo
 // SignProposal signs a canonical representation of the proposal, along with
 // the chainID. Implements PrivValidator.
 func (pv *FilePV) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
-	if err := pv.signProposal(chainID, proposal); err != nil {
+	err := pv.withLastSignState(func() error {
+		return pv.signProposal(chainID, proposal)
+	})
+	pv.auditLog.RecordProposal(chainID, proposal, err)
+	if err != nil {
 		return fmt.Errorf("error signing proposal: %v", err)
 	}
 	return nil
@@ -0,0 +1,14 @@
+//go:build !unix
+
+package privval
+
+import "errors"
+
+type fileLock struct{}
+
+func openFileLock(string) (*fileLock, error) {
+	return nil, errors.New("file locks are not supported on this platform")
+}
+
+func (*fileLock) lock() error   { return nil }
+func (*fileLock) unlock() error { return nil }
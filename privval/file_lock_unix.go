@@ -0,0 +1,36 @@
+//go:build unix
+
+package privval
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileLock is an exclusive advisory lock on a file, held with flock(2). The
+// lock is tied to the open file, so it conflicts with the locks taken through
+// other fileLocks, in this process or others.
+type fileLock struct {
+	file *os.File
+}
+
+func openFileLock(path string) (*fileLock, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileLock{file: f}, nil
+}
+
+func (l *fileLock) lock() error {
+	for {
+		err := syscall.Flock(int(l.file.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			return err
+		}
+	}
+}
+
+func (l *fileLock) unlock() error {
+	return syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN)
+}
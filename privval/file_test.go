@@ -13,6 +13,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/internal/keyfile"
 	kt "github.com/cometbft/cometbft/internal/keytypes"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
@@ -40,6 +41,34 @@ func TestGenLoadValidator(t *testing.T) {
 	}
 }
 
+func TestEncryptedKeyFile(t *testing.T) {
+	privVal, tempKeyFileName, tempStateFileName := newTestFilePV(t, nil)
+	privVal.Key.SaveEncrypted("passphrase")
+	privVal.LastSignState.Save()
+
+	bz, err := os.ReadFile(tempKeyFileName)
+	require.NoError(t, err)
+	assert.NotContains(t, string(bz), "priv_key")
+
+	t.Setenv(keyfile.PassphraseEnvVar, "passphrase")
+	loaded := LoadFilePV(tempKeyFileName, tempStateFileName)
+	assert.Equal(t, privVal.Key.PrivKey, loaded.Key.PrivKey)
+
+	// The key file remains encrypted when the validator is saved again.
+	loaded.Reset()
+	bz, err = os.ReadFile(tempKeyFileName)
+	require.NoError(t, err)
+	assert.NotContains(t, string(bz), "priv_key")
+	loaded = LoadFilePV(tempKeyFileName, tempStateFileName)
+	assert.Equal(t, privVal.Key.PrivKey, loaded.Key.PrivKey)
+
+	// Decrypt it.
+	loaded.Key.SaveEncrypted("")
+	t.Setenv(keyfile.PassphraseEnvVar, "wrong passphrase")
+	loaded = LoadFilePV(tempKeyFileName, tempStateFileName)
+	assert.Equal(t, privVal.Key.PrivKey, loaded.Key.PrivKey)
+}
+
 func TestResetValidator(t *testing.T) {
 	for _, keyType := range kt.ListSupportedKeyTypes() {
 		t.Run(keyType, func(t *testing.T) {
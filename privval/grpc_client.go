@@ -0,0 +1,214 @@
+package privval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pvproto "github.com/cometbft/cometbft/api/cometbft/privval/v2"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// GRPCServiceName is the name of the gRPC signing service, under which
+	// its health is reported.
+	GRPCServiceName = "cometbft.privval.v2.PrivValidatorService"
+
+	defaultGRPCRequestTimeout = 3 * time.Second
+)
+
+// GRPCSignerClient implements PrivValidator.
+// Handles a remote signer serving the PrivValidatorService over gRPC.
+//
+// Every request has a deadline and waits for the connection to the signer
+// to be ready, until the deadline.
+type GRPCSignerClient struct {
+	conn    *grpc.ClientConn
+	client  pvproto.PrivValidatorServiceClient
+	health  healthpb.HealthClient
+	chainID string
+	timeout time.Duration
+}
+
+var _ types.PrivValidator = (*GRPCSignerClient)(nil)
+
+// GRPCSignerClientOption sets an optional parameter on the GRPCSignerClient.
+type GRPCSignerClientOption func(*grpcSignerClientBuilder)
+
+type grpcSignerClientBuilder struct {
+	timeout time.Duration
+	creds   credentials.TransportCredentials
+}
+
+// GRPCRequestTimeout sets the deadline of each request to the remote signer
+// (default: 3s).
+func GRPCRequestTimeout(timeout time.Duration) GRPCSignerClientOption {
+	return func(b *grpcSignerClientBuilder) {
+		b.timeout = timeout
+	}
+}
+
+// GRPCTransportCredentials sets the transport credentials used to connect to
+// the remote signer. See GRPCTLSCredentials.
+func GRPCTransportCredentials(creds credentials.TransportCredentials) GRPCSignerClientOption {
+	return func(b *grpcSignerClientBuilder) {
+		b.creds = creds
+	}
+}
+
+// GRPCInsecure disables transport security for the connection to the remote
+// signer, which is only acceptable on a trusted network.
+func GRPCInsecure() GRPCSignerClientOption {
+	return GRPCTransportCredentials(insecure.NewCredentials())
+}
+
+// NewGRPCSignerClient returns an instance of GRPCSignerClient connecting to
+// the remote signer at addr. The address may be prefixed with the grpc://
+// scheme. The connection is established lazily, on the first request.
+//
+// The transport credentials must be set, with either GRPCTransportCredentials
+// or GRPCInsecure.
+func NewGRPCSignerClient(addr, chainID string, opts ...GRPCSignerClientOption) (*GRPCSignerClient, error) {
+	b := &grpcSignerClientBuilder{
+		timeout: defaultGRPCRequestTimeout,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	if b.creds == nil {
+		return nil, errors.New("no transport credentials for the remote signer: " +
+			"use GRPCTransportCredentials, or GRPCInsecure on a trusted network")
+	}
+
+	addr = strings.TrimPrefix(addr, "grpc://")
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(b.creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", addr, err)
+	}
+
+	return &GRPCSignerClient{
+		conn:    conn,
+		client:  pvproto.NewPrivValidatorServiceClient(conn),
+		health:  healthpb.NewHealthClient(conn),
+		chainID: chainID,
+		timeout: b.timeout,
+	}, nil
+}
+
+// Close closes the underlying connection.
+func (sc *GRPCSignerClient) Close() error {
+	return sc.conn.Close()
+}
+
+func (sc *GRPCSignerClient) requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), sc.timeout)
+}
+
+// --------------------------------------------------------
+// Implement PrivValidator
+
+// Ping checks the health of the signing service of the remote signer.
+func (sc *GRPCSignerClient) Ping() error {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.health.Check(ctx, &healthpb.HealthCheckRequest{Service: GRPCServiceName}, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("health check: %w", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("remote signer is not serving: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// GetPubKey retrieves a public key from a remote signer
+// returns an error if client is not able to provide the key.
+func (sc *GRPCSignerClient) GetPubKey() (crypto.PubKey, error) {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.GetPubKey(ctx, &pvproto.PubKeyRequest{ChainId: sc.chainID}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, fmt.Errorf("send: %w", err)
+	}
+	if resp.Error != nil {
+		return nil, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	pk, err := cryptoenc.PubKeyFromTypeAndBytes(resp.PubKeyType, resp.PubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
+// SignVote requests a remote signer to sign a vote.
+func (sc *GRPCSignerClient) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.SignVote(ctx,
+		&pvproto.SignVoteRequest{Vote: vote, ChainId: chainID, SkipExtensionSigning: !signExtension},
+		grpc.WaitForReady(true),
+	)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*vote = resp.Vote
+
+	return nil
+}
+
+// SignProposal requests a remote signer to sign a proposal.
+func (sc *GRPCSignerClient) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.SignProposal(ctx,
+		&pvproto.SignProposalRequest{Proposal: proposal, ChainId: chainID},
+		grpc.WaitForReady(true),
+	)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*proposal = resp.Proposal
+
+	return nil
+}
+
+// SignBytes requests a remote signer to sign bytes.
+func (sc *GRPCSignerClient) SignBytes(bytes []byte) ([]byte, error) {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.SignBytes(ctx, &pvproto.SignBytesRequest{Value: bytes}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return resp.Signature, nil
+}
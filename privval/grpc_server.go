@@ -0,0 +1,150 @@
+package privval
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pvproto "github.com/cometbft/cometbft/api/cometbft/privval/v2"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// GRPCSignerServer serves a PrivValidator over gRPC, through the
+// PrivValidatorService, to the node dialing it. It also serves the standard
+// gRPC health service, reporting GRPCServiceName as serving while running.
+//
+// The requests are handled one at a time, with the same request handler as
+// the SignerServer.
+type GRPCSignerServer struct {
+	service.BaseService
+
+	listener net.Listener
+	server   *grpc.Server
+	health   *health.Server
+	chainID  string
+	privVal  types.PrivValidator
+
+	handlerMtx               cmtsync.Mutex
+	validationRequestHandler ValidationRequestHandlerFunc
+}
+
+var _ pvproto.PrivValidatorServiceServer = (*GRPCSignerServer)(nil)
+
+// NewGRPCSignerServer returns a GRPCSignerServer serving privVal for the given
+// chain on the listener, once started. The gRPC server options allow, for
+// instance, setting the transport credentials (see GRPCTLSCredentials).
+func NewGRPCSignerServer(
+	listener net.Listener,
+	chainID string,
+	privVal types.PrivValidator,
+	logger log.Logger,
+	opts ...grpc.ServerOption,
+) *GRPCSignerServer {
+	ss := &GRPCSignerServer{
+		listener:                 listener,
+		server:                   grpc.NewServer(opts...),
+		health:                   health.NewServer(),
+		chainID:                  chainID,
+		privVal:                  privVal,
+		validationRequestHandler: DefaultValidationRequestHandler,
+	}
+	pvproto.RegisterPrivValidatorServiceServer(ss.server, ss)
+	healthpb.RegisterHealthServer(ss.server, ss.health)
+
+	ss.BaseService = *service.NewBaseService(logger, "GRPCSignerServer", ss)
+
+	return ss
+}
+
+// OnStart implements service.Service.
+func (ss *GRPCSignerServer) OnStart() error {
+	ss.health.SetServingStatus(GRPCServiceName, healthpb.HealthCheckResponse_SERVING)
+	go func() {
+		if err := ss.server.Serve(ss.listener); err != nil {
+			ss.Logger.Error("GRPCSignerServer: Serve", "err", err)
+		}
+	}()
+	return nil
+}
+
+// OnStop implements service.Service.
+func (ss *GRPCSignerServer) OnStop() {
+	ss.health.Shutdown()
+	ss.server.GracefulStop()
+}
+
+// SetRequestHandler override the default function that is used to service requests.
+func (ss *GRPCSignerServer) SetRequestHandler(validationRequestHandler ValidationRequestHandlerFunc) {
+	ss.handlerMtx.Lock()
+	defer ss.handlerMtx.Unlock()
+	ss.validationRequestHandler = validationRequestHandler
+}
+
+// handle passes the request to the request handler. Errors are reported in
+// the response, unless the handler replied with a response of another type,
+// like for a request to sign for another chain, in which case the request is
+// rejected with the InvalidArgument code.
+func (ss *GRPCSignerServer) handle(req pvproto.Message) (pvproto.Message, error) {
+	ss.handlerMtx.Lock()
+	defer ss.handlerMtx.Unlock()
+	res, err := ss.validationRequestHandler(ss.privVal, req, ss.chainID)
+	if err != nil {
+		// only log the error; we'll reply with an error in res
+		ss.Logger.Error("GRPCSignerServer: handleMessage", "err", err)
+	}
+	return res, err
+}
+
+func handlerError(err error) error {
+	if err == nil {
+		return status.Error(codes.Internal, "no response from the request handler")
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// GetPubKey implements pvproto.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) GetPubKey(_ context.Context, req *pvproto.PubKeyRequest) (*pvproto.PubKeyResponse, error) {
+	res, err := ss.handle(mustWrapMsg(req))
+	if resp := res.GetPubKeyResponse(); resp != nil {
+		return resp, nil
+	}
+	return nil, handlerError(err)
+}
+
+// SignVote implements pvproto.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) SignVote(_ context.Context, req *pvproto.SignVoteRequest) (*pvproto.SignedVoteResponse, error) {
+	res, err := ss.handle(mustWrapMsg(req))
+	if resp := res.GetSignedVoteResponse(); resp != nil {
+		return resp, nil
+	}
+	return nil, handlerError(err)
+}
+
+// SignProposal implements pvproto.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) SignProposal(
+	_ context.Context,
+	req *pvproto.SignProposalRequest,
+) (*pvproto.SignedProposalResponse, error) {
+	res, err := ss.handle(mustWrapMsg(req))
+	if resp := res.GetSignedProposalResponse(); resp != nil {
+		return resp, nil
+	}
+	return nil, handlerError(err)
+}
+
+// SignBytes implements pvproto.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) SignBytes(_ context.Context, req *pvproto.SignBytesRequest) (*pvproto.SignBytesResponse, error) {
+	res, err := ss.handle(mustWrapMsg(req))
+	if resp := res.GetSignBytesResponse(); resp != nil {
+		return resp, nil
+	}
+	return nil, handlerError(err)
+}
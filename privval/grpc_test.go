@@ -0,0 +1,233 @@
+package privval
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	privvalproto "github.com/cometbft/cometbft/api/cometbft/privval/v2"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// newGRPCSigner starts a gRPC signer server for mockPV and returns a client
+// connected to it.
+func newGRPCSigner(
+	t *testing.T,
+	chainID string,
+	mockPV types.PrivValidator,
+	serverOpts []grpc.ServerOption,
+	clientOpts ...GRPCSignerClientOption,
+) (*GRPCSignerServer, *GRPCSignerClient) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ss := NewGRPCSignerServer(ln, chainID, mockPV, log.TestingLogger(), serverOpts...)
+	require.NoError(t, ss.Start())
+	t.Cleanup(func() {
+		if err := ss.Stop(); err != nil && !errors.Is(err, service.ErrAlreadyStopped) {
+			t.Error(err)
+		}
+	})
+
+	clientOpts = append([]GRPCSignerClientOption{GRPCInsecure()}, clientOpts...)
+	sc, err := NewGRPCSignerClient("grpc://"+ln.Addr().String(), chainID, clientOpts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return ss, sc
+}
+
+func TestGRPCSigner(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	mockPV := types.NewMockPV()
+	_, sc := newGRPCSigner(t, chainID, mockPV, nil)
+
+	require.NoError(t, sc.Ping())
+
+	pubKey, err := sc.GetPubKey()
+	require.NoError(t, err)
+	expectedPubKey, err := mockPV.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, expectedPubKey, pubKey)
+
+	hash := cmtrand.Bytes(tmhash.Size)
+	blockID := types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}}
+	ts := cmttime.Now()
+
+	want := &types.Vote{Type: types.PrecommitType, Height: 1, Round: 2, BlockID: blockID, Timestamp: ts}
+	have := &types.Vote{Type: types.PrecommitType, Height: 1, Round: 2, BlockID: blockID, Timestamp: ts}
+	wantVote, haveVote := want.ToProto(), have.ToProto()
+	require.NoError(t, mockPV.SignVote(chainID, wantVote, true))
+	require.NoError(t, sc.SignVote(chainID, haveVote, true))
+	assert.Equal(t, wantVote.Signature, haveVote.Signature)
+	assert.NotEmpty(t, haveVote.Signature)
+
+	wantProposal := &types.Proposal{Type: types.ProposalType, Height: 1, Round: 2, POLRound: 1, BlockID: blockID, Timestamp: ts}
+	haveProposal := &types.Proposal{Type: types.ProposalType, Height: 1, Round: 2, POLRound: 1, BlockID: blockID, Timestamp: ts}
+	wantProto, haveProposalProto := wantProposal.ToProto(), haveProposal.ToProto()
+	require.NoError(t, mockPV.SignProposal(chainID, wantProto))
+	require.NoError(t, sc.SignProposal(chainID, haveProposalProto))
+	assert.Equal(t, wantProto.Signature, haveProposalProto.Signature)
+
+	sig, err := sc.SignBytes([]byte("hello"))
+	require.NoError(t, err)
+	assert.True(t, pubKey.VerifySignature([]byte("hello"), sig))
+
+	// Requests for another chain are rejected.
+	err = sc.SignVote("other-chain", have.ToProto(), false)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	other, err := NewGRPCSignerClient(sc.conn.Target(), "other-chain", GRPCInsecure())
+	require.NoError(t, err)
+	defer other.Close()
+	_, err = other.GetPubKey()
+	require.ErrorAs(t, err, new(*RemoteSignerError))
+
+	// Signing errors are reported by the remote signer.
+	_, sc = newGRPCSigner(t, chainID, types.NewErroringMockPV(), nil)
+	err = sc.SignVote(chainID, have.ToProto(), false)
+	require.ErrorAs(t, err, new(*RemoteSignerError))
+}
+
+func TestGRPCSignerDeadline(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	ss, sc := newGRPCSigner(t, chainID, types.NewMockPV(), nil, GRPCRequestTimeout(50*time.Millisecond))
+	ss.SetRequestHandler(func(
+		privVal types.PrivValidator,
+		req privvalproto.Message,
+		chainID string,
+	) (privvalproto.Message, error) {
+		time.Sleep(200 * time.Millisecond)
+		return DefaultValidationRequestHandler(privVal, req, chainID)
+	})
+
+	_, err := sc.GetPubKey()
+	require.Error(t, err)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// No signer listening.
+	sc, err = NewGRPCSignerClient(GetFreeLocalhostAddrPort(), chainID, GRPCInsecure(), GRPCRequestTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+	defer sc.Close()
+	require.Error(t, sc.Ping())
+}
+
+func TestGRPCSignerTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := writeTestCA(t, dir, "ca")
+	writeTestCert(t, dir, "signer", ca, caKey)
+	writeTestCert(t, dir, "node", ca, caKey)
+	otherCA, otherCAKey := writeTestCA(t, dir, "other-ca")
+	writeTestCert(t, dir, "intruder", otherCA, otherCAKey)
+
+	file := func(name string) string { return filepath.Join(dir, name) }
+	serverCreds, err := GRPCTLSCredentials(file("signer.crt"), file("signer.key"), file("ca.crt"), true)
+	require.NoError(t, err)
+	nodeCreds, err := GRPCTLSCredentials(file("node.crt"), file("node.key"), file("ca.crt"), false)
+	require.NoError(t, err)
+	intruderCreds, err := GRPCTLSCredentials(file("intruder.crt"), file("intruder.key"), file("ca.crt"), false)
+	require.NoError(t, err)
+
+	chainID := cmtrand.Str(12)
+	_, sc := newGRPCSigner(t, chainID, types.NewMockPV(), []grpc.ServerOption{grpc.Creds(serverCreds)},
+		GRPCTransportCredentials(nodeCreds), GRPCRequestTimeout(time.Second))
+	_, err = sc.GetPubKey()
+	require.NoError(t, err)
+
+	// A client with a certificate of another authority is rejected.
+	intruder, err := NewGRPCSignerClient(sc.conn.Target(), chainID,
+		GRPCTransportCredentials(intruderCreds), GRPCRequestTimeout(time.Second))
+	require.NoError(t, err)
+	defer intruder.Close()
+	_, err = intruder.GetPubKey()
+	require.Error(t, err)
+
+	// So is a client without transport security.
+	plain, err := NewGRPCSignerClient(sc.conn.Target(), chainID, GRPCInsecure(), GRPCRequestTimeout(time.Second))
+	require.NoError(t, err)
+	defer plain.Close()
+	_, err = plain.GetPubKey()
+	require.Error(t, err)
+
+	_, err = GRPCTLSCredentials(file("node.crt"), file("node.key"), file("node.key"), false)
+	require.Error(t, err)
+
+	// The transport security must be set explicitly.
+	_, err = NewGRPCSignerClient(sc.conn.Target(), chainID)
+	require.Error(t, err)
+}
+
+func writeTestCA(t *testing.T, dir, name string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func writeTestCert(t *testing.T, dir, name string, ca *x509.Certificate, caKey *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyDER)
+}
+
+func writePEM(t *testing.T, path, typ string, der []byte) {
+	t.Helper()
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600)
+	require.NoError(t, err)
+}
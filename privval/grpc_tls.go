@@ -0,0 +1,47 @@
+package privval
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// GRPCTLSCredentials returns the mutual TLS credentials of a node or remote
+// signer, using the certificate and key in the given PEM files. The peer must
+// present a certificate signed by the certificate authority in rootCAFile.
+//
+// If server is true, the credentials are those of the remote signer, which
+// requires and verifies the certificate of the node. Otherwise, they are the
+// credentials of the node, which verifies the certificate of the signer.
+func GRPCTLSCredentials(certFile, keyFile, rootCAFile string, server bool) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	pem, err := os.ReadFile(rootCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read root CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no certificate found in root CA file")
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+	}
+	if server {
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		cfg.ClientCAs = pool
+	} else {
+		cfg.RootCAs = pool
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
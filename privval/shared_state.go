@@ -0,0 +1,88 @@
+package privval
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+// SharedStateLockSuffix is appended to the path of the state file to get the
+// path of the lock file of a shared last sign state.
+const SharedStateLockSuffix = ".lock"
+
+// ShareLastSignState makes the last sign state of the FilePV shared with the
+// other signers of the same key using the same state file, possibly in other
+// processes, like the signers of a high-availability setup.
+//
+// Before each signature, the FilePV takes an exclusive lock on the lock file
+// next to the state file (see SharedStateLockSuffix), and reloads the last
+// sign state from the state file. Hence, the signers never sign a vote or
+// proposal at a height/round/step below the one signed by another signer, and
+// a node can fail over between them without the risk of double signing. If a
+// signer crashes, the lock is released by the operating system.
+//
+// The state file must have been created, with FilePV.Save, before it is
+// shared. It must be on a file system supporting flock(2) across all the
+// signers, like a local file system. Network file systems may not.
+func (pv *FilePV) ShareLastSignState() error {
+	if pv.stateLock != nil {
+		return nil
+	}
+	path := pv.LastSignState.filePath
+	if path == "" {
+		return errors.New("cannot share FilePVLastSignState: filePath not set")
+	}
+	// The state file is created by FilePV.Save, and never reset afterwards.
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("cannot share FilePVLastSignState: %w", err)
+	}
+	lock, err := openFileLock(path + SharedStateLockSuffix)
+	if err != nil {
+		return fmt.Errorf("opening last sign state lock: %w", err)
+	}
+	pv.stateLock = lock
+	return nil
+}
+
+// withLastSignState calls fn, which may update and save the last sign state.
+// If the last sign state is shared, fn is called under the state lock, after
+// reloading the state from its file.
+func (pv *FilePV) withLastSignState(fn func() error) (err error) {
+	if pv.stateLock == nil {
+		return fn()
+	}
+	if err := pv.stateLock.lock(); err != nil {
+		return fmt.Errorf("locking last sign state: %w", err)
+	}
+	defer func() {
+		if uerr := pv.stateLock.unlock(); uerr != nil {
+			err = errors.Join(err, fmt.Errorf("unlocking last sign state: %w", uerr))
+		}
+	}()
+	if err := pv.LastSignState.reload(); err != nil {
+		return err
+	}
+	return fn()
+}
+
+// reload reads the last sign state from its file. The file must have been
+// created when the FilePV was generated (see FilePV.Save): a missing or empty
+// file is an error, rather than a reset of the state which would allow signing
+// again at the heights already signed.
+func (lss *FilePVLastSignState) reload() error {
+	bz, err := os.ReadFile(lss.filePath)
+	if err != nil {
+		return fmt.Errorf("reading last sign state: %w", err)
+	}
+	if len(bz) == 0 {
+		return fmt.Errorf("last sign state file %v is empty", lss.filePath)
+	}
+	state := FilePVLastSignState{filePath: lss.filePath}
+	if err := cmtjson.Unmarshal(bz, &state); err != nil {
+		return fmt.Errorf("reading last sign state from %v: %w", lss.filePath, err)
+	}
+	*lss = state
+	return nil
+}
@@ -0,0 +1,221 @@
+package privval
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+// newSharedStateFilePVs returns n FilePVs of the same key, sharing their last
+// sign state.
+func newSharedStateFilePVs(t *testing.T, n int) []*FilePV {
+	t.Helper()
+	dir := t.TempDir()
+	keyFile, stateFile := filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json")
+	pv, err := GenFilePV(keyFile, stateFile, nil)
+	require.NoError(t, err)
+	pv.Save()
+
+	pvs := make([]*FilePV, n)
+	for i := range pvs {
+		pvs[i] = LoadFilePV(keyFile, stateFile)
+		require.NoError(t, pvs[i].ShareLastSignState())
+	}
+	return pvs
+}
+
+func randBlockID() types.BlockID {
+	hash := cmtrand.Bytes(tmhash.Size)
+	return types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Total: 1, Hash: hash}}
+}
+
+func TestSharedLastSignState(t *testing.T) {
+	chainID := "mychainid"
+	pvs := newSharedStateFilePVs(t, 2)
+	blockID := randBlockID()
+
+	vote := newVote(pvs[0].Key.Address, 10, 0, types.PrevoteType, blockID)
+	v0 := vote.ToProto()
+	require.NoError(t, pvs[0].SignVote(chainID, v0, false))
+
+	// The other signer returns the same signature for the same vote...
+	v1 := vote.ToProto()
+	require.NoError(t, pvs[1].SignVote(chainID, v1, false))
+	assert.Equal(t, v0.Signature, v1.Signature)
+
+	// ...and refuses to sign a conflicting vote, or a lower height.
+	conflicting := newVote(pvs[0].Key.Address, 10, 0, types.PrevoteType, randBlockID())
+	require.Error(t, pvs[1].SignVote(chainID, conflicting.ToProto(), false))
+	lower := newVote(pvs[0].Key.Address, 9, 0, types.PrevoteType, blockID)
+	require.Error(t, pvs[1].SignVote(chainID, lower.ToProto(), false))
+
+	proposal := newProposal(11, 0, blockID).ToProto()
+	require.NoError(t, pvs[1].SignProposal(chainID, proposal))
+	require.Error(t, pvs[0].SignProposal(chainID, newProposal(11, 0, randBlockID()).ToProto()))
+	assert.EqualValues(t, 11, pvs[0].LastSignState.Height)
+}
+
+func TestSharedLastSignStateConcurrent(t *testing.T) {
+	chainID := "mychainid"
+	pvs := newSharedStateFilePVs(t, 4)
+
+	// At each height, every signer tries to sign a prevote for its own block:
+	// only one of them must succeed.
+	const heights = 20
+	signed := make([][]int, heights)
+	var mtx sync.Mutex
+	var wg sync.WaitGroup
+	for i, pv := range pvs {
+		wg.Add(1)
+		go func(i int, pv *FilePV) {
+			defer wg.Done()
+			for h := 0; h < heights; h++ {
+				vote := newVote(pv.Key.Address, int64(h+1), 0, types.PrevoteType, randBlockID())
+				if pv.SignVote(chainID, vote.ToProto(), false) == nil {
+					mtx.Lock()
+					signed[h] = append(signed[h], i)
+					mtx.Unlock()
+				}
+			}
+		}(i, pv)
+	}
+	wg.Wait()
+
+	for h, signers := range signed {
+		assert.LessOrEqual(t, len(signers), 1, "height %d signed by %v", h+1, signers)
+	}
+}
+
+func TestShareLastSignStateLockFile(t *testing.T) {
+	pvs := newSharedStateFilePVs(t, 1)
+	_, err := os.Stat(pvs[0].LastSignState.filePath + SharedStateLockSuffix)
+	require.NoError(t, err)
+
+	pv := NewFilePV(pvs[0].Key.PrivKey, "", "")
+	require.Error(t, pv.ShareLastSignState())
+
+	// The state file must be created before it is shared.
+	dir := t.TempDir()
+	pv = NewFilePV(pvs[0].Key.PrivKey, filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+	require.Error(t, pv.ShareLastSignState())
+}
+
+func TestSharedLastSignStateMissingFile(t *testing.T) {
+	chainID := "mychainid"
+	pvs := newSharedStateFilePVs(t, 1)
+	path := pvs[0].LastSignState.filePath
+	vote := newVote(pvs[0].Key.Address, 10, 0, types.PrevoteType, randBlockID())
+	require.NoError(t, pvs[0].SignVote(chainID, vote.ToProto(), false))
+
+	// A missing or empty state file is not taken for a fresh state, which
+	// would allow signing the heights already signed again.
+	require.NoError(t, os.Remove(path))
+	lower := newVote(pvs[0].Key.Address, 9, 0, types.PrevoteType, randBlockID())
+	require.Error(t, pvs[0].SignVote(chainID, lower.ToProto(), false))
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.Error(t, pvs[0].SignVote(chainID, lower.ToProto(), false))
+}
+
+// refusingPV refuses to sign, like a remote signer.
+type refusingPV struct {
+	types.PrivValidator
+}
+
+func (refusingPV) SignVote(string, *cmtproto.Vote, bool) error {
+	return &RemoteSignerError{Code: 1, Description: "refused"}
+}
+
+// unreachablePV signs with the next PrivValidator, but returns an error as if
+// the response was lost.
+type unreachablePV struct {
+	types.PrivValidator
+}
+
+func (pv unreachablePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	if err := pv.PrivValidator.SignVote(chainID, vote, signExtension); err != nil {
+		return err
+	}
+	vote.Signature = nil
+	return ErrReadTimeout
+}
+
+func TestFailoverSignerClient(t *testing.T) {
+	chainID := "mychainid"
+	pvs := newSharedStateFilePVs(t, 2)
+	fc, err := NewFailoverSignerClient(log.TestingLogger(), unreachablePV{pvs[0]}, pvs[1])
+	require.NoError(t, err)
+
+	// The first signer signs the vote but times out: the second one returns
+	// the same signature.
+	vote := newVote(pvs[0].Key.Address, 10, 0, types.PrevoteType, randBlockID())
+	want := vote.ToProto()
+	require.NoError(t, pvs[0].SignVote(chainID, want, false))
+
+	have := vote.ToProto()
+	require.NoError(t, fc.SignVote(chainID, have, false))
+	assert.Equal(t, want.Signature, have.Signature)
+	assert.Equal(t, 1, fc.Current())
+
+	pubKey, err := fc.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, pvs[0].Key.PubKey, pubKey)
+
+	// Refusals are not retried with another signer.
+	fc, err = NewFailoverSignerClient(log.TestingLogger(), refusingPV{pvs[0]}, pvs[1])
+	require.NoError(t, err)
+	err = fc.SignVote(chainID, vote.ToProto(), false)
+	require.ErrorAs(t, err, new(*RemoteSignerError))
+	assert.Equal(t, 0, fc.Current())
+
+	// So are the refusals of a FilePV to sign conflicting data, which a signer
+	// with another state could sign.
+	dir := t.TempDir()
+	standalone := NewFilePV(pvs[0].Key.PrivKey, filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+	fc, err = NewFailoverSignerClient(log.TestingLogger(), pvs[0], standalone)
+	require.NoError(t, err)
+	conflicting := newVote(pvs[0].Key.Address, 10, 0, types.PrevoteType, randBlockID())
+	require.Error(t, fc.SignVote(chainID, conflicting.ToProto(), false))
+	assert.Zero(t, standalone.LastSignState.Height)
+
+	_, err = NewFailoverSignerClient(log.TestingLogger())
+	require.Error(t, err)
+}
+
+func TestFailoverSignerClientGRPC(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	pvs := newSharedStateFilePVs(t, 2)
+	ss0, sc0 := newGRPCSigner(t, chainID, pvs[0], nil, GRPCRequestTimeout(100*time.Millisecond))
+	_, sc1 := newGRPCSigner(t, chainID, pvs[1], nil)
+	fc, err := NewFailoverSignerClient(log.TestingLogger(), sc0, sc1)
+	require.NoError(t, err)
+
+	require.NoError(t, fc.SignVote(chainID, newVote(nil, 1, 0, types.PrevoteType, randBlockID()).ToProto(), false))
+	assert.Equal(t, 0, fc.Current())
+
+	// The first signer goes down.
+	require.NoError(t, ss0.Stop())
+	require.NoError(t, fc.SignVote(chainID, newVote(nil, 2, 0, types.PrevoteType, randBlockID()).ToProto(), false))
+	assert.Equal(t, 1, fc.Current())
+
+	// Refusals of the remote signer are not retried, nor are the requests it
+	// rejects.
+	err = fc.SignVote(chainID, newVote(nil, 1, 0, types.PrevoteType, randBlockID()).ToProto(), false)
+	require.ErrorAs(t, err, new(*RemoteSignerError))
+	err = fc.SignVote("other-chain", newVote(nil, 3, 0, types.PrevoteType, randBlockID()).ToProto(), false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, fc.Current())
+}
@@ -376,6 +376,22 @@ func (c *baseRPCClient) ConsensusParams(
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTimeline(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultConsensusTimeline, error) {
+	result := new(ctypes.ResultConsensusTimeline)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_timeline", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call(ctx, "health", map[string]any{}, result)
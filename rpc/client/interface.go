@@ -112,6 +112,7 @@ type NetworkClient interface {
 	DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
+	ConsensusTimeline(ctx context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error)
 	Health(ctx context.Context) (*ctypes.ResultHealth, error)
 }
 
@@ -142,6 +142,10 @@ func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.Resul
 	return c.env.ConsensusParams(c.ctx, height)
 }
 
+func (c *Local) ConsensusTimeline(_ context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error) {
+	return c.env.ConsensusTimeline(c.ctx, height)
+}
+
 func (c *Local) Health(context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(c.ctx)
 }
@@ -136,6 +136,10 @@ func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.Resul
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
 
+func (c Client) ConsensusTimeline(_ context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error) {
+	return c.env.ConsensusTimeline(&rpctypes.Context{}, height)
+}
+
 func (c Client) Health(_ context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(&rpctypes.Context{})
 }
@@ -390,6 +390,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// ConsensusTimeline provides a mock function with given fields: ctx, height
+func (_m *Client) ConsensusTimeline(ctx context.Context, height *int64) (*coretypes.ResultConsensusTimeline, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultConsensusTimeline
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultConsensusTimeline); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTimeline)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -151,6 +151,20 @@ func TestConsensusState(t *testing.T) {
 	}
 }
 
+func TestConsensusTimeline(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+		height := int64(1)
+		res, err := nc.ConsensusTimeline(context.Background(), &height)
+		require.NoError(t, err, "%d: %+v", i, err)
+		assert.Contains(t, string(res.Timeline), `"height":"1"`)
+
+		_, err = nc.ConsensusTimeline(context.Background(), nil)
+		require.NoError(t, err, "%d: %+v", i, err)
+	}
+}
+
 func TestHealth(t *testing.T) {
 	for i, c := range GetClients() {
 		nc, ok := c.(client.NetworkClient)
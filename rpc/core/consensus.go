@@ -101,6 +101,27 @@ func (env *Environment) GetConsensusState(*rpctypes.Context) (*ctypes.ResultCons
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTimeline returns the timeline of the given height recorded by the
+// consensus state machine: when the proposal, the block parts and the votes of
+// each round were received, and how long the ABCI calls took.
+// If no height is provided, it will fetch the timeline of the current height.
+// UNSTABLE
+// More: https://docs.cometbft.com/main/rpc/#/Info/consensus_timeline
+func (env *Environment) ConsensusTimeline(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultConsensusTimeline, error) {
+	var height int64
+	if heightPtr != nil {
+		height = *heightPtr
+		if height <= 0 {
+			return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+		}
+	}
+	bz, err := env.ConsensusState.GetTimelineJSON(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusTimeline{Timeline: bz}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.cometbft.com/main/rpc/#/Info/consensus_params
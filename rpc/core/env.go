@@ -47,6 +47,7 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetTimelineJSON(height int64) ([]byte, error)
 }
 
 type transport interface {
@@ -37,6 +37,7 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, ""),
 		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
 		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
+		"consensus_timeline":   rpc.NewRPCFunc(env.ConsensusTimeline, "height"),
 		"unconfirmed_tx":       rpc.NewRPCFunc(env.UnconfirmedTx, "hash"),
 		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
@@ -171,6 +171,11 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// UNSTABLE.
+type ResultConsensusTimeline struct {
+	Timeline json.RawMessage `json:"timeline"`
+}
+
 // CheckTx result.
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
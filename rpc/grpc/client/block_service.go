@@ -33,6 +33,12 @@ func blockFromProto(pblockID *cmtproto.BlockID, pblock *cmtproto.Block) (*Block,
 	}, nil
 }
 
+// Validators returned by the CometBFT BlockService gRPC API.
+type Validators struct {
+	Height       int64               `json:"height"`
+	ValidatorSet *types.ValidatorSet `json:"validator_set"`
+}
+
 // LatestHeightResult type used in GetLatestResult and send to the client
 // via a channel.
 type LatestHeightResult struct {
@@ -63,6 +69,10 @@ type BlockServiceClient interface {
 	// GetLatestHeight provides sends the latest committed block height to the
 	// resulting output channel as blocks are committed.
 	GetLatestHeight(ctx context.Context, opts ...GetLatestHeightOption) (<-chan LatestHeightResult, error)
+
+	// GetValidators attempts to retrieve the validator set at the given
+	// height, or at the latest height if height is 0.
+	GetValidators(ctx context.Context, height int64) (*Validators, error)
 }
 
 type blockServiceClient struct {
@@ -129,6 +139,25 @@ func (c *blockServiceClient) GetLatestHeight(ctx context.Context, opts ...GetLat
 	return resultCh, nil
 }
 
+// GetValidators implements BlockServiceClient GetValidators.
+func (c *blockServiceClient) GetValidators(ctx context.Context, height int64) (*Validators, error) {
+	res, err := c.client.GetValidators(ctx, &blocksvc.GetValidatorsRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := types.ValidatorSetFromProto(res.ValidatorSet)
+	if err != nil {
+		return nil, err
+	}
+	return &Validators{
+		Height:       res.Height,
+		ValidatorSet: vals,
+	}, nil
+}
+
 type disabledBlockServiceClient struct{}
 
 func newDisabledBlockServiceClient() BlockServiceClient {
@@ -149,3 +178,8 @@ func (*disabledBlockServiceClient) GetLatestBlock(context.Context) (*Block, erro
 func (*disabledBlockServiceClient) GetLatestHeight(context.Context, ...GetLatestHeightOption) (<-chan LatestHeightResult, error) {
 	panic("block service client is disabled")
 }
+
+// GetValidators implements BlockServiceClient GetValidators - disabled client.
+func (*disabledBlockServiceClient) GetValidators(context.Context, int64) (*Validators, error) {
+	panic("block service client is disabled")
+}
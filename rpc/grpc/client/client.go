@@ -22,6 +22,8 @@ type Client interface {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	SnapshotServiceClient
+	ConsensusTimelineServiceClient
 
 	// Close the connection to the server. Any subsequent requests will fail.
 	Close() error
@@ -34,6 +36,8 @@ type clientBuilder struct {
 	versionServiceEnabled      bool
 	blockServiceEnabled        bool
 	blockResultsServiceEnabled bool
+	snapshotServiceEnabled     bool
+	timelineServiceEnabled     bool
 }
 
 func newClientBuilder() *clientBuilder {
@@ -43,6 +47,8 @@ func newClientBuilder() *clientBuilder {
 		versionServiceEnabled:      true,
 		blockServiceEnabled:        true,
 		blockResultsServiceEnabled: true,
+		snapshotServiceEnabled:     true,
+		timelineServiceEnabled:     true,
 	}
 }
 
@@ -56,6 +62,8 @@ type client struct {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	SnapshotServiceClient
+	ConsensusTimelineServiceClient
 }
 
 // Close implements Client.
@@ -105,6 +113,29 @@ func WithBlockResultsServiceEnabled(enabled bool) Option {
 	}
 }
 
+// WithSnapshotServiceEnabled allows control of whether or not to create a
+// client for interacting with the snapshot service of a CometBFT node.
+//
+// If disabled and the client attempts to access the snapshot service API, the
+// client will panic.
+func WithSnapshotServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.snapshotServiceEnabled = enabled
+	}
+}
+
+// WithConsensusTimelineServiceEnabled allows control of whether or not to
+// create a client for interacting with the consensus timeline service of a
+// CometBFT node.
+//
+// If disabled and the client attempts to access the consensus timeline service
+// API, the client will panic.
+func WithConsensusTimelineServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.timelineServiceEnabled = enabled
+	}
+}
+
 // WithGRPCDialOption allows passing lower-level gRPC dial options through to
 // the gRPC dialer when creating the client.
 func WithGRPCDialOption(opt ggrpc.DialOption) Option {
@@ -144,10 +175,20 @@ func New(_ context.Context, addr string, opts ...Option) (Client, error) {
 	if builder.blockResultsServiceEnabled {
 		blockResultServiceClient = newBlockResultsServiceClient(conn)
 	}
+	snapshotServiceClient := newDisabledSnapshotServiceClient()
+	if builder.snapshotServiceEnabled {
+		snapshotServiceClient = newSnapshotServiceClient(conn)
+	}
+	timelineServiceClient := newDisabledConsensusTimelineServiceClient()
+	if builder.timelineServiceEnabled {
+		timelineServiceClient = newConsensusTimelineServiceClient(conn)
+	}
 	return &client{
-		conn:                      conn,
-		VersionServiceClient:      versionServiceClient,
-		BlockServiceClient:        blockServiceClient,
-		BlockResultsServiceClient: blockResultServiceClient,
+		conn:                           conn,
+		VersionServiceClient:           versionServiceClient,
+		BlockServiceClient:             blockServiceClient,
+		BlockResultsServiceClient:      blockResultServiceClient,
+		SnapshotServiceClient:          snapshotServiceClient,
+		ConsensusTimelineServiceClient: timelineServiceClient,
 	}, nil
 }
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	timelinesvc "github.com/cometbft/cometbft/api/cometbft/services/consensus_timeline/v1"
+)
+
+// ConsensusTimeline records when a CometBFT node reached the milestones of a
+// height: when it received the proposal, the block parts and the votes of
+// each round, and how long its ABCI calls took.
+type ConsensusTimeline = timelinesvc.HeightTimeline
+
+// ConsensusTimelineServiceClient provides the consensus timelines recorded by
+// a CometBFT node.
+type ConsensusTimelineServiceClient interface {
+	// GetConsensusTimeline returns the consensus timeline of the given height,
+	// or of the height the node is currently deciding if height is 0.
+	GetConsensusTimeline(ctx context.Context, height int64) (*ConsensusTimeline, error)
+}
+
+type consensusTimelineServiceClient struct {
+	client timelinesvc.ConsensusTimelineServiceClient
+}
+
+func newConsensusTimelineServiceClient(conn grpc.ClientConn) ConsensusTimelineServiceClient {
+	return &consensusTimelineServiceClient{
+		client: timelinesvc.NewConsensusTimelineServiceClient(conn),
+	}
+}
+
+// GetConsensusTimeline implements ConsensusTimelineServiceClient GetConsensusTimeline.
+func (c *consensusTimelineServiceClient) GetConsensusTimeline(ctx context.Context, height int64) (*ConsensusTimeline, error) {
+	res, err := c.client.GetTimeline(ctx, &timelinesvc.GetTimelineRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	return res.Timeline, nil
+}
+
+type disabledConsensusTimelineServiceClient struct{}
+
+func newDisabledConsensusTimelineServiceClient() ConsensusTimelineServiceClient {
+	return &disabledConsensusTimelineServiceClient{}
+}
+
+// GetConsensusTimeline implements ConsensusTimelineServiceClient GetConsensusTimeline.
+func (*disabledConsensusTimelineServiceClient) GetConsensusTimeline(context.Context, int64) (*ConsensusTimeline, error) {
+	panic("consensus timeline service client is disabled")
+}
@@ -47,3 +47,15 @@ func (e ErrDial) Error() string {
 func (e ErrDial) Unwrap() error {
 	return e.Source
 }
+
+type ErrSnapshotChunkReceive struct {
+	Source error
+}
+
+func (e ErrSnapshotChunkReceive) Error() string {
+	return "error receiving a snapshot chunk from a stream: " + e.Source.Error()
+}
+
+func (e ErrSnapshotChunkReceive) Unwrap() error {
+	return e.Source
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	snapshotsvc "github.com/cometbft/cometbft/api/cometbft/services/snapshot/v1"
+)
+
+// SnapshotChunk is a chunk of a snapshot streamed by the CometBFT
+// SnapshotService gRPC API, sent to the client via a channel. The chunks are
+// streamed as the state sync ChunkResponse messages also stored in snapshot
+// files.
+type SnapshotChunk struct {
+	Index uint32
+	Chunk []byte
+	Error error
+}
+
+// SnapshotServiceClient provides the state sync snapshots of the application.
+type SnapshotServiceClient interface {
+	// ListSnapshots returns the snapshots available from the application.
+	ListSnapshots(ctx context.Context) ([]*abci.Snapshot, error)
+
+	// GetSnapshotChunks sends the chunks of the snapshot at the given height
+	// and format to the resulting output channel, in order, starting from
+	// startIndex. The channel is closed after the last chunk, or after a
+	// chunk carrying an error.
+	GetSnapshotChunks(ctx context.Context, height uint64, format uint32, startIndex uint32) (<-chan SnapshotChunk, error)
+}
+
+type snapshotServiceClient struct {
+	client snapshotsvc.SnapshotServiceClient
+}
+
+func newSnapshotServiceClient(conn grpc.ClientConn) SnapshotServiceClient {
+	return &snapshotServiceClient{
+		client: snapshotsvc.NewSnapshotServiceClient(conn),
+	}
+}
+
+// ListSnapshots implements SnapshotServiceClient ListSnapshots.
+func (c *snapshotServiceClient) ListSnapshots(ctx context.Context) ([]*abci.Snapshot, error) {
+	res, err := c.client.ListSnapshots(ctx, &snapshotsvc.ListSnapshotsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Snapshots, nil
+}
+
+// GetSnapshotChunks implements SnapshotServiceClient GetSnapshotChunks.
+func (c *snapshotServiceClient) GetSnapshotChunks(
+	ctx context.Context,
+	height uint64,
+	format uint32,
+	startIndex uint32,
+) (<-chan SnapshotChunk, error) {
+	chunksClient, err := c.client.GetChunks(ctx, &snapshotsvc.GetChunksRequest{
+		Height:     height,
+		Format:     format,
+		StartIndex: startIndex,
+	})
+	if err != nil {
+		return nil, ErrStreamSetup{Source: err}
+	}
+
+	resultCh := make(chan SnapshotChunk)
+	go func(client snapshotsvc.SnapshotService_GetChunksClient) {
+		defer close(resultCh)
+		for {
+			response, err := client.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			res := SnapshotChunk{}
+			if err != nil {
+				res.Error = ErrSnapshotChunkReceive{Source: err}
+			} else {
+				res.Index, res.Chunk = response.Index, response.Chunk
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+			if err != nil {
+				return
+			}
+		}
+	}(chunksClient)
+
+	return resultCh, nil
+}
+
+type disabledSnapshotServiceClient struct{}
+
+func newDisabledSnapshotServiceClient() SnapshotServiceClient {
+	return &disabledSnapshotServiceClient{}
+}
+
+// ListSnapshots implements SnapshotServiceClient ListSnapshots - disabled client.
+func (*disabledSnapshotServiceClient) ListSnapshots(context.Context) ([]*abci.Snapshot, error) {
+	panic("snapshot service client is disabled")
+}
+
+// GetSnapshotChunks implements SnapshotServiceClient GetSnapshotChunks - disabled client.
+func (*disabledSnapshotServiceClient) GetSnapshotChunks(context.Context, uint64, uint32, uint32) (<-chan SnapshotChunk, error) {
+	panic("snapshot service client is disabled")
+}
@@ -0,0 +1,133 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	snapshotsvc "github.com/cometbft/cometbft/api/cometbft/services/snapshot/v1"
+	"github.com/cometbft/cometbft/libs/log"
+	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	"github.com/cometbft/cometbft/rpc/grpc/client"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/snapshotservice"
+)
+
+// startSnapshotService serves the snapshots of the given app connection over
+// gRPC, and returns a client connected to it.
+func startSnapshotService(t *testing.T, conn *proxymocks.AppConnSnapshot) client.Client {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := ggrpc.NewServer()
+	snapshotsvc.RegisterSnapshotServiceServer(server, snapshotservice.New(conn, 0, log.NewNopLogger()))
+	go func() {
+		_ = server.Serve(ln)
+	}()
+	t.Cleanup(server.Stop)
+
+	c, err := client.New(context.Background(), ln.Addr().String(),
+		client.WithInsecure(),
+		client.WithVersionServiceEnabled(false),
+		client.WithBlockServiceEnabled(false),
+		client.WithBlockResultsServiceEnabled(false),
+		client.WithConsensusTimelineServiceEnabled(false),
+		client.WithSnapshotServiceEnabled(true))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := c.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return c
+}
+
+func TestSnapshotService(t *testing.T) {
+	snapshots := []*abci.Snapshot{
+		{Height: 1, Format: 1, Chunks: 3, Hash: []byte{1}},
+		{Height: 2, Format: 1, Chunks: 2, Hash: []byte{2}},
+	}
+	chunks := [][]byte{{1, 0}, {1, 1}, {1, 2}}
+
+	conn := &proxymocks.AppConnSnapshot{}
+	conn.On("ListSnapshots", mock.Anything, mock.Anything).
+		Return(&abci.ListSnapshotsResponse{Snapshots: snapshots}, nil)
+	for i, chunk := range chunks {
+		conn.On("LoadSnapshotChunk", mock.Anything, &abci.LoadSnapshotChunkRequest{Height: 1, Format: 1, Chunk: uint32(i)}).
+			Return(&abci.LoadSnapshotChunkResponse{Chunk: chunk}, nil)
+	}
+	conn.On("LoadSnapshotChunk", mock.Anything, &abci.LoadSnapshotChunkRequest{Height: 2, Format: 1, Chunk: 0}).
+		Return(&abci.LoadSnapshotChunkResponse{Chunk: []byte{2, 0}}, nil)
+	conn.On("LoadSnapshotChunk", mock.Anything, &abci.LoadSnapshotChunkRequest{Height: 2, Format: 1, Chunk: 1}).
+		Return(nil, errors.New("boom"))
+
+	c := startSnapshotService(t, conn)
+	ctx := context.Background()
+
+	listed, err := c.ListSnapshots(ctx)
+	require.NoError(t, err)
+	require.Len(t, listed, len(snapshots))
+	for i, snapshot := range snapshots {
+		require.Equal(t, snapshot.Height, listed[i].Height)
+		require.Equal(t, snapshot.Hash, listed[i].Hash)
+	}
+
+	receive := func(height uint64, startIndex uint32) ([][]byte, error) {
+		chunkCh, err := c.GetSnapshotChunks(ctx, height, 1, startIndex)
+		require.NoError(t, err)
+		var received [][]byte
+		for chunk := range chunkCh {
+			if chunk.Error != nil {
+				return received, chunk.Error
+			}
+			require.EqualValues(t, int(startIndex)+len(received), chunk.Index)
+			received = append(received, chunk.Chunk)
+		}
+		return received, nil
+	}
+
+	t.Run("all chunks", func(t *testing.T) {
+		received, err := receive(1, 0)
+		require.NoError(t, err)
+		require.Equal(t, chunks, received)
+	})
+
+	t.Run("from a chunk index", func(t *testing.T) {
+		received, err := receive(1, 1)
+		require.NoError(t, err)
+		require.Equal(t, chunks[1:], received)
+
+		_, err = receive(1, 3)
+		requireCode(t, codes.InvalidArgument, err)
+	})
+
+	t.Run("missing snapshot", func(t *testing.T) {
+		_, err := receive(3, 0)
+		requireCode(t, codes.NotFound, err)
+	})
+
+	t.Run("chunk error", func(t *testing.T) {
+		received, err := receive(2, 0)
+		require.Equal(t, [][]byte{{2, 0}}, received)
+		requireCode(t, codes.Internal, err)
+	})
+
+	// The snapshots are looked up in the ones listed before, and the app is
+	// only asked again for the missing snapshot.
+	conn.AssertNumberOfCalls(t, "ListSnapshots", 2)
+}
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	var recvErr client.ErrSnapshotChunkReceive
+	require.ErrorAs(t, err, &recvErr)
+	require.Equal(t, code, status.Code(recvErr.Source))
+}
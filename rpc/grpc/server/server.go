@@ -9,11 +9,16 @@ import (
 
 	pbblocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v2"
 	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v2"
+	pbtimelinesvc "github.com/cometbft/cometbft/api/cometbft/services/consensus_timeline/v1"
+	pbsnapshotsvc "github.com/cometbft/cometbft/api/cometbft/services/snapshot/v1"
 	pbversionsvc "github.com/cometbft/cometbft/api/cometbft/services/version/v1"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
 	grpcerr "github.com/cometbft/cometbft/rpc/grpc/errors"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockresultservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/consensustimelineservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/snapshotservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/versionservice"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
@@ -29,6 +34,8 @@ type serverBuilder struct {
 	versionService      pbversionsvc.VersionServiceServer
 	blockService        pbblocksvc.BlockServiceServer
 	blockResultsService brs.BlockResultsServiceServer
+	snapshotService     pbsnapshotsvc.SnapshotServiceServer
+	timelineService     pbtimelinesvc.ConsensusTimelineServiceServer
 	logger              log.Logger
 	grpcOpts            []grpc.ServerOption
 }
@@ -68,12 +75,38 @@ func WithBlockService(store *store.BlockStore, eventBus *types.EventBus, logger
 	}
 }
 
+// WithBlockServiceValidators enables the block service on the CometBFT
+// server, serving the validator sets loaded from the state store too.
+func WithBlockServiceValidators(store *store.BlockStore, stateStore sm.Store, eventBus *types.EventBus, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.blockService = blockservice.NewWithValidators(store, stateStore, eventBus, logger)
+	}
+}
+
 func WithBlockResultsService(bs *store.BlockStore, ss sm.Store, logger log.Logger) Option {
 	return func(b *serverBuilder) {
 		b.blockResultsService = blockresultservice.New(bs, ss, logger)
 	}
 }
 
+// WithSnapshotService enables the snapshot service on the CometBFT server,
+// serving the state sync snapshots of the application. Chunks are streamed at
+// most at sendRate bytes per second, or without limit if sendRate is 0.
+func WithSnapshotService(conn proxy.AppConnSnapshot, sendRate int64, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.snapshotService = snapshotservice.New(conn, sendRate, logger)
+	}
+}
+
+// WithConsensusTimelineService enables the consensus timeline service on the
+// CometBFT server, serving the timelines recorded by the consensus state
+// machine.
+func WithConsensusTimelineService(source consensustimelineservice.TimelineSource, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.timelineService = consensustimelineservice.New(source, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -114,6 +147,14 @@ func Serve(listener net.Listener, opts ...Option) error {
 		brs.RegisterBlockResultsServiceServer(server, b.blockResultsService)
 		b.logger.Debug("Registered block results service")
 	}
+	if b.snapshotService != nil {
+		pbsnapshotsvc.RegisterSnapshotServiceServer(server, b.snapshotService)
+		b.logger.Debug("Registered snapshot service")
+	}
+	if b.timelineService != nil {
+		pbtimelinesvc.RegisterConsensusTimelineServiceServer(server, b.timelineService)
+		b.logger.Debug("Registered consensus timeline service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
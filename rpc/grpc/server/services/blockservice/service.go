@@ -2,6 +2,7 @@ package blockservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc/codes"
@@ -12,22 +13,32 @@ import (
 	"github.com/cometbft/cometbft/internal/rpctrace"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 )
 
 type blockServiceServer struct {
-	store    *store.BlockStore
-	eventBus *types.EventBus
-	logger   log.Logger
+	store      *store.BlockStore
+	stateStore sm.Store
+	eventBus   *types.EventBus
+	logger     log.Logger
 }
 
 // New creates a new CometBFT version service server.
 func New(store *store.BlockStore, eventBus *types.EventBus, logger log.Logger) blocksvc.BlockServiceServer {
+	return NewWithValidators(store, nil, eventBus, logger)
+}
+
+// NewWithValidators creates a new CometBFT block service server, which also
+// serves the validator sets loaded from the state store. The server created
+// by New does not serve validator sets.
+func NewWithValidators(store *store.BlockStore, stateStore sm.Store, eventBus *types.EventBus, logger log.Logger) blocksvc.BlockServiceServer {
 	return &blockServiceServer{
-		store:    store,
-		eventBus: eventBus,
-		logger:   logger.With("service", "BlockService"),
+		store:      store,
+		stateStore: stateStore,
+		eventBus:   eventBus,
+		logger:     logger.With("service", "BlockService"),
 	}
 }
 
@@ -124,6 +135,46 @@ func (s *blockServiceServer) GetLatestHeight(_ *blocksvc.GetLatestHeightRequest,
 	}
 }
 
+// GetValidators implements v2.BlockServiceServer GetValidators method.
+func (s *blockServiceServer) GetValidators(_ context.Context, req *blocksvc.GetValidatorsRequest) (*blocksvc.GetValidatorsResponse, error) {
+	logger := s.logger.With("endpoint", "GetValidators")
+	if s.stateStore == nil {
+		return nil, status.Error(codes.Unimplemented, "Validator sets are not served by this node")
+	}
+	height := req.Height
+	if height == 0 {
+		height = s.store.Height()
+	}
+	if err := validateBlockHeight(height, s.store.Base(), s.store.Height()); err != nil {
+		return nil, err
+	}
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+
+	vals, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		if errors.As(err, &sm.ErrNoValSetForHeight{}) {
+			return nil, status.Errorf(codes.NotFound, "Validator set not found for height %d", height)
+		}
+		logger.Error("Error loading validator set", "height", height, "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.Internal, "Failed to load validator set from store (see logs for trace ID: %s)", traceID)
+	}
+	pvals, err := vals.ToProto()
+	if err != nil {
+		logger.Error("Error attempting to convert validator set to its Protobuf representation", "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.Internal, "Failed to load validator set from store (see logs for trace ID: %s)", traceID)
+	}
+
+	return &blocksvc.GetValidatorsResponse{
+		Height:       height,
+		ValidatorSet: pvals,
+	}, nil
+}
+
 func validateBlockHeight(height, baseHeight, latestHeight int64) error {
 	switch {
 	case height <= 0:
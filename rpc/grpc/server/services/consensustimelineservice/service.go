@@ -0,0 +1,91 @@
+package consensustimelineservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	timelinesvc "github.com/cometbft/cometbft/api/cometbft/services/consensus_timeline/v1"
+	cs "github.com/cometbft/cometbft/internal/consensus"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// TimelineSource provides the consensus timelines recorded by the node.
+type TimelineSource interface {
+	GetTimeline(height int64) (*cs.HeightTimeline, error)
+}
+
+type consensusTimelineServiceServer struct {
+	source TimelineSource
+	logger log.Logger
+}
+
+// New creates a new CometBFT consensus timeline service server.
+func New(source TimelineSource, logger log.Logger) timelinesvc.ConsensusTimelineServiceServer {
+	return &consensusTimelineServiceServer{
+		source: source,
+		logger: logger.With("service", "ConsensusTimelineService"),
+	}
+}
+
+// GetTimeline implements v1.ConsensusTimelineServiceServer GetTimeline method.
+func (s *consensusTimelineServiceServer) GetTimeline(_ context.Context, req *timelinesvc.GetTimelineRequest) (*timelinesvc.GetTimelineResponse, error) {
+	logger := s.logger.With("endpoint", "GetTimeline")
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be negative")
+	}
+	timeline, err := s.source.GetTimeline(req.Height)
+	if err != nil {
+		var notFound cs.ErrTimelineNotFound
+		if errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Error("Error fetching consensus timeline", "height", req.Height, "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return &timelinesvc.GetTimelineResponse{Timeline: timelineToProto(timeline)}, nil
+}
+
+func timelineToProto(ht *cs.HeightTimeline) *timelinesvc.HeightTimeline {
+	pb := &timelinesvc.HeightTimeline{
+		Height:      ht.Height,
+		StartTime:   ht.StartTime,
+		CommitRound: ht.CommitRound,
+		CommitTime:  ht.CommitTime,
+		Rounds:      make([]*timelinesvc.RoundTimeline, len(ht.Rounds)),
+		AbciCalls:   make([]*timelinesvc.ABCICallTimeline, len(ht.ABCICalls)),
+	}
+	for i, rt := range ht.Rounds {
+		pbrt := &timelinesvc.RoundTimeline{
+			Round:                rt.Round,
+			StartTime:            rt.StartTime,
+			ProposalTime:         rt.ProposalTime,
+			ProposalCompleteTime: rt.ProposalCompleteTime,
+			PrevotesAnyTime:      rt.PrevotesAnyTime,
+			PrevotesMaj23Time:    rt.PrevotesMaj23Time,
+			PrecommitsAnyTime:    rt.PrecommitsAnyTime,
+			PrecommitsMaj23Time:  rt.PrecommitsMaj23Time,
+			Votes:                make([]*timelinesvc.VoteArrival, len(rt.Votes)),
+		}
+		for j, vote := range rt.Votes {
+			pbrt.Votes[j] = &timelinesvc.VoteArrival{
+				Type:             vote.Type,
+				ValidatorIndex:   vote.ValidatorIndex,
+				ValidatorAddress: vote.ValidatorAddress,
+				Time:             vote.Time,
+			}
+		}
+		pb.Rounds[i] = pbrt
+	}
+	for i, call := range ht.ABCICalls {
+		pb.AbciCalls[i] = &timelinesvc.ABCICallTimeline{
+			Method:    call.Method,
+			Round:     call.Round,
+			StartTime: call.StartTime,
+			Duration:  call.Duration,
+		}
+	}
+	return pb
+}
@@ -0,0 +1,172 @@
+package snapshotservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	snapshotsvc "github.com/cometbft/cometbft/api/cometbft/services/snapshot/v1"
+	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	flow "github.com/cometbft/cometbft/internal/flowrate"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/proxy"
+)
+
+type snapshotKey struct {
+	height uint64
+	format uint32
+}
+
+type snapshotServiceServer struct {
+	conn     proxy.AppConnSnapshot
+	sendRate int64
+	monitor  *flow.Monitor
+	logger   log.Logger
+
+	mtx       cmtsync.Mutex
+	snapshots map[snapshotKey]*abci.Snapshot // the snapshots listed last by the app
+}
+
+// New creates a new CometBFT snapshot service server. Chunks are streamed at
+// most at sendRate bytes per second, shared between all the clients, or
+// without limit if sendRate is 0.
+func New(conn proxy.AppConnSnapshot, sendRate int64, logger log.Logger) snapshotsvc.SnapshotServiceServer {
+	return &snapshotServiceServer{
+		conn:      conn,
+		sendRate:  sendRate,
+		monitor:   flow.New(0, 0),
+		logger:    logger.With("service", "SnapshotService"),
+		snapshots: make(map[snapshotKey]*abci.Snapshot),
+	}
+}
+
+// ListSnapshots implements v1.SnapshotServiceServer ListSnapshots method.
+func (s *snapshotServiceServer) ListSnapshots(ctx context.Context, _ *snapshotsvc.ListSnapshotsRequest) (*snapshotsvc.ListSnapshotsResponse, error) {
+	logger := s.logger.With("endpoint", "ListSnapshots")
+	snapshots, err := s.listSnapshots(ctx)
+	if err != nil {
+		return nil, internalError(logger, "Failed to list snapshots", err)
+	}
+	return &snapshotsvc.ListSnapshotsResponse{Snapshots: snapshots}, nil
+}
+
+// listSnapshots lists the snapshots of the app, and remembers them for
+// GetChunks.
+func (s *snapshotServiceServer) listSnapshots(ctx context.Context) ([]*abci.Snapshot, error) {
+	resp, err := s.conn.ListSnapshots(ctx, &abci.ListSnapshotsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make(map[snapshotKey]*abci.Snapshot, len(resp.Snapshots))
+	for _, snapshot := range resp.Snapshots {
+		snapshots[snapshotKey{height: snapshot.Height, format: snapshot.Format}] = snapshot
+	}
+	s.mtx.Lock()
+	s.snapshots = snapshots
+	s.mtx.Unlock()
+	return resp.Snapshots, nil
+}
+
+// snapshot returns the snapshot at the given height and format, or nil if the
+// app does not have it. The app is only asked for its snapshots if the
+// snapshot is not among the ones it listed last. A snapshot since deleted by
+// the app fails when its chunks are loaded.
+func (s *snapshotServiceServer) snapshot(ctx context.Context, height uint64, format uint32) (*abci.Snapshot, error) {
+	key := snapshotKey{height: height, format: format}
+	s.mtx.Lock()
+	snapshot, ok := s.snapshots[key]
+	s.mtx.Unlock()
+	if ok {
+		return snapshot, nil
+	}
+	if _, err := s.listSnapshots(ctx); err != nil {
+		return nil, err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.snapshots[key], nil
+}
+
+// GetChunks implements v1.SnapshotServiceServer GetChunks method.
+func (s *snapshotServiceServer) GetChunks(req *snapshotsvc.GetChunksRequest, stream snapshotsvc.SnapshotService_GetChunksServer) error {
+	logger := s.logger.With("endpoint", "GetChunks")
+	ctx := stream.Context()
+
+	if req.Height == 0 {
+		return status.Error(codes.InvalidArgument, "Height cannot be zero")
+	}
+	snapshot, err := s.snapshot(ctx, req.Height, req.Format)
+	if err != nil {
+		return internalError(logger, "Failed to list snapshots", err)
+	}
+	if snapshot == nil {
+		return status.Errorf(codes.NotFound, "Snapshot not found for height %d and format %d", req.Height, req.Format)
+	}
+	if req.StartIndex >= snapshot.Chunks {
+		return status.Errorf(codes.InvalidArgument, "Start index %d is out of range, the snapshot has %d chunks",
+			req.StartIndex, snapshot.Chunks)
+	}
+
+	for index := req.StartIndex; index < snapshot.Chunks; index++ {
+		resp, err := s.conn.LoadSnapshotChunk(ctx, &abci.LoadSnapshotChunkRequest{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return internalError(logger, "Failed to load snapshot chunk", err)
+		}
+		if resp.Chunk == nil {
+			return status.Errorf(codes.NotFound, "Chunk %d not found for snapshot at height %d and format %d",
+				index, snapshot.Height, snapshot.Format)
+		}
+		if err := s.limit(ctx, len(resp.Chunk)); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		if err := stream.Send(&ssproto.ChunkResponse{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Index:  index,
+			Chunk:  resp.Chunk,
+		}); err != nil {
+			logger.Error("Failed to stream snapshot chunk", "err", err, "height", snapshot.Height, "chunk", index)
+			return status.Error(codes.Unavailable, "Cannot send stream response")
+		}
+	}
+	return nil
+}
+
+// limit blocks until size bytes can be sent without exceeding the send rate.
+func (s *snapshotServiceServer) limit(ctx context.Context, size int) error {
+	if s.sendRate <= 0 {
+		return nil
+	}
+	for size > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n := s.monitor.Limit(size, s.sendRate, true)
+		s.monitor.Update(n)
+		size -= n
+	}
+	return nil
+}
+
+// internalError logs an unexpected error with a trace ID, and returns the
+// gRPC error to send to the client.
+func internalError(logger log.Logger, msg string, err error) error {
+	traceID, traceErr := rpctrace.New()
+	if traceErr != nil {
+		logger.Error("Error generating RPC trace ID", "err", traceErr)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	logger.Error(msg, "err", err, "traceID", traceID)
+	return status.Errorf(codes.Internal, "%s (see logs for trace ID: %s)", msg, traceID)
+}
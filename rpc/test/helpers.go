@@ -94,6 +94,8 @@ func createConfig() *cfg.Config {
 	// Set pruning interval to a value lower than the default for some of the
 	// tests that rely on pruning to occur quickly
 	c.Storage.Pruning.Interval = 100 * time.Millisecond
+	// Record the consensus timeline, which is disabled by default
+	c.Consensus.TimelineHeights = 10
 	return c
 }
 
@@ -9,6 +9,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/internal/fail"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/mempool"
@@ -136,6 +138,12 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxReapBytes, maxGas)
 	commit := lastExtCommit.ToCommit()
+	if types.UseAggregatedCommit(state.ConsensusParams.Feature, lastExtCommit.Height, state.LastValidators) {
+		var err error
+		if commit, err = lastExtCommit.ToAggregatedCommit(); err != nil {
+			return nil, fmt.Errorf("aggregating last commit: %w", err)
+		}
+	}
 	block := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
 	rpp, err := blockExec.proxyApp.PrepareProposal(
 		ctx,
@@ -273,8 +281,14 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 
 	fail.Fail() // XXX
 
-	// validate the validator updates and convert to CometBFT types
-	err = validateValidatorUpdates(abciResponse.ValidatorUpdates, state.ConsensusParams.Validator)
+	// validate the validator updates and convert to CometBFT types. They are
+	// validated with the consensus params updated by this block, which apply
+	// before the updated validators join the set.
+	params := state.ConsensusParams
+	if abciResponse.ConsensusParamUpdates != nil {
+		params = state.ConsensusParams.Update(abciResponse.ConsensusParamUpdates)
+	}
+	err = validateValidatorUpdates(abciResponse.ValidatorUpdates, params)
 	if err != nil {
 		return state, fmt.Errorf("error in validator updates: %w", err)
 	}
@@ -508,9 +522,13 @@ func BuildLastCommitInfo(block *types.Block, lastValSet *types.ValidatorSet, ini
 		))
 	}
 
+	commitSigs, err := block.LastCommit.CommitSigs(lastValSet)
+	if err != nil {
+		panic(fmt.Errorf("failed to get the signatures of the last commit at height %d: %w", block.Height, err))
+	}
 	votes := make([]abci.VoteInfo, block.LastCommit.Size())
 	for i, val := range lastValSet.Validators {
-		commitSig := block.LastCommit.Signatures[i]
+		commitSig := commitSigs[i]
 		votes[i] = abci.VoteInfo{
 			Validator:   types.TM2PB.Validator(val),
 			BlockIdFlag: cmtproto.BlockIDFlag(commitSig.BlockIDFlag),
@@ -607,7 +625,7 @@ func BuildExtendedCommitInfo(ec *types.ExtendedCommit, valSet *types.ValidatorSe
 }
 
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
-	params types.ValidatorParams,
+	params types.ConsensusParams,
 ) error {
 	for _, valUpdate := range abciUpdates {
 		if valUpdate.Power < 0 {
@@ -615,11 +633,26 @@ func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
 		}
 
 		// Check if validator's pubkey matches an ABCI type in the consensus params.
-		if isValid, suppTypes := types.IsValidPubkeyType(params, valUpdate.PubKeyType); !isValid {
+		if isValid, suppTypes := types.IsValidPubkeyType(params.Validator, valUpdate.PubKeyType); !isValid {
 			return fmt.Errorf("validator %X is using pubkey %s, which is unsupported for consensus (supported types: %s)",
 				valUpdate.PubKeyBytes, valUpdate.PubKeyType, suppTypes)
 		}
 
+		// The signatures of the BLS12-381 validators are aggregated into a
+		// single signature, which is only secure if each validator proved
+		// that it owns its key. Otherwise, a validator could pick a key that
+		// cancels out the keys of the others and forge their signatures.
+		if params.Feature.AggregatedCommitsEnableHeight > 0 &&
+			valUpdate.PubKeyType == types.ABCIPubKeyTypeBls12381 && valUpdate.Power > 0 {
+			pubKey, err := cryptoenc.PubKeyFromTypeAndBytes(valUpdate.PubKeyType, valUpdate.PubKeyBytes)
+			if err != nil {
+				return fmt.Errorf("validator %X: %w", valUpdate.PubKeyBytes, err)
+			}
+			if !bls12381.VerifyProofOfPossession(pubKey, valUpdate.ProofOfPossession) {
+				return fmt.Errorf("validator %X has no valid proof of possession of its key", valUpdate.PubKeyBytes)
+			}
+		}
+
 		// XXX: PubKeyBytes will be checked in PB2TM.ValidatorUpdates
 	}
 	return nil
@@ -674,6 +707,19 @@ func updateState(
 			return state, fmt.Errorf("updating consensus params: %w", err)
 		}
 
+		// Once aggregated commits are enabled, the validators joining the set
+		// must prove that they own their key (see validateValidatorUpdates),
+		// so the validators of the sets signing the next heights must have
+		// proven it when enabling them.
+		if state.ConsensusParams.Feature.AggregatedCommitsEnableHeight == 0 &&
+			nextParams.Feature.AggregatedCommitsEnableHeight > 0 {
+			for _, vals := range []*types.ValidatorSet{state.NextValidators, nValSet} {
+				if err := vals.VerifyProofsOfPossession(); err != nil {
+					return state, fmt.Errorf("enabling aggregated commits: %w", err)
+				}
+			}
+		}
+
 		state.Version.Consensus.App = nextParams.Version.App
 
 		// Change results from this height but only applies to the next height.
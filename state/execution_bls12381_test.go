@@ -0,0 +1,103 @@
+//go:build bls12381
+
+package state_test
+
+import (
+	"testing"
+
+	gogo "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestValidateValidatorUpdatesProofOfPossession(t *testing.T) {
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	otherKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+
+	proof, err := privKey.ProofOfPossession()
+	require.NoError(t, err)
+	otherProof, err := otherKey.ProofOfPossession()
+	require.NoError(t, err)
+
+	withProof := func(power int64, proof []byte) []abci.ValidatorUpdate {
+		valUpdate := abci.NewValidatorUpdate(privKey.PubKey(), power)
+		valUpdate.ProofOfPossession = proof
+		return []abci.ValidatorUpdate{valUpdate}
+	}
+
+	params := types.ConsensusParams{
+		Validator: types.ValidatorParams{PubKeyTypes: []string{types.ABCIPubKeyTypeBls12381}},
+	}
+	aggParams := params
+	aggParams.Feature.AggregatedCommitsEnableHeight = 10
+
+	testCases := []struct {
+		name        string
+		abciUpdates []abci.ValidatorUpdate
+		params      types.ConsensusParams
+		shouldErr   bool
+	}{
+		{"adding a validator with a proof is OK", withProof(20, proof), aggParams, false},
+		{"adding a validator without a proof results in an error", withProof(20, nil), aggParams, true},
+		{"adding a validator with the proof of another key results in an error", withProof(20, otherProof), aggParams, true},
+		{"removing a validator without a proof is OK", withProof(0, nil), aggParams, false},
+		{"adding a validator without a proof is OK if commits are not aggregated", withProof(20, nil), params, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := sm.ValidateValidatorUpdates(tc.abciUpdates, tc.params)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateStateEnablingAggregatedCommitsProofOfPossession(t *testing.T) {
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	proof, err := privKey.ProofOfPossession()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		proof     []byte
+		shouldErr bool
+	}{
+		{"enabling aggregated commits with a proof of each validator is OK", proof, false},
+		{"enabling aggregated commits without a proof of each validator results in an error", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, _, _ := makeState(1, 1, chainID)
+			val := types.NewValidator(privKey.PubKey(), 10)
+			val.ProofOfPossession = tc.proof
+			state.Validators = types.NewValidatorSet([]*types.Validator{val})
+			state.NextValidators = state.Validators.CopyIncrementProposerPriority(1)
+
+			enableHeight := &gogo.Int64Value{Value: state.LastBlockHeight + 2}
+			params := state.ConsensusParams.ToProto()
+			params.Feature.VoteExtensionsEnableHeight = enableHeight
+			params.Feature.PbtsEnableHeight = enableHeight
+			params.Feature.AggregatedCommitsEnableHeight = enableHeight
+			header, blockID, resp := makeHeaderPartsResponsesParams(state, params)
+
+			_, err := sm.UpdateState(state, blockID, &header, resp, nil)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -510,7 +510,8 @@ func TestValidateValidatorUpdates(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := sm.ValidateValidatorUpdates(tc.abciUpdates, tc.validatorParams)
+			params := types.ConsensusParams{Validator: tc.validatorParams}
+			err := sm.ValidateValidatorUpdates(tc.abciUpdates, params)
 			if tc.shouldErr {
 				require.Error(t, err)
 			} else {
@@ -56,6 +56,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "application_block_retain_height",
 			Help:      "ApplicationBlockRetainHeight is the accepted block retain height set by the application",
 		}, labels).With(labelsAndValues...),
+		OperatorBlockRetainHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "operator_block_retain_height",
+			Help:      "OperatorBlockRetainHeight is the block retain height derived from the pruning targets set by the operator",
+		}, labels).With(labelsAndValues...),
 		BlockStoreBaseHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -106,6 +112,7 @@ func NopMetrics() *Metrics {
 		PruningServiceTxIndexerRetainHeight:    discard.NewGauge(),
 		PruningServiceBlockIndexerRetainHeight: discard.NewGauge(),
 		ApplicationBlockRetainHeight:           discard.NewGauge(),
+		OperatorBlockRetainHeight:              discard.NewGauge(),
 		BlockStoreBaseHeight:                   discard.NewGauge(),
 		ABCIResultsBaseHeight:                  discard.NewGauge(),
 		TxIndexerBaseHeight:                    discard.NewGauge(),
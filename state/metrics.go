@@ -44,6 +44,10 @@ type Metrics struct {
 	// retain height set by the application
 	ApplicationBlockRetainHeight metrics.Gauge
 
+	// OperatorBlockRetainHeight is the block retain height derived
+	// from the pruning targets set by the operator
+	OperatorBlockRetainHeight metrics.Gauge
+
 	// BlockStoreBaseHeight shows the first height at which
 	// a block is available
 	BlockStoreBaseHeight metrics.Gauge
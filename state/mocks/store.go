@@ -52,6 +52,24 @@ func (_m *Store) Close() error {
 	return r0
 }
 
+// DeleteStatesAbove provides a mock function with given fields: height, toHeight
+func (_m *Store) DeleteStatesAbove(height int64, toHeight int64) error {
+	ret := _m.Called(height, toHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStatesAbove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(height, toHeight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetABCIResRetainHeight provides a mock function with no fields
 func (_m *Store) GetABCIResRetainHeight() (int64, error) {
 	ret := _m.Called()
@@ -2,6 +2,7 @@ package state
 
 import (
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
+	cmttime "github.com/cometbft/cometbft/types/time"
 )
 
 var (
@@ -39,16 +41,24 @@ type Pruner struct {
 	observer     PrunerObserver
 	metrics      *Metrics
 
+	// Pruning targets set by the node operator. Zero values disable them.
+	maxBlockAge        time.Duration
+	maxBlockStoreBytes int64
+	blockStoreSize     func() (int64, error)
+
 	// Preserve the number of state entries pruned.
 	// Used to calculated correctly when to trigger compactions
 	prunedStates uint64
 }
 
 type prunerConfig struct {
-	dcEnabled bool
-	interval  time.Duration
-	observer  PrunerObserver
-	metrics   *Metrics
+	dcEnabled          bool
+	interval           time.Duration
+	observer           PrunerObserver
+	metrics            *Metrics
+	maxBlockAge        time.Duration
+	maxBlockStoreBytes int64
+	blockStoreSize     func() (int64, error)
 }
 
 func defaultPrunerConfig() *prunerConfig {
@@ -88,6 +98,23 @@ func WithPrunerMetrics(metrics *Metrics) PrunerOption {
 	}
 }
 
+// WithPrunerMaxBlockAge allows the pruner to prune blocks whose time is older
+// than maxAge, provided that neither the application nor the data companion
+// require them.
+func WithPrunerMaxBlockAge(maxAge time.Duration) PrunerOption {
+	return func(p *prunerConfig) { p.maxBlockAge = maxAge }
+}
+
+// WithPrunerMaxBlockStoreSize allows the pruner to prune the oldest blocks to
+// keep the size of the block store, as reported by size, under maxBytes,
+// provided that neither the application nor the data companion require them.
+func WithPrunerMaxBlockStoreSize(maxBytes int64, size func() (int64, error)) PrunerOption {
+	return func(p *prunerConfig) {
+		p.maxBlockStoreBytes = maxBytes
+		p.blockStoreSize = size
+	}
+}
+
 // NewPruner creates a service that controls background pruning of node data.
 //
 // Assumes that the initial application and data companion retain heights have
@@ -114,6 +141,10 @@ func NewPruner(
 		observer:     cfg.observer,
 		metrics:      cfg.metrics,
 		dcEnabled:    cfg.dcEnabled,
+
+		maxBlockAge:        cfg.maxBlockAge,
+		maxBlockStoreBytes: cfg.maxBlockStoreBytes,
+		blockStoreSize:     cfg.blockStoreSize,
 	}
 	p.BaseService = *service.NewBaseService(logger, "Pruner", p)
 	return p
@@ -420,7 +451,7 @@ func (p *Pruner) pruneBlockIndexerToRetainHeight(lastRetainHeight int64) int64 {
 
 func (p *Pruner) pruneBlocksToRetainHeight(lastRetainHeight int64) int64 {
 	targetRetainHeight := p.findMinBlockRetainHeight()
-	if targetRetainHeight == lastRetainHeight {
+	if targetRetainHeight <= lastRetainHeight {
 		return lastRetainHeight
 	}
 	pruned, evRetainHeight, err := p.pruneBlocksToHeight(targetRetainHeight)
@@ -470,16 +501,34 @@ func (p *Pruner) pruneABCIResToRetainHeight(lastRetainHeight int64) int64 {
 	return newRetainHeight
 }
 
+// findMinBlockRetainHeight returns the lowest of the block retain heights set
+// by the application, the data companion (if enabled) and the operator's
+// pruning targets, so that no block is pruned before all of them allow it.
+//
+// As a deliberate exception to this rule, an application retain height of 0
+// does not prevent the operator's targets from being applied: it is what
+// applications which never set a retain height report, and treating it as
+// "retain everything" would make the operator's targets useless with them.
+// A data companion retain height of 0, on the other hand, prevents any
+// pruning, since enabling the companion is an explicit choice.
 func (p *Pruner) findMinBlockRetainHeight() int64 {
 	appRetainHeight, err := p.stateStore.GetApplicationRetainHeight()
 	if err != nil {
 		p.logger.Error("Unexpected error fetching application retain height", "err", err)
 		return 0
 	}
+	retainHeight := appRetainHeight
+	operatorRetainHeight := p.findOperatorRetainHeight()
+	p.metrics.OperatorBlockRetainHeight.Set(float64(operatorRetainHeight))
+	if operatorRetainHeight > 0 {
+		if retainHeight == 0 || operatorRetainHeight < retainHeight {
+			retainHeight = operatorRetainHeight
+		}
+	}
 	// We only care about the companion retain height if pruning is configured
 	// to respect the companion's retain height.
 	if !p.dcEnabled {
-		return appRetainHeight
+		return retainHeight
 	}
 	dcRetainHeight, err := p.stateStore.GetCompanionBlockRetainHeight()
 	if err != nil {
@@ -488,12 +537,82 @@ func (p *Pruner) findMinBlockRetainHeight() int64 {
 	}
 	// If we are here, both heights were set and the companion is enabled, so
 	// we pick the minimum.
-	if appRetainHeight < dcRetainHeight {
-		return appRetainHeight
+	if retainHeight < dcRetainHeight {
+		return retainHeight
 	}
 	return dcRetainHeight
 }
 
+// findOperatorRetainHeight returns the block retain height satisfying the
+// pruning targets set by the operator, or 0 if no target is set. If no target
+// requires pruning, the base of the block store is returned so that all the
+// blocks are retained. When both the age and the size targets require
+// pruning, the lowest of the two retain heights is returned. The latest block
+// is never pruned.
+func (p *Pruner) findOperatorRetainHeight() int64 {
+	if p.maxBlockAge <= 0 && p.maxBlockStoreBytes <= 0 {
+		return 0
+	}
+	base, height := p.bs.Base(), p.bs.Height()
+	if base <= 0 || height <= base {
+		return base
+	}
+
+	var retainHeight int64
+	if p.maxBlockAge > 0 {
+		retainHeight = p.ageRetainHeight(base, height, cmttime.Now().Add(-p.maxBlockAge))
+	}
+	if p.maxBlockStoreBytes > 0 {
+		sizeRetainHeight, err := p.sizeRetainHeight(base, height)
+		if err != nil {
+			p.logger.Error("Failed to get the size of the block store", "err", err)
+		} else if sizeRetainHeight > 0 && (retainHeight == 0 || sizeRetainHeight < retainHeight) {
+			retainHeight = sizeRetainHeight
+		}
+	}
+	if retainHeight <= base {
+		return base
+	}
+	return retainHeight
+}
+
+// ageRetainHeight returns the first height in [base, height] whose block time
+// is not before cutoff, or height if all the blocks are older. It returns 0 if
+// no block is older than cutoff.
+func (p *Pruner) ageRetainHeight(base, height int64, cutoff time.Time) int64 {
+	i := sort.Search(int(height-base), func(i int) bool {
+		meta := p.bs.LoadBlockMeta(base + int64(i))
+		// A missing block was pruned concurrently, so it is old enough.
+		return meta != nil && !meta.Header.Time.Before(cutoff)
+	})
+	if i == 0 {
+		return 0
+	}
+	return base + int64(i)
+}
+
+// sizeRetainHeight estimates the retain height keeping the block store under
+// maxBlockStoreBytes, assuming that all blocks have the same size. It returns
+// 0 if the block store is already small enough.
+//
+// The size on disk only decreases once the database has been compacted, so
+// the target is best used with compaction enabled.
+func (p *Pruner) sizeRetainHeight(base, height int64) (int64, error) {
+	size, err := p.blockStoreSize()
+	if err != nil {
+		return 0, err
+	}
+	if size <= p.maxBlockStoreBytes {
+		return 0, nil
+	}
+	bytesPerBlock := size / (height - base + 1)
+	if bytesPerBlock == 0 {
+		return 0, nil
+	}
+	retainHeight := height - p.maxBlockStoreBytes/bytesPerBlock + 1
+	return min(max(retainHeight, base), height), nil
+}
+
 func (p *Pruner) pruneBlocksToHeight(height int64) (uint64, int64, error) {
 	if height <= 0 {
 		return 0, 0, ErrInvalidRetainHeight
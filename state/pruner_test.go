@@ -277,3 +277,70 @@ func TestPruningWithHeight1(t *testing.T) {
 	require.Equal(t, uint64(0), pruned)
 	require.NoError(t, err)
 }
+
+func TestOperatorRetainHeight(t *testing.T) {
+	state, bs, txIndexer, blockIndexer, cleanup, stateStore := makeStateAndBlockStoreAndIndexers()
+	defer cleanup()
+	require.NoError(t, initStateStoreRetainHeights(stateStore))
+
+	// Block h is (10-h) hours old.
+	now := time.Now()
+	for h := int64(1); h <= 10; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		block.Time = now.Add(-time.Duration(10-h) * time.Hour)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlock(block, partSet, &types.Commit{Height: h})
+	}
+	blockStoreSize := func() (int64, error) { return 1000, nil }
+
+	newPruner := func(options ...sm.PrunerOption) *sm.Pruner {
+		return sm.NewPruner(stateStore, bs, blockIndexer, txIndexer, log.TestingLogger(), options...)
+	}
+
+	testCases := []struct {
+		name            string
+		options         []sm.PrunerOption
+		appRetainHeight int64
+		dcRetainHeight  int64
+		expected        int64
+	}{
+		{"no targets", nil, 0, 0, 0},
+		{"no targets, app", nil, 3, 0, 3},
+		{"age", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(270 * time.Minute)}, 0, 0, 6},
+		{"age, lower app", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(270 * time.Minute)}, 3, 0, 3},
+		{"age, higher app", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(270 * time.Minute)}, 8, 0, 6},
+		{"age, all blocks recent", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(24 * time.Hour)}, 0, 0, 1},
+		{"age, all blocks recent, higher app", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(24 * time.Hour)}, 8, 0, 1},
+		{"age, all blocks old", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(time.Minute)}, 0, 0, 10},
+		// 100 bytes per block, 4 blocks fit in 400 bytes.
+		{"size", []sm.PrunerOption{sm.WithPrunerMaxBlockStoreSize(400, blockStoreSize)}, 0, 0, 7},
+		{"size, under target", []sm.PrunerOption{sm.WithPrunerMaxBlockStoreSize(2000, blockStoreSize)}, 0, 0, 1},
+		{"size, under target, higher app", []sm.PrunerOption{sm.WithPrunerMaxBlockStoreSize(2000, blockStoreSize)}, 8, 0, 1},
+		{
+			"age and size",
+			[]sm.PrunerOption{
+				sm.WithPrunerMaxBlockAge(270 * time.Minute),
+				sm.WithPrunerMaxBlockStoreSize(400, blockStoreSize),
+			},
+			0, 0, 6,
+		},
+		{
+			"size, all blocks recent",
+			[]sm.PrunerOption{
+				sm.WithPrunerMaxBlockAge(24 * time.Hour),
+				sm.WithPrunerMaxBlockStoreSize(400, blockStoreSize),
+			},
+			0, 0, 7,
+		},
+		{"age, companion not set", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(270 * time.Minute), sm.WithPrunerCompanionEnabled()}, 0, 0, 0},
+		{"age, lower companion", []sm.PrunerOption{sm.WithPrunerMaxBlockAge(270 * time.Minute), sm.WithPrunerCompanionEnabled()}, 0, 4, 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, stateStore.SaveApplicationRetainHeight(tc.appRetainHeight))
+			require.NoError(t, stateStore.SaveCompanionBlockRetainHeight(tc.dcRetainHeight))
+			require.Equal(t, tc.expected, newPruner(tc.options...).FindMinRetainHeight())
+		})
+	}
+}
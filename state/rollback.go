@@ -1,11 +1,13 @@
 package state
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
 )
 
@@ -79,11 +81,197 @@ func Rollback(bs BlockStore, ss Store, removeBlock bool) (int64, []byte, error)
 	}
 
 	// build the new state from the old state and the prior block
-	rolledBackState := State{
+	rolledBackState := makeRolledBackState(invalidState, rollbackBlock, latestBlock,
+		previousLastValidatorSet, invalidState.LastValidators, invalidState.Validators,
+		previousParams, valChangeHeight, paramsChangeHeight)
+
+	// persist the new state. This overrides the invalid one. NOTE: this will also
+	// persist the validator set and consensus params over the existing structures,
+	// but both should be the same
+	if err := ss.Save(rolledBackState); err != nil {
+		return -1, nil, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+
+	// If removeBlock is true then also remove the block associated with the previous state.
+	// This will mean both the last state and last block height is equal to n - 1
+	if removeBlock {
+		if err := bs.DeleteLatestBlock(); err != nil {
+			return -1, nil, fmt.Errorf("failed to remove final block from blockstore: %w", err)
+		}
+	}
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
+
+// RollbackToHeight overwrites the current CometBFT state (height n) with the
+// state at the given height, which must be below n. All blocks above height + 1
+// are removed from the block store, along with the ABCI responses, validator
+// sets and consensus params saved for the heights above height.
+// If removeBlock is true, the block at height + 1 is removed as well;
+// otherwise it is re-executed against the application upon restart, as with
+// Rollback.
+//
+// The new state is persisted first, then the obsolete state entries and
+// finally the blocks are removed. If the rollback is interrupted, the stored
+// state is already at height and calling RollbackToHeight again with the same
+// arguments completes it.
+//
+// If dryRun is true, all the checks are performed and the resulting height and
+// app hash are returned, but neither store is modified.
+// Note that this function does not affect application state.
+func RollbackToHeight(bs BlockStore, ss Store, height int64, removeBlock, dryRun bool) (int64, []byte, error) {
+	invalidState, err := ss.Load()
+	if err != nil {
+		return -1, nil, err
+	}
+	if invalidState.IsEmpty() {
+		return -1, nil, errors.New("no state found")
+	}
+
+	// keepHeight is the latest height left in the block store after the rollback.
+	keepHeight := height + 1
+	if removeBlock {
+		keepHeight = height
+	}
+
+	storeHeight := bs.Height()
+
+	// The state has already been rolled back, possibly by an interrupted
+	// previous run: only the removal of blocks is left.
+	if invalidState.LastBlockHeight == height {
+		if storeHeight < height {
+			return -1, nil, fmt.Errorf("blockstore height (%d) is below the statestore height (%d)",
+				storeHeight, height)
+		}
+		if !dryRun {
+			if err := deleteAbove(bs, ss, height, keepHeight); err != nil {
+				return -1, nil, err
+			}
+		}
+		return invalidState.LastBlockHeight, invalidState.AppHash, nil
+	}
+
+	if height > invalidState.LastBlockHeight {
+		return -1, nil, fmt.Errorf("cannot roll back to height %d above the statestore height (%d)",
+			height, invalidState.LastBlockHeight)
+	}
+	if height < invalidState.InitialHeight {
+		return -1, nil, fmt.Errorf("cannot roll back to height %d below the initial height (%d)",
+			height, invalidState.InitialHeight)
+	}
+	// The block store may be below the statestore height if a previous run was
+	// interrupted while removing blocks.
+	if storeHeight > invalidState.LastBlockHeight+1 || storeHeight <= height {
+		return -1, nil, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			invalidState.LastBlockHeight, storeHeight)
+	}
+	if base := bs.Base(); height < base {
+		return -1, nil, fmt.Errorf("block at height %d has been pruned (blockstore base %d)", height, base)
+	}
+
+	rollbackBlock := bs.LoadBlockMeta(height)
+	if rollbackBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", height)
+	}
+	// The app hash and last results hash of the rollback height are only
+	// agreed upon in the following block.
+	latestBlock := bs.LoadBlockMeta(height + 1)
+	if latestBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", height+1)
+	}
+
+	lastVals, err := ss.LoadValidators(height)
+	if err != nil {
+		return -1, nil, fmt.Errorf("validators at height %d not available: %w", height, err)
+	}
+	vals, err := ss.LoadValidators(height + 1)
+	if err != nil {
+		return -1, nil, fmt.Errorf("validators at height %d not available: %w", height+1, err)
+	}
+	nextVals, err := ss.LoadValidators(height + 2)
+	if err != nil {
+		return -1, nil, fmt.Errorf("validators at height %d not available: %w", height+2, err)
+	}
+	params, err := ss.LoadConsensusParams(height + 1)
+	if err != nil {
+		return -1, nil, fmt.Errorf("consensus params at height %d not available: %w", height+1, err)
+	}
+
+	// Make sure the stored data matches what the blocks committed to before
+	// overwriting anything.
+	if !bytes.Equal(lastVals.Hash(), rollbackBlock.Header.ValidatorsHash) ||
+		!bytes.Equal(vals.Hash(), latestBlock.Header.ValidatorsHash) ||
+		!bytes.Equal(nextVals.Hash(), latestBlock.Header.NextValidatorsHash) {
+		return -1, nil, fmt.Errorf("stored validator sets do not match the headers at heights %d and %d",
+			height, height+1)
+	}
+	if !bytes.Equal(params.Hash(), latestBlock.Header.ConsensusHash) {
+		return -1, nil, fmt.Errorf("stored consensus params do not match the header at height %d", height+1)
+	}
+
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	// this can only happen if the validator set changed after the rollback height
+	if valChangeHeight > height+2 {
+		valChangeHeight = height + 2
+	}
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	// this can only happen if params changed after the rollback height
+	if paramsChangeHeight > height+1 {
+		paramsChangeHeight = height + 1
+	}
+
+	rolledBackState := makeRolledBackState(invalidState, rollbackBlock, latestBlock,
+		lastVals, vals, nextVals, params, valChangeHeight, paramsChangeHeight)
+
+	if dryRun {
+		return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+	}
+
+	if err := ss.Save(rolledBackState); err != nil {
+		return -1, nil, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+	if err := deleteAbove(bs, ss, height, keepHeight); err != nil {
+		return -1, nil, err
+	}
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
+
+// deleteAbove removes the state entries saved for the heights above height,
+// then the blocks above keepHeight. The block store height bounds the heights
+// which may have state entries, as a block is saved before it is executed.
+func deleteAbove(bs BlockStore, ss Store, height, keepHeight int64) error {
+	if err := ss.DeleteStatesAbove(height, bs.Height()); err != nil {
+		return fmt.Errorf("failed to remove the states above height %d: %w", height, err)
+	}
+	return deleteBlocksAbove(bs, keepHeight)
+}
+
+// deleteBlocksAbove removes blocks from the top of the block store until its
+// height is the given one.
+func deleteBlocksAbove(bs BlockStore, height int64) error {
+	for bs.Height() > height {
+		if err := bs.DeleteLatestBlock(); err != nil {
+			return fmt.Errorf("failed to remove block %d from blockstore: %w", bs.Height(), err)
+		}
+	}
+	return nil
+}
+
+// makeRolledBackState builds the state at the height of rollbackBlock from
+// the invalid state, the block at the rollback height and the block after it.
+func makeRolledBackState(
+	invalidState State,
+	rollbackBlock, latestBlock *types.BlockMeta,
+	lastVals, vals, nextVals *types.ValidatorSet,
+	params types.ConsensusParams,
+	valChangeHeight, paramsChangeHeight int64,
+) State {
+	return State{
 		Version: cmtstate.Version{
 			Consensus: cmtversion.Consensus{
 				Block: version.BlockProtocol,
-				App:   previousParams.Version.App,
+				App:   params.Version.App,
 			},
 			Software: version.CMTSemVer,
 		},
@@ -95,32 +283,15 @@ func Rollback(bs BlockStore, ss Store, removeBlock bool) (int64, []byte, error)
 		LastBlockID:     rollbackBlock.BlockID,
 		LastBlockTime:   rollbackBlock.Header.Time,
 
-		NextValidators:              invalidState.Validators,
-		Validators:                  invalidState.LastValidators,
-		LastValidators:              previousLastValidatorSet,
+		NextValidators:              nextVals,
+		Validators:                  vals,
+		LastValidators:              lastVals,
 		LastHeightValidatorsChanged: valChangeHeight,
 
-		ConsensusParams:                  previousParams,
+		ConsensusParams:                  params,
 		LastHeightConsensusParamsChanged: paramsChangeHeight,
 
 		LastResultsHash: latestBlock.Header.LastResultsHash,
 		AppHash:         latestBlock.Header.AppHash,
 	}
-
-	// persist the new state. This overrides the invalid one. NOTE: this will also
-	// persist the validator set and consensus params over the existing structures,
-	// but both should be the same
-	if err := ss.Save(rolledBackState); err != nil {
-		return -1, nil, fmt.Errorf("failed to save rolled back state: %w", err)
-	}
-
-	// If removeBlock is true then also remove the block associated with the previous state.
-	// This will mean both the last state and last block height is equal to n - 1
-	if removeBlock {
-		if err := bs.DeleteLatestBlock(); err != nil {
-			return -1, nil, fmt.Errorf("failed to remove final block from blockstore: %w", err)
-		}
-	}
-
-	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
 }
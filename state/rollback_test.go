@@ -8,10 +8,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/mocks"
 	"github.com/cometbft/cometbft/store"
@@ -238,6 +240,184 @@ func TestRollbackDifferentStateHeight(t *testing.T) {
 	require.Equal(t, "statestore height (100) is not one below or equal to blockstore height (102)", err.Error())
 }
 
+func TestRollbackToHeight(t *testing.T) {
+	const numBlocks int64 = 8
+	blockStore, stateStore, states := setupRollbackChain(t, numBlocks)
+
+	requireStateAt := func(expected state.State) {
+		t.Helper()
+		loaded, err := stateStore.Load()
+		require.NoError(t, err)
+		require.Equal(t, expected.LastBlockHeight, loaded.LastBlockHeight)
+		require.Equal(t, expected.LastBlockID, loaded.LastBlockID)
+		require.Equal(t, expected.AppHash, loaded.AppHash)
+		require.Equal(t, expected.LastResultsHash, loaded.LastResultsHash)
+		require.Equal(t, expected.LastValidators.Hash(), loaded.LastValidators.Hash())
+		require.Equal(t, expected.Validators, loaded.Validators)
+		require.Equal(t, expected.NextValidators.Hash(), loaded.NextValidators.Hash())
+		require.Equal(t, expected.ConsensusParams, loaded.ConsensusParams)
+	}
+
+	// a dry run changes nothing
+	height, hash, err := state.RollbackToHeight(blockStore, stateStore, 4, false, true)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, height)
+	require.Equal(t, states[4].AppHash, hash)
+	require.Equal(t, numBlocks, blockStore.Height())
+	requireStateAt(states[numBlocks])
+
+	// the rollback crosses the validator set change at height 5
+	height, hash, err = state.RollbackToHeight(blockStore, stateStore, 4, false, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, height)
+	require.Equal(t, states[4].AppHash, hash)
+	require.EqualValues(t, 5, blockStore.Height())
+	requireStateAt(states[4])
+	loaded, err := stateStore.Load()
+	require.NoError(t, err)
+	require.EqualValues(t, 5, loaded.LastHeightValidatorsChanged)
+
+	// the ABCI responses, validators and params above the rollback height are removed
+	requireStatesRemovedAbove(t, stateStore, 4, numBlocks)
+
+	// rolling back to the same height only removes the remaining block
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 4, true, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, blockStore.Height())
+	requireStateAt(states[4])
+
+	height, _, err = state.RollbackToHeight(blockStore, stateStore, 1, true, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, height)
+	require.EqualValues(t, 1, blockStore.Height())
+	requireStateAt(states[1])
+
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 2, false, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "above the statestore height")
+}
+
+func TestRollbackToHeightInterrupted(t *testing.T) {
+	const numBlocks int64 = 6
+	blockStore, stateStore, states := setupRollbackChain(t, numBlocks)
+
+	// A previous run removed some of the blocks before stopping.
+	require.NoError(t, blockStore.DeleteLatestBlock())
+	require.NoError(t, blockStore.DeleteLatestBlock())
+
+	height, hash, err := state.RollbackToHeight(blockStore, stateStore, 2, true, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, height)
+	require.Equal(t, states[2].AppHash, hash)
+	require.EqualValues(t, 2, blockStore.Height())
+
+	// The block store cannot be below the rollback height.
+	blockStore, stateStore, _ = setupRollbackChain(t, numBlocks)
+	for blockStore.Height() > 2 {
+		require.NoError(t, blockStore.DeleteLatestBlock())
+	}
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 2, false, true)
+	require.Error(t, err)
+}
+
+func TestRollbackToHeightInterruptedAfterSave(t *testing.T) {
+	const numBlocks int64 = 6
+	blockStore, stateStore, states := setupRollbackChain(t, numBlocks)
+
+	// A previous run saved the rolled back state before stopping.
+	require.NoError(t, stateStore.Save(states[2]))
+
+	height, _, err := state.RollbackToHeight(blockStore, stateStore, 2, false, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, height)
+	require.EqualValues(t, 3, blockStore.Height())
+	requireStatesRemovedAbove(t, stateStore, 2, numBlocks)
+}
+
+func TestRollbackToHeightPruned(t *testing.T) {
+	const numBlocks int64 = 6
+	blockStore, stateStore, states := setupRollbackChain(t, numBlocks)
+
+	_, _, err := blockStore.PruneBlocks(3, states[numBlocks])
+	require.NoError(t, err)
+
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 2, false, true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "has been pruned")
+
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 3, false, true)
+	require.NoError(t, err)
+}
+
+// requireStatesRemovedAbove checks that the store only keeps the entries
+// needed by the state at the given height.
+func requireStatesRemovedAbove(t *testing.T, stateStore state.Store, height, numBlocks int64) {
+	t.Helper()
+
+	_, err := stateStore.LoadFinalizeBlockResponse(height)
+	require.NoError(t, err)
+	_, err = stateStore.LoadValidators(height + 2)
+	require.NoError(t, err)
+	_, err = stateStore.LoadConsensusParams(height + 1)
+	require.NoError(t, err)
+	for h := height + 1; h <= numBlocks; h++ {
+		_, err = stateStore.LoadFinalizeBlockResponse(h)
+		require.ErrorAs(t, err, &state.ErrNoABCIResponsesForHeight{}, "height %d", h)
+		_, err = stateStore.LoadValidators(h + 2)
+		require.Error(t, err, "height %d", h+2)
+		_, err = stateStore.LoadConsensusParams(h + 1)
+		require.Error(t, err, "height %d", h+1)
+	}
+}
+
+// setupRollbackChain creates stores containing a chain of numBlocks blocks, in
+// which the power of one validator changes at height 3. It returns the stores
+// and the state after each height.
+func setupRollbackChain(t *testing.T, numBlocks int64) (*store.BlockStore, state.Store, map[int64]state.State) {
+	t.Helper()
+
+	genVals, privVals := test.GenesisValidatorSet(2)
+	currState, err := state.MakeGenesisState(&types.GenesisDoc{
+		ChainID:         chainID,
+		Validators:      genVals,
+		ConsensusParams: test.ConsensusParams(),
+	})
+	require.NoError(t, err)
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	stateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{})
+	require.NoError(t, stateStore.Save(currState))
+
+	states := make(map[int64]state.State, numBlocks)
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= numBlocks; height++ {
+		block := makeBlock(currState, height, lastCommit)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		extCommit, err := makeValidCommit(height, blockID, currState.Validators, privVals)
+		require.NoError(t, err)
+		lastCommit = extCommit.ToCommit()
+		blockStore.SaveBlock(block, partSet, lastCommit)
+
+		resp := &abci.FinalizeBlockResponse{
+			TxResults: []*abci.ExecTxResult{{Code: uint32(height)}},
+			AppHash:   tmhash.Sum([]byte{byte(height)}),
+		}
+		var valUpdates []*types.Validator
+		if height == 3 {
+			valUpdates = []*types.Validator{types.NewValidator(genVals[1].PubKey, 20)}
+		}
+		currState, err = state.UpdateState(currState, blockID, &block.Header, resp, valUpdates)
+		require.NoError(t, err)
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(height, resp))
+		require.NoError(t, stateStore.Save(currState))
+		states[height] = currState
+	}
+	return blockStore, stateStore, states
+}
+
 func setupStateStore(t *testing.T, height int64) state.Store {
 	t.Helper()
 	stateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{DiscardABCIResponses: false})
@@ -314,6 +314,7 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 		validators := make([]*types.Validator, len(genDoc.Validators))
 		for i, val := range genDoc.Validators {
 			validators[i] = types.NewValidator(val.PubKey, val.Power)
+			validators[i].ProofOfPossession = val.ProofOfPossession
 		}
 		validatorSet = types.NewValidatorSet(validators)
 		nextValidatorSet = types.NewValidatorSet(validators).CopyIncrementProposerPriority(1)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -14,6 +15,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/internal/keymigrate"
 	cmtos "github.com/cometbft/cometbft/internal/os"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
@@ -148,6 +150,42 @@ func (v2l v2Layout) CalcValidatorsKey(height int64) []byte {
 
 var _ KeyLayout = (*v2Layout)(nil)
 
+// MigrateKeyLayoutToV2 rewrites the keys of a state store database from the
+// v1 to the v2 layout. It returns the number of keys rewritten, and reports
+// the progress to progress if it is not nil. The database must not be in use while
+// it is migrated; an interrupted migration is resumed by calling
+// MigrateKeyLayoutToV2 again.
+func MigrateKeyLayoutToV2(db dbm.DB, progress func(migrated int64)) (int64, error) {
+	return keymigrate.MigrateV1ToV2(db, v1KeyToV2, keymigrate.WithProgress(progress))
+}
+
+// v1KeyToV2 translates a key of the v1 layout to the v2 layout. Keys which
+// do not depend on the layout, such as the state itself or retain heights,
+// are left untouched.
+func v1KeyToV2(key []byte) ([]byte, bool, error) {
+	var v2 v2Layout
+	for _, p := range v1KeyPrefixes {
+		if heightStr, found := strings.CutPrefix(string(key), p.v1); found {
+			height, err := strconv.ParseInt(heightStr, 10, 64)
+			if err != nil {
+				return nil, false, err
+			}
+			return v2.encodeKey(p.v2, height), true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// v1KeyPrefixes maps the prefixes of the v1 layout to the v2 ones.
+var v1KeyPrefixes = []struct {
+	v1 string
+	v2 int64
+}{
+	{"abciResponsesKey:", prefixABCIResponses},
+	{"consensusParamsKey:", prefixConsensusParams},
+	{"validatorsKey:", prefixValidators},
+}
+
 //go:generate ../scripts/mockery_generate.sh Store
 
 // Store defines the state store interface
@@ -181,6 +219,10 @@ type Store interface {
 	PruneStates(fromHeight, toHeight, evidenceThresholdHeight int64, previouslyPrunedStates uint64) (uint64, error)
 	// PruneABCIResponses will prune all ABCI responses below the given height.
 	PruneABCIResponses(targetRetainHeight int64, forceCompact bool) (int64, int64, error)
+	// DeleteStatesAbove deletes the ABCI responses, validator sets and consensus
+	// params saved for the heights above the given one, up to toHeight, in a
+	// single batch. The entries needed by the state at height are kept.
+	DeleteStatesAbove(height, toHeight int64) error
 	// SaveApplicationRetainHeight persists the application retain height from the application
 	SaveApplicationRetainHeight(height int64) error
 	// GetApplicationRetainHeight returns the retain height set by the application
@@ -673,6 +715,28 @@ func (store dbStore) PruneABCIResponses(targetRetainHeight int64, forceCompact b
 	return pruned + batchPruned, targetRetainHeight, err
 }
 
+// DeleteStatesAbove deletes the ABCI responses, validator sets and consensus
+// params saved for the heights above the given one, up to toHeight, in a
+// single batch. The state at height uses the validator set saved at height + 2
+// and the consensus params saved at height + 1, so these are kept.
+func (store dbStore) DeleteStatesAbove(height, toHeight int64) error {
+	batch := store.db.NewBatch()
+	defer batch.Close()
+
+	for h := height + 1; h <= toHeight; h++ {
+		if err := batch.Delete(store.DBKeyLayout.CalcABCIResponsesKey(h)); err != nil {
+			return fmt.Errorf("failed to delete ABCI responses at height %d: %w", h, err)
+		}
+		if err := batch.Delete(store.DBKeyLayout.CalcConsensusParamsKey(h + 1)); err != nil {
+			return fmt.Errorf("failed to delete consensus params at height %d: %w", h+1, err)
+		}
+		if err := batch.Delete(store.DBKeyLayout.CalcValidatorsKey(h + 2)); err != nil {
+			return fmt.Errorf("failed to delete validators at height %d: %w", h+2, err)
+		}
+	}
+	return batch.WriteSync()
+}
+
 // ------------------------------------------------------------------------
 
 // TxResultsHash returns the root hash of a Merkle tree of
@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -61,6 +62,54 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestMigrateKeyLayoutToV2(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DBKeyLayout: "v1"})
+	vals, _ := test.ValidatorSet(context.Background(), t, 2, 10)
+	state, err := sm.MakeGenesisState(test.GenesisDoc(time.Now(), vals.Validators, nil, test.DefaultTestChainID))
+	require.NoError(t, err)
+	state.LastValidators = state.Validators.Copy()
+	for h := int64(1); h <= 3; h++ {
+		// Store the full validator set and parameters at every height.
+		state.LastBlockHeight = h
+		state.LastHeightValidatorsChanged = h + 2
+		state.LastHeightConsensusParamsChanged = h + 1
+		require.NoError(t, stateStore.Save(state))
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.FinalizeBlockResponse{
+			TxResults: []*abci.ExecTxResult{{Code: uint32(h)}},
+			AppHash:   []byte{byte(h)},
+		}))
+	}
+
+	migrated, err := sm.MigrateKeyLayoutToV2(stateDB, nil)
+	require.NoError(t, err)
+	require.Positive(t, migrated)
+
+	// The recorded layout takes precedence over the requested one.
+	stateStore = sm.NewStore(stateDB, sm.StoreOptions{DBKeyLayout: "v1"})
+	loaded, err := stateStore.Load()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, loaded.LastBlockHeight)
+	for h := int64(1); h <= 3; h++ {
+		vals, err := stateStore.LoadValidators(h + 2)
+		require.NoError(t, err)
+		require.Equal(t, state.NextValidators.Hash(), vals.Hash())
+
+		params, err := stateStore.LoadConsensusParams(h + 1)
+		require.NoError(t, err)
+		require.Equal(t, state.ConsensusParams.Hash(), params.Hash())
+
+		resp, err := stateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(h)}, resp.AppHash)
+	}
+
+	// Migrating again does nothing.
+	migrated, err = sm.MigrateKeyLayoutToV2(stateDB, nil)
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
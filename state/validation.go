@@ -86,10 +86,16 @@ func validateBlock(state State, block *types.Block) error {
 
 	// Validate block LastCommit.
 	if block.Height == state.InitialHeight {
-		if len(block.LastCommit.Signatures) != 0 {
+		if len(block.LastCommit.Signatures) != 0 || block.LastCommit.IsAggregated() {
 			return errors.New("initial block can't have LastCommit signatures")
 		}
 	} else {
+		aggregated := types.UseAggregatedCommit(state.ConsensusParams.Feature, block.Height-1, state.LastValidators)
+		if block.LastCommit.IsAggregated() != aggregated {
+			return fmt.Errorf("expected aggregated LastCommit to be %v, got %v",
+				aggregated, block.LastCommit.IsAggregated())
+		}
+
 		// LastCommit.Signatures length is checked in VerifyCommit.
 		if err := state.LastValidators.VerifyCommit(
 			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
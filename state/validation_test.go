@@ -10,8 +10,10 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/internal/bits"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
 	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
@@ -212,6 +214,24 @@ func TestValidateBlockCommit(t *testing.T) {
 				height,
 				err,
 			)
+
+			/*
+				aggregated commits are rejected while the feature is disabled
+			*/
+			validators := bits.NewBitArray(1)
+			validators.SetIndex(0, true)
+			aggregatedCommit := &types.Commit{
+				Height:  height - 1,
+				BlockID: state.LastBlockID,
+				AggregatedSignatures: []types.AggregatedCommitSig{{
+					BlockIDFlag: types.BlockIDFlagCommit,
+					Validators:  validators,
+					Signature:   make([]byte, bls12381.SignatureLength),
+				}},
+			}
+			block = makeBlock(state, height, aggregatedCommit)
+			err = blockExec.ValidateBlock(state, block)
+			require.ErrorContains(t, err, "expected aggregated LastCommit to be false")
 		}
 
 		/*
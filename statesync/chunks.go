@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/cometbft/cometbft/internal/tempfile"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
 )
@@ -31,6 +33,7 @@ type chunkQueue struct {
 	cmtsync.Mutex
 	snapshot       *snapshot                  // if this is nil, the queue has been closed
 	dir            string                     // temp dir for on-disk chunk storage
+	persistent     bool                       // whether chunk files are kept when closing
 	chunkFiles     map[uint32]string          // path to temporary chunk file
 	chunkSenders   map[uint32]p2p.ID          // the peer who sent the given chunk
 	chunkAllocated map[uint32]bool            // chunks that have been allocated via Allocate()
@@ -59,6 +62,46 @@ func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
 	}, nil
 }
 
+// newPersistentChunkQueue creates a chunk queue for a snapshot storing chunks in dir, which is
+// kept when the queue is closed. Chunks already in dir, written by a previous queue for the same
+// snapshot, are loaded into the queue and will not be fetched again.
+func newPersistentChunkQueue(snapshot *snapshot, dir string) (*chunkQueue, error) {
+	if snapshot.Chunks == 0 {
+		return nil, errors.New("snapshot has no chunks")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create dir for state sync chunks: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read state sync chunks: %w", err)
+	}
+	q := &chunkQueue{
+		snapshot:       snapshot,
+		dir:            dir,
+		persistent:     true,
+		chunkFiles:     make(map[uint32]string, snapshot.Chunks),
+		chunkSenders:   make(map[uint32]p2p.ID, snapshot.Chunks),
+		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
+		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
+		waiters:        make(map[uint32][]chan<- uint32),
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		index, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil || uint32(index) >= snapshot.Chunks {
+			// Leftovers of an interrupted write, or of another snapshot.
+			if err := os.RemoveAll(path); err != nil {
+				return nil, fmt.Errorf("failed to remove %v: %w", path, err)
+			}
+			continue
+		}
+		q.chunkFiles[uint32(index)] = path
+		q.chunkAllocated[uint32(index)] = true
+	}
+	return q, nil
+}
+
 // Add adds a chunk to the queue. It ignores chunks that already exist, returning false.
 func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 	if chunk == nil || chunk.Chunk == nil {
@@ -83,7 +126,13 @@ func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 	}
 
 	path := filepath.Join(q.dir, strconv.FormatUint(uint64(chunk.Index), 10))
-	err := os.WriteFile(path, chunk.Chunk, 0o600)
+	var err error
+	if q.persistent {
+		// The chunk file must not be found truncated after a crash.
+		err = tempfile.WriteFileAtomic(path, chunk.Chunk, 0o600)
+	} else {
+		err = os.WriteFile(path, chunk.Chunk, 0o600)
+	}
 	if err != nil {
 		return false, fmt.Errorf("failed to save chunk %v to file %v: %w", chunk.Index, path, err)
 	}
@@ -120,7 +169,8 @@ func (q *chunkQueue) Allocate() (uint32, error) {
 	return 0, errDone
 }
 
-// Close closes the chunk queue, cleaning up all temporary files.
+// Close closes the chunk queue, cleaning up all temporary files. The files of a persistent queue
+// are kept.
 func (q *chunkQueue) Close() error {
 	q.Lock()
 	defer q.Unlock()
@@ -134,6 +184,9 @@ func (q *chunkQueue) Close() error {
 	}
 	q.waiters = nil
 	q.snapshot = nil
+	if q.persistent {
+		return nil
+	}
 	err := os.RemoveAll(q.dir)
 	if err != nil {
 		return fmt.Errorf("failed to clean up state sync tempdir %v: %w", q.dir, err)
@@ -271,6 +324,30 @@ func (q *chunkQueue) nextUp() (uint32, error) {
 	return 0, errDone
 }
 
+// MarkReturned marks chunks as already returned via Next(), e.g. because they were applied
+// before the node restarted.
+func (q *chunkQueue) MarkReturned(indexes []uint32) {
+	q.Lock()
+	defer q.Unlock()
+	for _, index := range indexes {
+		if q.chunkFiles[index] != "" {
+			q.chunkReturned[index] = true
+		}
+	}
+}
+
+// Returned returns the indexes of the chunks returned via Next(), in increasing order.
+func (q *chunkQueue) Returned() []uint32 {
+	q.Lock()
+	defer q.Unlock()
+	indexes := make([]uint32, 0, len(q.chunkReturned))
+	for index := range q.chunkReturned {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes
+}
+
 // Retry schedules a chunk to be retried, without refetching it.
 func (q *chunkQueue) Retry(index uint32) {
 	q.Lock()
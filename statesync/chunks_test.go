@@ -2,6 +2,7 @@ package statesync
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,50 @@ func TestNewChunkQueue_TempDir(t *testing.T) {
 	assert.Empty(t, files)
 }
 
+func TestNewPersistentChunkQueue(t *testing.T) {
+	snapshot := &snapshot{
+		Height:   3,
+		Format:   1,
+		Chunks:   5,
+		Hash:     []byte{7},
+		Metadata: nil,
+	}
+	dir := filepath.Join(t.TempDir(), "chunks")
+	queue, err := newPersistentChunkQueue(snapshot, dir)
+	require.NoError(t, err)
+
+	_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 0, Chunk: []byte{3, 1, 0}})
+	require.NoError(t, err)
+	_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 2, Chunk: []byte{3, 1, 2}})
+	require.NoError(t, err)
+	require.NoError(t, queue.Close())
+
+	// The chunks are kept, while leftovers of interrupted writes are removed.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "write-file-atomic-01"), []byte{1}, 0o600))
+	queue, err = newPersistentChunkQueue(snapshot, dir)
+	require.NoError(t, err)
+	defer queue.Close()
+
+	assert.True(t, queue.Has(0))
+	assert.False(t, queue.Has(1))
+	assert.True(t, queue.Has(2))
+	assert.NoFileExists(t, filepath.Join(dir, "write-file-atomic-01"))
+
+	// Chunks already on disk are not allocated again.
+	index, err := queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, index)
+
+	// Chunks marked as returned are skipped by Next().
+	queue.MarkReturned([]uint32{0})
+	_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 1, Chunk: []byte{3, 1, 1}})
+	require.NoError(t, err)
+	c, err := queue.Next()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, c.Index)
+	assert.Equal(t, []uint32{0, 1}, queue.Returned())
+}
+
 func TestChunkQueue(t *testing.T) {
 	queue, teardown := setupChunkQueue(t)
 	defer teardown()
@@ -0,0 +1,241 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	// errPeerBusy is returned when a request is already outstanding with a peer.
+	errPeerBusy = errors.New("a request is already outstanding with the peer")
+	// errPeerDisconnected is returned when a peer disconnects before responding.
+	errPeerDisconnected = errors.New("peer disconnected")
+	// errEvidenceNotSupported is returned when reporting evidence to a peer,
+	// which the state sync channels do not support.
+	errEvidenceNotSupported = errors.New("evidence cannot be reported over the state sync channels")
+)
+
+// dispatchKey identifies an outstanding request: at most one request per peer
+// and channel is outstanding at a time, since light block responses do not
+// carry the requested height.
+type dispatchKey struct {
+	peer    p2p.ID
+	channel byte
+}
+
+// dispatcher sends light block and consensus params requests to the peers
+// serving them, and routes the responses back to the callers.
+type dispatcher struct {
+	timeout time.Duration
+
+	mtx   cmtsync.Mutex
+	peers map[p2p.ID]p2p.Peer
+	calls map[dispatchKey]chan proto.Message
+}
+
+func newDispatcher(timeout time.Duration) *dispatcher {
+	return &dispatcher{
+		timeout: timeout,
+		peers:   make(map[p2p.ID]p2p.Peer),
+		calls:   make(map[dispatchKey]chan proto.Message),
+	}
+}
+
+// AddPeer adds a peer, if it serves light blocks.
+func (d *dispatcher) AddPeer(peer p2p.Peer) {
+	if !peer.HasChannel(LightBlockChannel) {
+		return
+	}
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.peers[peer.ID()] = peer
+}
+
+// RemovePeer removes a peer, failing its outstanding requests.
+func (d *dispatcher) RemovePeer(id p2p.ID) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	delete(d.peers, id)
+	for key, ch := range d.calls {
+		if key.peer == id {
+			close(ch)
+			delete(d.calls, key)
+		}
+	}
+}
+
+// Peers returns the peers serving light blocks, ordered by ID.
+func (d *dispatcher) Peers() []p2p.Peer {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	peers := make([]p2p.Peer, 0, len(d.peers))
+	for _, peer := range d.peers {
+		peers = append(peers, peer)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].ID() < peers[j].ID() })
+	return peers
+}
+
+// Respond routes a response from a peer to the outstanding request. It returns
+// false if no request was outstanding.
+func (d *dispatcher) Respond(peer p2p.ID, channel byte, msg proto.Message) bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	key := dispatchKey{peer: peer, channel: channel}
+	ch, ok := d.calls[key]
+	if !ok {
+		return false
+	}
+	delete(d.calls, key)
+	ch <- msg
+	return true
+}
+
+// call sends a request to a peer, and waits for the response.
+func (d *dispatcher) call(ctx context.Context, peer p2p.Peer, channel byte, request proto.Message) (proto.Message, error) {
+	key := dispatchKey{peer: peer.ID(), channel: channel}
+	ch := make(chan proto.Message, 1)
+
+	d.mtx.Lock()
+	if _, ok := d.calls[key]; ok {
+		d.mtx.Unlock()
+		return nil, errPeerBusy
+	}
+	d.calls[key] = ch
+	d.mtx.Unlock()
+
+	defer func() {
+		d.mtx.Lock()
+		defer d.mtx.Unlock()
+		if d.calls[key] == ch {
+			delete(d.calls, key)
+		}
+	}()
+
+	if err := peer.Send(p2p.Envelope{ChannelID: channel, Message: request}); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(d.timeout)
+	defer timer.Stop()
+	select {
+	case msg, ok := <-ch:
+		if !ok {
+			return nil, errPeerDisconnected
+		}
+		return msg, nil
+	case <-timer.C:
+		return nil, lightprovider.ErrNoResponse
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// LightBlock requests the light block at the given height from a peer, or the
+// latest light block of the peer if height is 0. It returns nil if the peer
+// does not have it.
+func (d *dispatcher) LightBlock(ctx context.Context, peer p2p.Peer, height uint64) (*types.LightBlock, error) {
+	msg, err := d.call(ctx, peer, LightBlockChannel, &ssproto.LightBlockRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := msg.(*ssproto.LightBlockResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response %T", msg)
+	}
+	if resp.LightBlock == nil {
+		return nil, nil
+	}
+	lb, err := types.LightBlockFromProto(resp.LightBlock)
+	if err != nil {
+		return nil, err
+	}
+	if height != 0 && lb.Height != int64(height) {
+		return nil, fmt.Errorf("expected light block at height %d, got %d", height, lb.Height)
+	}
+	return lb, nil
+}
+
+// ConsensusParams requests the consensus parameters at the given height from
+// a peer. It returns nil if the peer does not have them.
+func (d *dispatcher) ConsensusParams(ctx context.Context, peer p2p.Peer, height uint64) (*types.ConsensusParams, error) {
+	msg, err := d.call(ctx, peer, ParamsChannel, &ssproto.ParamsRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := msg.(*ssproto.ParamsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response %T", msg)
+	}
+	if resp.Height != height {
+		return nil, fmt.Errorf("expected consensus params at height %d, got %d", height, resp.Height)
+	}
+	if resp.Missing {
+		return nil, nil
+	}
+	params := types.ConsensusParamsFromProto(resp.ConsensusParams)
+	return &params, nil
+}
+
+// blockProvider is a light client provider fetching light blocks from a peer.
+type blockProvider struct {
+	peer       p2p.Peer
+	chainID    string
+	dispatcher *dispatcher
+}
+
+var _ lightprovider.Provider = (*blockProvider)(nil)
+
+func newBlockProvider(peer p2p.Peer, chainID string, dispatcher *dispatcher) *blockProvider {
+	return &blockProvider{peer: peer, chainID: chainID, dispatcher: dispatcher}
+}
+
+// ChainID implements provider.Provider.
+func (p *blockProvider) ChainID() string {
+	return p.chainID
+}
+
+// LightBlock implements provider.Provider.
+func (p *blockProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("expected height >= 0, got height %d", height)
+	}
+	lb, err := p.dispatcher.LightBlock(ctx, p.peer, uint64(height))
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return nil, ctx.Err()
+	case err != nil && (errors.Is(err, lightprovider.ErrNoResponse) ||
+		errors.Is(err, errPeerBusy) || errors.Is(err, errPeerDisconnected)):
+		return nil, lightprovider.ErrNoResponse
+	case err != nil:
+		return nil, lightprovider.ErrBadLightBlock{Reason: err}
+	case lb == nil:
+		return nil, lightprovider.ErrLightBlockNotFound
+	}
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	return lb, nil
+}
+
+// ReportEvidence implements provider.Provider. Evidence is not gossiped over
+// the state sync channels, so it is rejected for the light client to log it.
+func (*blockProvider) ReportEvidence(context.Context, types.Evidence) error {
+	return errEvidenceNotSupported
+}
+
+// String implements fmt.Stringer.
+func (p *blockProvider) String() string {
+	return fmt.Sprintf("peer %v", p.peer.ID())
+}
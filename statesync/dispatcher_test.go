@@ -0,0 +1,209 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
+	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/p2p"
+	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// servingPeer returns a peer serving the given light blocks and consensus
+// parameters through the dispatcher.
+func servingPeer(
+	t *testing.T,
+	id string,
+	d *dispatcher,
+	lightBlocks []*types.LightBlock,
+	params types.ConsensusParams,
+) *p2pmocks.Peer {
+	t.Helper()
+	peer := simplePeer(id)
+	peer.On("HasChannel", LightBlockChannel).Return(true)
+	peer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		e := args[0].(p2p.Envelope)
+		switch msg := e.Message.(type) {
+		case *ssproto.LightBlockRequest:
+			resp := &ssproto.LightBlockResponse{}
+			height := int64(msg.Height)
+			if height == 0 {
+				height = int64(len(lightBlocks))
+			}
+			if height <= int64(len(lightBlocks)) {
+				lb, err := lightBlocks[height-1].ToProto()
+				require.NoError(t, err)
+				resp.LightBlock = lb
+			}
+			d.Respond(peer.ID(), e.ChannelID, resp)
+		case *ssproto.ParamsRequest:
+			resp := &ssproto.ParamsResponse{Height: msg.Height, Missing: true}
+			if msg.Height <= uint64(len(lightBlocks)) {
+				resp.ConsensusParams, resp.Missing = params.ToProto(), false
+			}
+			d.Respond(peer.ID(), e.ChannelID, resp)
+		}
+	}).Return(nil)
+	return peer
+}
+
+func TestDispatcher_LightBlock(t *testing.T) {
+	params := test.ConsensusParams()
+	lightBlocks := makeLightBlocks(t, 3, params, cmttime.Now().Add(-time.Hour))
+	d := newDispatcher(100 * time.Millisecond)
+	ctx := context.Background()
+
+	peer := servingPeer(t, "a", d, lightBlocks, *params)
+	d.AddPeer(peer)
+	lb, err := d.LightBlock(ctx, peer, 2)
+	require.NoError(t, err)
+	assert.Equal(t, lightBlocks[1].Hash(), lb.Hash())
+
+	// The latest light block is returned for height 0, and nil for heights
+	// the peer does not have.
+	lb, err = d.LightBlock(ctx, peer, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, lb.Height)
+	lb, err = d.LightBlock(ctx, peer, 4)
+	require.NoError(t, err)
+	assert.Nil(t, lb)
+
+	got, err := d.ConsensusParams(ctx, peer, 2)
+	require.NoError(t, err)
+	assert.Equal(t, params.Hash(), got.Hash())
+	got, err = d.ConsensusParams(ctx, peer, 4)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// Peers not serving light blocks are ignored.
+	other := simplePeer("b")
+	other.On("HasChannel", LightBlockChannel).Return(false)
+	d.AddPeer(other)
+	assert.Equal(t, []p2p.Peer{peer}, d.Peers())
+
+	// Unsolicited responses are dropped.
+	assert.False(t, d.Respond("b", LightBlockChannel, &ssproto.LightBlockResponse{}))
+
+	// Requests time out when the peer does not respond, and fail when it
+	// disconnects.
+	silent := simplePeer("c")
+	silent.On("Send", mock.Anything).Return(nil)
+	_, err = d.LightBlock(ctx, silent, 1)
+	require.ErrorIs(t, err, lightprovider.ErrNoResponse)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := d.LightBlock(ctx, silent, 1)
+		errCh <- err
+	}()
+	require.Eventually(t, func() bool {
+		d.mtx.Lock()
+		defer d.mtx.Unlock()
+		return len(d.calls) == 1
+	}, time.Second, 5*time.Millisecond)
+	d.RemovePeer("c")
+	require.ErrorIs(t, <-errCh, errPeerDisconnected)
+}
+
+func TestBlockProvider_LightBlock(t *testing.T) {
+	params := test.ConsensusParams()
+	lightBlocks := makeLightBlocks(t, 3, params, cmttime.Now().Add(-time.Hour))
+	d := newDispatcher(100 * time.Millisecond)
+	ctx := context.Background()
+
+	provider := newBlockProvider(servingPeer(t, "a", d, lightBlocks, *params), test.DefaultTestChainID, d)
+	lb, err := provider.LightBlock(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, lightBlocks[0].Hash(), lb.Hash())
+	_, err = provider.LightBlock(ctx, 4)
+	require.Equal(t, lightprovider.ErrLightBlockNotFound, err)
+
+	// Evidence cannot be reported to peers.
+	require.ErrorIs(t, provider.ReportEvidence(ctx, &types.DuplicateVoteEvidence{}), errEvidenceNotSupported)
+
+	// Light blocks of another chain are rejected.
+	provider = newBlockProvider(servingPeer(t, "b", d, lightBlocks, *params), "other-chain", d)
+	_, err = provider.LightBlock(ctx, 1)
+	require.IsType(t, lightprovider.ErrBadLightBlock{}, err)
+
+	silent := simplePeer("c")
+	silent.On("Send", mock.Anything).Return(nil)
+	provider = newBlockProvider(silent, test.DefaultTestChainID, d)
+	_, err = provider.LightBlock(ctx, 1)
+	require.Equal(t, lightprovider.ErrNoResponse, err)
+}
+
+func TestP2PStateProvider(t *testing.T) {
+	params := test.ConsensusParams()
+	lightBlocks := makeLightBlocks(t, 10, params, cmttime.Now().Add(-time.Hour))
+	r := NewReactor(*config.DefaultStateSyncConfig(), nil, nil, NopMetrics())
+	trustOptions := light.TrustOptions{
+		Period: 24 * time.Hour,
+		Height: 2,
+		Hash:   lightBlocks[1].Hash(),
+	}
+	stateProvider, err := NewP2PStateProvider(r, test.DefaultTestChainID, cmtstate.Version{}, 1,
+		trustOptions, log.NewNopLogger(), "")
+	require.NoError(t, err)
+
+	// The light client needs a witness besides the primary.
+	peerA := servingPeer(t, "a", r.dispatcher, lightBlocks, *params)
+	r.dispatcher.AddPeer(peerA)
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	_, err = stateProvider.AppHash(ctx, 5)
+	require.ErrorIs(t, err, light.ErrNoWitnesses)
+
+	// The consensus parameters of a peer not matching the verified headers are
+	// ignored.
+	tampered := *params
+	tampered.Block.MaxBytes++
+	peer0 := servingPeer(t, "0", r.dispatcher, lightBlocks, tampered)
+	r.dispatcher.AddPeer(peer0)
+
+	ctx = context.Background()
+	appHash, err := stateProvider.AppHash(ctx, 5)
+	require.NoError(t, err)
+	assert.EqualValues(t, lightBlocks[5].AppHash, appHash)
+
+	commit, err := stateProvider.Commit(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, lightBlocks[4].Commit.Hash(), commit.Hash())
+
+	state, err := stateProvider.State(ctx, 5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, state.LastBlockHeight)
+	assert.EqualValues(t, lightBlocks[5].AppHash, state.AppHash)
+	assert.Equal(t, params.Hash(), state.ConsensusParams.Hash())
+	assert.EqualValues(t, 6, state.LastHeightConsensusParamsChanged)
+
+	// Once the peers of the light client disconnect, it is set up again with
+	// the peers connected then.
+	for _, peer := range []*p2pmocks.Peer{peerA, peer0} {
+		id := peer.ID()
+		r.dispatcher.RemovePeer(id)
+		peer.ExpectedCalls = nil
+		peer.On("ID").Return(id)
+		peer.On("Send", mock.Anything).Return(errors.New("peer stopped"))
+	}
+	r.dispatcher.AddPeer(servingPeer(t, "b", r.dispatcher, lightBlocks, *params))
+	r.dispatcher.AddPeer(servingPeer(t, "c", r.dispatcher, lightBlocks, *params))
+
+	appHash, err = stateProvider.AppHash(ctx, 7)
+	require.NoError(t, err)
+	assert.EqualValues(t, lightBlocks[7].AppHash, appHash)
+}
@@ -14,6 +14,10 @@ const (
 	snapshotMsgSize = int(4e6)
 	// chunkMsgSize is the maximum size of a chunkResponseMessage.
 	chunkMsgSize = int(16e6)
+	// lightBlockMsgSize is the maximum size of a lightBlockResponseMessage.
+	lightBlockMsgSize = int(1e7)
+	// paramsMsgSize is the maximum size of a paramsResponseMessage.
+	paramsMsgSize = int(1e5)
 )
 
 // validateMsg validates a message.
@@ -47,6 +51,16 @@ func validateMsg(pb proto.Message) error {
 		if msg.Chunks == 0 {
 			return errors.New("snapshot has no chunks")
 		}
+	case *ssproto.LightBlockRequest:
+	case *ssproto.LightBlockResponse:
+	case *ssproto.ParamsRequest:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
+	case *ssproto.ParamsResponse:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
 	default:
 		return fmt.Errorf("unknown message type %T", msg)
 	}
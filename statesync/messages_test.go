@@ -84,6 +84,16 @@ func TestValidateMsg(t *testing.T) {
 			&ssproto.SnapshotsResponse{Height: 1, Format: 1, Chunks: 2, Hash: []byte{}},
 			false,
 		},
+
+		"LightBlockRequest valid":    {&ssproto.LightBlockRequest{Height: 1}, true},
+		"LightBlockRequest latest":   {&ssproto.LightBlockRequest{Height: 0}, true},
+		"LightBlockResponse valid":   {&ssproto.LightBlockResponse{LightBlock: &cmtproto.LightBlock{}}, true},
+		"LightBlockResponse missing": {&ssproto.LightBlockResponse{}, true},
+
+		"ParamsRequest valid":     {&ssproto.ParamsRequest{Height: 1}, true},
+		"ParamsRequest 0 height":  {&ssproto.ParamsRequest{Height: 0}, false},
+		"ParamsResponse valid":    {&ssproto.ParamsResponse{Height: 1}, true},
+		"ParamsResponse 0 height": {&ssproto.ParamsResponse{Height: 0}, false},
 	}
 	for name, tc := range testcases {
 		t.Run(name, func(t *testing.T) {
@@ -108,6 +118,10 @@ func TestStateSyncVectors(t *testing.T) {
 		{"SnapshotsResponse", &ssproto.SnapshotsResponse{Height: 1, Format: 2, Chunks: 3, Hash: []byte("chuck hash"), Metadata: []byte("snapshot metadata")}, "1225080110021803220a636875636b20686173682a11736e617073686f74206d65746164617461"},
 		{"ChunkRequest", &ssproto.ChunkRequest{Height: 1, Format: 2, Index: 3}, "1a06080110021803"},
 		{"ChunkResponse", &ssproto.ChunkResponse{Height: 1, Format: 2, Index: 3, Chunk: []byte("it's a chunk")}, "2214080110021803220c697427732061206368756e6b"},
+		{"LightBlockRequest", &ssproto.LightBlockRequest{Height: 1}, "2a020801"},
+		{"LightBlockResponse", &ssproto.LightBlockResponse{}, "3200"},
+		{"ParamsRequest", &ssproto.ParamsRequest{Height: 1}, "3a020801"},
+		{"ParamsResponse", &ssproto.ParamsResponse{Height: 1}, "420408011200"},
 	}
 
 	for _, tc := range testCases {
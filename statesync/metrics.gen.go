@@ -20,11 +20,39 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "syncing",
 			Help:      "Whether or not a node is state syncing. 1 if yes, 0 if no.",
 		}, labels).With(labelsAndValues...),
+		ChunkFetchers: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_fetchers",
+			Help:      "Number of chunk fetchers currently allowed to request chunks, adapted to chunk request timeouts.",
+		}, labels).With(labelsAndValues...),
+		PeerChunkThroughput: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_chunk_throughput",
+			Help:      "Throughput of the chunks received from a peer, in bytes per second.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		ChunkRequestTimeouts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_request_timeouts",
+			Help:      "Number of chunk requests which timed out.",
+		}, labels).With(labelsAndValues...),
+		BannedPeers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "banned_peers",
+			Help:      "Number of peers banned because the app rejected their chunks.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Syncing: discard.NewGauge(),
+		Syncing:              discard.NewGauge(),
+		ChunkFetchers:        discard.NewGauge(),
+		PeerChunkThroughput:  discard.NewGauge(),
+		ChunkRequestTimeouts: discard.NewCounter(),
+		BannedPeers:          discard.NewCounter(),
 	}
 }
@@ -16,4 +16,17 @@ const (
 type Metrics struct {
 	// Whether or not a node is state syncing. 1 if yes, 0 if no.
 	Syncing metrics.Gauge
+
+	// Number of chunk fetchers currently allowed to request chunks, adapted
+	// to chunk request timeouts.
+	ChunkFetchers metrics.Gauge
+
+	// Throughput of the chunks received from a peer, in bytes per second.
+	PeerChunkThroughput metrics.Gauge `metrics_labels:"peer_id"`
+
+	// Number of chunk requests which timed out.
+	ChunkRequestTimeouts metrics.Counter
+
+	// Number of peers banned because the app rejected their chunks.
+	BannedPeers metrics.Counter
 }
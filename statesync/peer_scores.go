@@ -0,0 +1,271 @@
+package statesync
+
+import (
+	"math"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// throughputWeight is the weight of the latest sample in the moving
+	// average of the throughput of a peer.
+	throughputWeight = 0.3
+	// maxPeerTimeouts is the number of consecutive chunk request timeouts
+	// after which a peer is only used if no other peer is available.
+	maxPeerTimeouts = 3
+)
+
+// peerStats tracks how well a peer serves chunks.
+type peerStats struct {
+	throughput float64 // moving average, in bytes per second
+	inFlight   int     // outstanding chunk requests
+	timeouts   int     // consecutive chunk request timeouts
+}
+
+// chunkRequest is an outstanding chunk request.
+type chunkRequest struct {
+	peer   p2p.ID
+	sentAt time.Time
+}
+
+// peerScorer tracks the throughput and failures of the peers serving chunks,
+// to request chunks preferably from the fastest peers, and keeps track of the
+// peers banned because the app rejected their chunks.
+type peerScorer struct {
+	cmtsync.Mutex
+	peers    map[p2p.ID]*peerStats
+	banned   map[p2p.ID]bool
+	requests map[uint32]chunkRequest // by chunk index
+}
+
+func newPeerScorer() *peerScorer {
+	return &peerScorer{
+		peers:    make(map[p2p.ID]*peerStats),
+		banned:   make(map[p2p.ID]bool),
+		requests: make(map[uint32]chunkRequest),
+	}
+}
+
+// stats returns the stats of a peer. The caller must hold the mutex lock.
+func (ps *peerScorer) stats(id p2p.ID) *peerStats {
+	stats, ok := ps.peers[id]
+	if !ok {
+		stats = &peerStats{}
+		ps.peers[id] = stats
+	}
+	return stats
+}
+
+// Pick returns the peer to request the next chunk from, or nil if peers is
+// empty or only contains banned peers. Peers whose throughput is unknown yet
+// are tried first, then peers are chosen by throughput, divided between their
+// outstanding requests. Peers which keep timing out are only picked when no
+// other peer is left.
+func (ps *peerScorer) Pick(peers []p2p.Peer) p2p.Peer {
+	ps.Lock()
+	defer ps.Unlock()
+
+	// Peers whose throughput is unknown are assumed to be as fast as the
+	// fastest peer once they have requests in flight.
+	fastest := 1.0
+	for _, peer := range peers {
+		if stats, ok := ps.peers[peer.ID()]; ok && stats.throughput > fastest {
+			fastest = stats.throughput
+		}
+	}
+
+	var (
+		best      p2p.Peer
+		bestScore float64
+		bestSlow  bool
+	)
+	for _, peer := range peers {
+		if ps.banned[peer.ID()] {
+			continue
+		}
+		stats := ps.stats(peer.ID())
+		slow := stats.timeouts >= maxPeerTimeouts
+		var score float64
+		switch {
+		case stats.throughput > 0:
+			score = stats.throughput / float64(1+stats.inFlight)
+		case stats.inFlight == 0:
+			score = math.Inf(1)
+		default:
+			score = fastest / float64(1+stats.inFlight)
+		}
+		if slow {
+			score = -float64(stats.timeouts)
+		}
+		if best == nil || (bestSlow && !slow) || (bestSlow == slow && score > bestScore) {
+			best, bestScore, bestSlow = peer, score, slow
+		}
+	}
+	return best
+}
+
+// Requested records a chunk request sent to a peer.
+func (ps *peerScorer) Requested(index uint32, peer p2p.ID) {
+	ps.Lock()
+	defer ps.Unlock()
+
+	ps.clearRequest(index)
+	ps.requests[index] = chunkRequest{peer: peer, sentAt: time.Now()}
+	ps.stats(peer).inFlight++
+}
+
+// Received records a chunk received from a peer. It returns the updated
+// throughput of the peer, and whether the chunk answered a request to the
+// peer.
+func (ps *peerScorer) Received(index uint32, sender p2p.ID, size int) (float64, bool) {
+	ps.Lock()
+	defer ps.Unlock()
+
+	request, ok := ps.requests[index]
+	if !ok || request.peer != sender {
+		return 0, false
+	}
+	ps.clearRequest(index)
+
+	stats := ps.stats(sender)
+	elapsed := time.Since(request.sentAt).Seconds()
+	if elapsed <= 0 {
+		elapsed = math.SmallestNonzeroFloat64
+	}
+	sample := float64(size) / elapsed
+	if stats.throughput == 0 {
+		stats.throughput = sample
+	} else {
+		stats.throughput = throughputWeight*sample + (1-throughputWeight)*stats.throughput
+	}
+	stats.timeouts = 0
+	return stats.throughput, true
+}
+
+// TimedOut records that a chunk request timed out, and returns the peer it
+// was sent to, if any.
+func (ps *peerScorer) TimedOut(index uint32) p2p.ID {
+	ps.Lock()
+	defer ps.Unlock()
+
+	request, ok := ps.requests[index]
+	if !ok {
+		return ""
+	}
+	ps.clearRequest(index)
+	ps.stats(request.peer).timeouts++
+	return request.peer
+}
+
+// clearRequest removes an outstanding request. The caller must hold the mutex
+// lock.
+func (ps *peerScorer) clearRequest(index uint32) {
+	request, ok := ps.requests[index]
+	if !ok {
+		return
+	}
+	delete(ps.requests, index)
+	if stats := ps.peers[request.peer]; stats != nil && stats.inFlight > 0 {
+		stats.inFlight--
+	}
+}
+
+// Ban bans a peer. It returns false if the peer was already banned.
+func (ps *peerScorer) Ban(id p2p.ID) bool {
+	ps.Lock()
+	defer ps.Unlock()
+
+	if ps.banned[id] {
+		return false
+	}
+	ps.banned[id] = true
+	delete(ps.peers, id)
+	return true
+}
+
+// IsBanned returns whether a peer is banned.
+func (ps *peerScorer) IsBanned(id p2p.ID) bool {
+	ps.Lock()
+	defer ps.Unlock()
+	return ps.banned[id]
+}
+
+// RemovePeer forgets the stats of a disconnected peer. Bans are kept.
+func (ps *peerScorer) RemovePeer(id p2p.ID) {
+	ps.Lock()
+	defer ps.Unlock()
+
+	for index, request := range ps.requests {
+		if request.peer == id {
+			delete(ps.requests, index)
+		}
+	}
+	delete(ps.peers, id)
+}
+
+// fetchLimit adapts the number of chunks requested concurrently: it is
+// halved when a request times out, and increased by one when a requested
+// chunk is received, up to the maximum.
+type fetchLimit struct {
+	cmtsync.Mutex
+	max    int
+	limit  int
+	active int
+}
+
+func newFetchLimit(maxFetchers int32) *fetchLimit {
+	if maxFetchers < 1 {
+		maxFetchers = 1
+	}
+	return &fetchLimit{max: int(maxFetchers), limit: int(maxFetchers)}
+}
+
+// TryAcquire reserves a request slot, returning false if all the slots are
+// in use.
+func (l *fetchLimit) TryAcquire() bool {
+	l.Lock()
+	defer l.Unlock()
+	if l.active >= l.limit {
+		return false
+	}
+	l.active++
+	return true
+}
+
+// Release frees a request slot.
+func (l *fetchLimit) Release() {
+	l.Lock()
+	defer l.Unlock()
+	if l.active > 0 {
+		l.active--
+	}
+}
+
+// Limit returns the number of concurrent requests currently allowed.
+func (l *fetchLimit) Limit() int {
+	l.Lock()
+	defer l.Unlock()
+	return l.limit
+}
+
+// Increase allows one more concurrent request, up to the maximum, and
+// returns the new limit.
+func (l *fetchLimit) Increase() int {
+	l.Lock()
+	defer l.Unlock()
+	if l.limit < l.max {
+		l.limit++
+	}
+	return l.limit
+}
+
+// Decrease halves the number of concurrent requests, down to one, and
+// returns the new limit.
+func (l *fetchLimit) Decrease() int {
+	l.Lock()
+	defer l.Unlock()
+	l.limit = max(1, l.limit/2)
+	return l.limit
+}
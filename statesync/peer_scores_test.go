@@ -0,0 +1,88 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p"
+)
+
+func TestPeerScorer_Pick(t *testing.T) {
+	scorer := newPeerScorer()
+	peerA, peerB, peerC := simplePeer("a"), simplePeer("b"), simplePeer("c")
+	peers := []p2p.Peer{peerA, peerB, peerC}
+
+	// Requests are spread between peers whose throughput is unknown.
+	for i, expected := range []p2p.ID{"a", "b", "c"} {
+		peer := scorer.Pick(peers)
+		require.NotNil(t, peer)
+		assert.Equal(t, expected, peer.ID())
+		scorer.Requested(uint32(i), peer.ID())
+	}
+
+	// b is ten times faster than a, c is timing out.
+	scorer.requests[0] = chunkRequest{peer: "a", sentAt: time.Now().Add(-10 * time.Second)}
+	scorer.requests[1] = chunkRequest{peer: "b", sentAt: time.Now().Add(-time.Second)}
+	_, ok := scorer.Received(0, "a", 1000)
+	require.True(t, ok)
+	throughput, ok := scorer.Received(1, "b", 1000)
+	require.True(t, ok)
+	assert.InDelta(t, 1000, throughput, 100)
+	for i := 0; i < maxPeerTimeouts; i++ {
+		assert.Equal(t, p2p.ID("c"), scorer.TimedOut(2))
+		scorer.Requested(2, "c")
+	}
+	assert.Equal(t, p2p.ID("c"), scorer.TimedOut(2))
+
+	assert.Equal(t, p2p.ID("b"), scorer.Pick(peers).ID())
+	// The outstanding requests of b are taken into account.
+	for i := uint32(3); i < 13; i++ {
+		scorer.Requested(i, "b")
+	}
+	assert.Equal(t, p2p.ID("a"), scorer.Pick(peers).ID())
+
+	// A chunk from another peer than the one it was requested from is not
+	// counted.
+	_, ok = scorer.Received(3, "a", 1000)
+	assert.False(t, ok)
+
+	// Peers timing out are only used when no other peer is left.
+	assert.True(t, scorer.Ban("a"))
+	assert.False(t, scorer.Ban("a"))
+	scorer.RemovePeer("b")
+	assert.True(t, scorer.IsBanned("a"))
+	assert.Equal(t, p2p.ID("c"), scorer.Pick([]p2p.Peer{peerA, peerC}).ID())
+	assert.Nil(t, scorer.Pick([]p2p.Peer{peerA}))
+}
+
+func TestFetchLimit(t *testing.T) {
+	limit := newFetchLimit(4)
+	for i := 0; i < 4; i++ {
+		require.True(t, limit.TryAcquire())
+	}
+	require.False(t, limit.TryAcquire())
+
+	// Timeouts halve the number of concurrent requests.
+	assert.Equal(t, 2, limit.Decrease())
+	assert.Equal(t, 1, limit.Decrease())
+	assert.Equal(t, 1, limit.Decrease())
+	for i := 0; i < 4; i++ {
+		limit.Release()
+	}
+	require.True(t, limit.TryAcquire())
+	require.False(t, limit.TryAcquire())
+
+	// Received chunks increase it again, up to the maximum.
+	assert.Equal(t, 2, limit.Increase())
+	require.True(t, limit.TryAcquire())
+	for i := 0; i < 4; i++ {
+		limit.Increase()
+	}
+	assert.Equal(t, 4, limit.Limit())
+
+	// At least one fetcher is always allowed.
+	assert.Equal(t, 1, newFetchLimit(0).Limit())
+}
@@ -0,0 +1,222 @@
+package statesync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/internal/tempfile"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// progressFile is the name of the file recording the progress of a state sync.
+	progressFile = "progress.json"
+	// progressChunksDir is the name of the directory holding the fetched chunks.
+	progressChunksDir = "chunks"
+)
+
+// syncProgress is the progress of a state sync: the snapshot being restored,
+// the app hash of the snapshot verified by the light client, the state and
+// commit built from the light blocks verified by the light client, once they
+// are, and the chunks applied by the app so far.
+type syncProgress struct {
+	Height         uint64   `json:"height"`
+	Format         uint32   `json:"format"`
+	Chunks         uint32   `json:"chunks"`
+	Hash           []byte   `json:"hash"`
+	Metadata       []byte   `json:"metadata"`
+	TrustedAppHash []byte   `json:"trusted_app_hash"`
+	TrustedState   []byte   `json:"trusted_state,omitempty"`  // protobuf-encoded
+	TrustedCommit  []byte   `json:"trusted_commit,omitempty"` // protobuf-encoded
+	AppliedChunks  []uint32 `json:"applied_chunks"`
+}
+
+// snapshot returns the snapshot being restored, along with the data verified
+// by the light client before the restart.
+func (p *syncProgress) snapshot() (*snapshot, error) {
+	s := &snapshot{
+		Height:         p.Height,
+		Format:         p.Format,
+		Chunks:         p.Chunks,
+		Hash:           p.Hash,
+		Metadata:       p.Metadata,
+		trustedAppHash: p.TrustedAppHash,
+	}
+	if len(p.TrustedState) == 0 || len(p.TrustedCommit) == 0 {
+		return s, nil
+	}
+
+	pbState := new(cmtstate.State)
+	if err := proto.Unmarshal(p.TrustedState, pbState); err != nil {
+		return nil, fmt.Errorf("invalid trusted state: %w", err)
+	}
+	state, err := sm.FromProto(pbState)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted state: %w", err)
+	}
+	pbCommit := new(cmtproto.Commit)
+	if err := proto.Unmarshal(p.TrustedCommit, pbCommit); err != nil {
+		return nil, fmt.Errorf("invalid trusted commit: %w", err)
+	}
+	commit, err := types.CommitFromProto(pbCommit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted commit: %w", err)
+	}
+	s.trustedState, s.trustedCommit = state, commit
+	return s, nil
+}
+
+// progressStore persists the progress of a state sync in a directory, along
+// with the fetched chunks, so that a restarted node can resume restoring the
+// same snapshot without fetching the chunks again.
+type progressStore struct {
+	dir string
+
+	mtx      cmtsync.Mutex
+	progress *syncProgress
+}
+
+func newProgressStore(dir string) (*progressStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create state sync progress dir: %w", err)
+	}
+	return &progressStore{dir: dir}, nil
+}
+
+// Load loads the persisted progress, or returns nil if there is none.
+func (ps *progressStore) Load() (*syncProgress, error) {
+	bz, err := os.ReadFile(filepath.Join(ps.dir, progressFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	progress := new(syncProgress)
+	if err := cmtjson.Unmarshal(bz, progress); err != nil {
+		return nil, fmt.Errorf("invalid state sync progress: %w", err)
+	}
+	if len(progress.TrustedAppHash) == 0 {
+		return nil, errors.New("invalid state sync progress: missing trusted app hash")
+	}
+	if _, err := progress.snapshot(); err != nil {
+		return nil, fmt.Errorf("invalid state sync progress: %w", err)
+	}
+	return progress, nil
+}
+
+// ChunkQueue returns a chunk queue for the snapshot, storing chunks in the
+// progress directory. Chunks fetched for the snapshot before a restart are
+// loaded into the queue.
+func (ps *progressStore) ChunkQueue(snapshot *snapshot) (*chunkQueue, error) {
+	return newPersistentChunkQueue(snapshot, filepath.Join(ps.dir, progressChunksDir))
+}
+
+// Start records that the restoration of the snapshot has started, with the
+// given chunks already applied.
+func (ps *progressStore) Start(snapshot *snapshot, appliedChunks []uint32) error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.progress = &syncProgress{
+		Height:         snapshot.Height,
+		Format:         snapshot.Format,
+		Chunks:         snapshot.Chunks,
+		Hash:           snapshot.Hash,
+		Metadata:       snapshot.Metadata,
+		TrustedAppHash: snapshot.trustedAppHash,
+		AppliedChunks:  append([]uint32{}, appliedChunks...),
+	}
+	return ps.save()
+}
+
+// Verified records the state and commit of the snapshot verified by the light
+// client, so that they are not fetched and verified again upon resuming the
+// restoration, when the trust period may have expired.
+func (ps *progressStore) Verified(state sm.State, commit *types.Commit) error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.progress == nil {
+		return errors.New("no state sync in progress")
+	}
+	pbState, err := state.ToProto()
+	if err != nil {
+		return err
+	}
+	stateBz, err := proto.Marshal(pbState)
+	if err != nil {
+		return err
+	}
+	commitBz, err := proto.Marshal(commit.ToProto())
+	if err != nil {
+		return err
+	}
+	ps.progress.TrustedState, ps.progress.TrustedCommit = stateBz, commitBz
+	return ps.save()
+}
+
+// ChunkApplied records that a chunk was applied by the app.
+func (ps *progressStore) ChunkApplied(index uint32) error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.progress == nil {
+		return errors.New("no state sync in progress")
+	}
+	i := sort.Search(len(ps.progress.AppliedChunks), func(i int) bool { return ps.progress.AppliedChunks[i] >= index })
+	if i < len(ps.progress.AppliedChunks) && ps.progress.AppliedChunks[i] == index {
+		return nil
+	}
+	ps.progress.AppliedChunks = append(ps.progress.AppliedChunks, 0)
+	copy(ps.progress.AppliedChunks[i+1:], ps.progress.AppliedChunks[i:])
+	ps.progress.AppliedChunks[i] = index
+	return ps.save()
+}
+
+// ChunkDiscarded records that a chunk was discarded, and must be applied
+// again once fetched again.
+func (ps *progressStore) ChunkDiscarded(index uint32) error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.progress == nil {
+		return errors.New("no state sync in progress")
+	}
+	i := sort.Search(len(ps.progress.AppliedChunks), func(i int) bool { return ps.progress.AppliedChunks[i] >= index })
+	if i == len(ps.progress.AppliedChunks) || ps.progress.AppliedChunks[i] != index {
+		return nil
+	}
+	ps.progress.AppliedChunks = append(ps.progress.AppliedChunks[:i], ps.progress.AppliedChunks[i+1:]...)
+	return ps.save()
+}
+
+// Clear removes the persisted progress and chunks.
+func (ps *progressStore) Clear() error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.progress = nil
+	if err := os.Remove(filepath.Join(ps.dir, progressFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(ps.dir, progressChunksDir))
+}
+
+// save writes the progress to disk. The caller must hold the mutex lock.
+func (ps *progressStore) save() error {
+	bz, err := cmtjson.Marshal(ps.progress)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filepath.Join(ps.dir, progressFile), bz, 0o600)
+}
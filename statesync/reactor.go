@@ -10,6 +10,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	"github.com/cometbft/cometbft/config"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
@@ -24,8 +25,15 @@ const (
 	SnapshotChannel = byte(0x60)
 	// ChunkChannel exchanges chunk contents.
 	ChunkChannel = byte(0x61)
+	// LightBlockChannel exchanges light blocks, for the P2P state provider.
+	LightBlockChannel = byte(0x62)
+	// ParamsChannel exchanges consensus parameters, for the P2P state provider.
+	ParamsChannel = byte(0x63)
 	// recentSnapshots is the number of recent snapshots to send and receive per peer.
 	recentSnapshots = 10
+	// lightBlockResponseTimeout is how long to wait for a peer to respond to
+	// a light block or consensus params request.
+	lightBlockResponseTimeout = 10 * time.Second
 )
 
 // Reactor handles state sync, both restoring snapshots for the local node and serving snapshots
@@ -33,11 +41,18 @@ const (
 type Reactor struct {
 	p2p.BaseReactor
 
-	cfg       config.StateSyncConfig
-	conn      proxy.AppConnSnapshot
-	connQuery proxy.AppConnQuery
-	tempDir   string
-	metrics   *Metrics
+	cfg         config.StateSyncConfig
+	conn        proxy.AppConnSnapshot
+	connQuery   proxy.AppConnQuery
+	tempDir     string
+	progressDir string
+	metrics     *Metrics
+
+	// Used to serve light blocks and consensus parameters to peers, when set.
+	stateStore sm.Store
+	blockStore sm.BlockStore
+	// Routes light block and consensus params responses to the P2P state provider.
+	dispatcher *dispatcher
 
 	// This will only be set when a state sync is in progress. It is used to feed received
 	// snapshots and chunks into the sync.
@@ -51,18 +66,43 @@ func NewReactor(
 	conn proxy.AppConnSnapshot,
 	connQuery proxy.AppConnQuery,
 	metrics *Metrics,
+	options ...ReactorOption,
 ) *Reactor {
 	r := &Reactor{
-		cfg:       cfg,
-		conn:      conn,
-		connQuery: connQuery,
-		metrics:   metrics,
+		cfg:        cfg,
+		conn:       conn,
+		connQuery:  connQuery,
+		metrics:    metrics,
+		dispatcher: newDispatcher(lightBlockResponseTimeout),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("StateSync", r)
 
+	for _, option := range options {
+		option(r)
+	}
+
 	return r
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// ReactorProgressDir sets the directory where the progress of a state sync
+// and the fetched chunks are persisted, so that a restarted node resumes
+// restoring the same snapshot instead of starting over.
+func ReactorProgressDir(dir string) ReactorOption {
+	return func(r *Reactor) { r.progressDir = dir }
+}
+
+// ReactorStores sets the stores used to serve light blocks and consensus
+// parameters to the peers using the P2P state provider.
+func ReactorStores(stateStore sm.Store, blockStore sm.BlockStore) ReactorOption {
+	return func(r *Reactor) {
+		r.stateStore = stateStore
+		r.blockStore = blockStore
+	}
+}
+
 // StreamDescriptors implements p2p.Reactor.
 func (*Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 	return []p2p.StreamDescriptor{
@@ -80,6 +120,20 @@ func (*Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 			RecvMessageCapacity: chunkMsgSize,
 			MessageTypeI:        &ssproto.Message{},
 		},
+		tcpconn.StreamDescriptor{
+			ID:                  LightBlockChannel,
+			Priority:            5,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: lightBlockMsgSize,
+			MessageTypeI:        &ssproto.Message{},
+		},
+		tcpconn.StreamDescriptor{
+			ID:                  ParamsChannel,
+			Priority:            2,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: paramsMsgSize,
+			MessageTypeI:        &ssproto.Message{},
+		},
 	}
 }
 
@@ -90,6 +144,7 @@ func (*Reactor) OnStart() error {
 
 // AddPeer implements p2p.Reactor.
 func (r *Reactor) AddPeer(peer p2p.Peer) {
+	r.dispatcher.AddPeer(peer)
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if r.syncer != nil {
@@ -99,6 +154,7 @@ func (r *Reactor) AddPeer(peer p2p.Peer) {
 
 // RemovePeer implements p2p.Reactor.
 func (r *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	r.dispatcher.RemovePeer(peer.ID())
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if r.syncer != nil {
@@ -223,11 +279,101 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 			r.Logger.Error("Received unknown message %T", msg)
 		}
 
+	case LightBlockChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.LightBlockRequest:
+			r.Logger.Debug("Received light block request", "height", msg.Height, "peer", e.Src.ID())
+			// A response without a light block tells the peer that it is not
+			// available, rather than letting its request time out.
+			lightBlock, err := r.fetchLightBlock(msg.Height)
+			if err != nil {
+				r.Logger.Error("Failed to fetch light block", "height", msg.Height, "err", err)
+				lightBlock = nil
+			}
+			_ = e.Src.Send(p2p.Envelope{
+				ChannelID: LightBlockChannel,
+				Message:   &ssproto.LightBlockResponse{LightBlock: lightBlock},
+			})
+
+		case *ssproto.LightBlockResponse:
+			if !r.dispatcher.Respond(e.Src.ID(), LightBlockChannel, msg) {
+				r.Logger.Debug("Received unexpected light block", "peer", e.Src.ID())
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
+	case ParamsChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.ParamsRequest:
+			r.Logger.Debug("Received consensus params request", "height", msg.Height, "peer", e.Src.ID())
+			resp := &ssproto.ParamsResponse{Height: msg.Height, Missing: true}
+			if r.stateStore != nil {
+				params, err := r.stateStore.LoadConsensusParams(int64(msg.Height))
+				if err != nil {
+					r.Logger.Error("Failed to load consensus params", "height", msg.Height, "err", err)
+				} else {
+					resp.ConsensusParams, resp.Missing = params.ToProto(), false
+				}
+			}
+			_ = e.Src.Send(p2p.Envelope{
+				ChannelID: ParamsChannel,
+				Message:   resp,
+			})
+
+		case *ssproto.ParamsResponse:
+			if !r.dispatcher.Respond(e.Src.ID(), ParamsChannel, msg) {
+				r.Logger.Debug("Received unexpected consensus params", "peer", e.Src.ID())
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
 	default:
 		r.Logger.Error("Received message on invalid channel %x", e.ChannelID)
 	}
 }
 
+// fetchLightBlock loads the light block at the given height from the stores,
+// or the latest light block if height is 0. It returns nil if the stores are
+// not set, or if the block or its commit is not available.
+func (r *Reactor) fetchLightBlock(height uint64) (*cmtproto.LightBlock, error) {
+	if r.stateStore == nil || r.blockStore == nil {
+		return nil, nil
+	}
+	h := int64(height)
+	if h == 0 {
+		h = r.blockStore.Height()
+	}
+	blockMeta := r.blockStore.LoadBlockMeta(h)
+	if blockMeta == nil {
+		return nil, nil
+	}
+	// The canonical commit is stored with the next block: the latest block
+	// only has a seen commit.
+	commit := r.blockStore.LoadBlockCommit(h)
+	if commit == nil {
+		commit = r.blockStore.LoadSeenCommit(h)
+	}
+	if commit == nil {
+		return nil, nil
+	}
+	vals, err := r.stateStore.LoadValidators(h)
+	if err != nil {
+		return nil, err
+	}
+	lightBlock := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &blockMeta.Header,
+			Commit: commit,
+		},
+		ValidatorSet: vals,
+	}
+	return lightBlock.ToProto()
+}
+
 // recentSnapshots fetches the n most recent snapshots from the app.
 func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	resp, err := r.conn.ListSnapshots(context.TODO(), &abci.ListSnapshotsRequest{})
@@ -270,8 +416,18 @@ func (r *Reactor) Sync(stateProvider StateProvider, maxDiscoveryTime time.Durati
 		r.mtx.Unlock()
 		return sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
+	syncer := newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
+	syncer.metrics = r.metrics
+	if r.progressDir != "" {
+		progress, err := newProgressStore(r.progressDir)
+		if err != nil {
+			r.mtx.Unlock()
+			return sm.State{}, nil, err
+		}
+		syncer.progress = progress
+	}
 	r.metrics.Syncing.Set(1)
-	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
+	r.syncer = syncer
 	r.mtx.Unlock()
 
 	hook := func() {
@@ -1,6 +1,7 @@
 package statesync
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -12,9 +13,13 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/p2p"
 	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
 	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	smmocks "github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
 )
 
 func TestReactor_Receive_ChunkRequest(t *testing.T) {
@@ -183,3 +188,73 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestReactor_Receive_LightBlockRequest(t *testing.T) {
+	params := test.ConsensusParams()
+	lightBlocks := makeLightBlocks(t, 3, params, cmttime.Now().Add(-time.Hour))
+
+	stateStore := &smmocks.Store{}
+	blockStore := &smmocks.BlockStore{}
+	blockStore.On("Height").Return(int64(3))
+	for _, lb := range lightBlocks {
+		blockStore.On("LoadBlockMeta", lb.Height).Return(&types.BlockMeta{Header: *lb.Header})
+		stateStore.On("LoadValidators", lb.Height).Return(lb.ValidatorSet, nil)
+	}
+	// The latest block only has a seen commit.
+	blockStore.On("LoadBlockCommit", int64(2)).Return(lightBlocks[1].Commit)
+	blockStore.On("LoadBlockCommit", int64(3)).Return(nil)
+	blockStore.On("LoadSeenCommit", int64(3)).Return(lightBlocks[2].Commit)
+	blockStore.On("LoadBlockMeta", int64(4)).Return(nil)
+	stateStore.On("LoadConsensusParams", int64(2)).Return(*params, nil)
+	// Failures to load the light block or params are reported to the peer.
+	blockStore.On("LoadBlockMeta", int64(5)).Return(&types.BlockMeta{Header: *lightBlocks[2].Header})
+	blockStore.On("LoadBlockCommit", int64(5)).Return(lightBlocks[2].Commit)
+	stateStore.On("LoadValidators", int64(5)).Return(nil, errors.New("boom"))
+	stateStore.On("LoadConsensusParams", int64(3)).Return(types.ConsensusParams{}, errors.New("boom"))
+
+	var responses []proto.Message
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(p2p.ID("id"))
+	peer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		responses = append(responses, args[0].(p2p.Envelope).Message)
+	}).Return(nil)
+
+	cfg := config.DefaultStateSyncConfig()
+	r := NewReactor(*cfg, nil, nil, NopMetrics(), ReactorStores(stateStore, blockStore))
+	require.NoError(t, r.Start())
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	for _, height := range []uint64{2, 0, 4, 5} {
+		r.Receive(p2p.Envelope{
+			ChannelID: LightBlockChannel,
+			Src:       peer,
+			Message:   &ssproto.LightBlockRequest{Height: height},
+		})
+	}
+	for _, height := range []uint64{2, 3} {
+		r.Receive(p2p.Envelope{
+			ChannelID: ParamsChannel,
+			Src:       peer,
+			Message:   &ssproto.ParamsRequest{Height: height},
+		})
+	}
+
+	require.Len(t, responses, 6)
+	for i, expected := range []*types.LightBlock{lightBlocks[1], lightBlocks[2], nil, nil} {
+		resp := responses[i].(*ssproto.LightBlockResponse)
+		if expected == nil {
+			assert.Nil(t, resp.LightBlock)
+			continue
+		}
+		lb, err := types.LightBlockFromProto(resp.LightBlock)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Hash(), lb.Hash())
+		assert.Equal(t, expected.Commit.Hash(), lb.Commit.Hash())
+	}
+	assert.Equal(t, &ssproto.ParamsResponse{Height: 2, ConsensusParams: params.ToProto()}, responses[4])
+	assert.Equal(t, &ssproto.ParamsResponse{Height: 3, Missing: true}, responses[5])
+}
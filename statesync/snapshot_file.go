@@ -0,0 +1,449 @@
+package statesync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
+	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// A snapshot file contains, in order:
+//
+//   - the snapshotFileMagic string and the snapshotFileVersion byte;
+//   - the number of light blocks in the file, as an unsigned varint;
+//   - the snapshot metadata, as a SnapshotsResponse message;
+//   - the consensus parameters at the height following the snapshot;
+//   - the light blocks, which must include the snapshot height, the next two
+//     heights and the trusted height, and allow verifying the former from the
+//     latter;
+//   - the snapshot chunks in order, as ChunkResponse messages.
+//
+// All messages are varint-delimited Protobuf messages.
+const (
+	snapshotFileMagic   = "CMTSNAP"
+	snapshotFileVersion = byte(1)
+
+	// snapshotFileMaxMsgSize is the maximum size of a message in a snapshot file.
+	snapshotFileMaxMsgSize = 4 * chunkMsgSize
+
+	// snapshotFileRestoreAttempts is the maximum number of times the
+	// restoration of a snapshot file is attempted, if the app asks to retry it.
+	snapshotFileRestoreAttempts = 5
+
+	// maxClockDrift is how much the time of the light blocks in a snapshot
+	// file can drift into the future.
+	maxClockDrift = 10 * time.Second
+)
+
+// WriteSnapshotFile writes the given snapshot to w, in the format read by
+// RestoreSnapshotFile. params are the consensus parameters at the height
+// following the snapshot, and lightBlocks the light blocks needed to verify
+// the snapshot from the trusted height the file will be restored with. The
+// chunks of the snapshot are loaded one at a time with loadChunk.
+func WriteSnapshotFile(
+	w io.Writer,
+	snapshot *abci.Snapshot,
+	params types.ConsensusParams,
+	lightBlocks []*types.LightBlock,
+	loadChunk func(index uint32) ([]byte, error),
+) error {
+	header := append([]byte(snapshotFileMagic), snapshotFileVersion)
+	header = binary.AppendUvarint(header, uint64(len(lightBlocks)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	pw := protoio.NewDelimitedWriter(w)
+	if _, err := pw.WriteMsg(&ssproto.SnapshotsResponse{
+		Height:   snapshot.Height,
+		Format:   snapshot.Format,
+		Chunks:   snapshot.Chunks,
+		Hash:     snapshot.Hash,
+		Metadata: snapshot.Metadata,
+	}); err != nil {
+		return err
+	}
+	pbParams := params.ToProto()
+	if _, err := pw.WriteMsg(&pbParams); err != nil {
+		return err
+	}
+	for _, lb := range lightBlocks {
+		pbLightBlock, err := lb.ToProto()
+		if err != nil {
+			return err
+		}
+		if _, err := pw.WriteMsg(pbLightBlock); err != nil {
+			return err
+		}
+	}
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		chunk, err := loadChunk(index)
+		if err != nil {
+			return fmt.Errorf("failed to load chunk %d: %w", index, err)
+		}
+		if _, err := pw.WriteMsg(&ssproto.ChunkResponse{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Index:  index,
+			Chunk:  chunk,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreSnapshotFile restores the app from the snapshot file at path, through
+// the same OfferSnapshot and ApplySnapshotChunk calls as state sync, but
+// without any peer or RPC server.
+//
+// The light blocks in the file are verified from the trusted header given by
+// trustOptions, and the app hash of the restored app is checked against them.
+// It returns the state and commit which the caller must use to bootstrap the
+// node.
+func RestoreSnapshotFile(
+	path string,
+	cfg config.StateSyncConfig,
+	conn proxy.AppConnSnapshot,
+	connQuery proxy.AppConnQuery,
+	chainID string,
+	stateVersion cmtstate.Version,
+	initialHeight int64,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+) (sm.State, *types.Commit, error) {
+	f, err := openSnapshotFile(path)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+	defer f.Close()
+
+	stateProvider, err := newLocalStateProvider(chainID, stateVersion, initialHeight,
+		f.lightBlocks, f.params, trustOptions, cmttime.Now())
+	if err != nil {
+		return sm.State{}, nil, fmt.Errorf("failed to verify the snapshot file: %w", err)
+	}
+	// Check that the file has all the data needed to build the state before
+	// offering the snapshot, since the syncer only reports it as rejected.
+	if _, err := stateProvider.State(context.Background(), f.snapshot.Height); err != nil {
+		return sm.State{}, nil, fmt.Errorf("invalid snapshot file: %w", err)
+	}
+
+	chunks, err := newChunkQueue(f.snapshot, cfg.TempDir)
+	if err != nil {
+		return sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %w", err)
+	}
+	defer chunks.Close()
+	if err := f.readChunks(chunks); err != nil {
+		return sm.State{}, nil, err
+	}
+
+	syncer := newSyncer(cfg, logger, conn, connQuery, stateProvider, cfg.TempDir)
+	// All the chunks are in the queue already, there is nothing to fetch.
+	syncer.chunkFetchers = 0
+
+	for attempt := 1; ; attempt++ {
+		state, commit, err := syncer.Sync(f.snapshot, chunks)
+		switch {
+		case err == nil:
+			return state, commit, nil
+		case errors.Is(err, errRetrySnapshot) && attempt < snapshotFileRestoreAttempts:
+			chunks.RetryAll()
+			logger.Info("Retrying snapshot", "height", f.snapshot.Height, "format", f.snapshot.Format,
+				"hash", log.NewLazySprintf("%X", f.snapshot.Hash))
+		default:
+			return sm.State{}, nil, fmt.Errorf("failed to restore snapshot at height %d: %w", f.snapshot.Height, err)
+		}
+	}
+}
+
+// snapshotFile is a snapshot file being read. The chunks are read after the
+// snapshot metadata, consensus parameters and light blocks.
+type snapshotFile struct {
+	file *os.File
+	r    protoio.ReadCloser
+
+	snapshot    *snapshot
+	params      types.ConsensusParams
+	lightBlocks []*types.LightBlock
+}
+
+func openSnapshotFile(path string) (*snapshotFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &snapshotFile{file: file}
+	if err := f.readHeader(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("invalid snapshot file %v: %w", path, err)
+	}
+	return f, nil
+}
+
+func (f *snapshotFile) readHeader() error {
+	br := bufio.NewReader(f.file)
+	magic := make([]byte, len(snapshotFileMagic)+1)
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return err
+	}
+	if string(magic[:len(snapshotFileMagic)]) != snapshotFileMagic {
+		return errors.New("not a snapshot file")
+	}
+	if v := magic[len(snapshotFileMagic)]; v != snapshotFileVersion {
+		return fmt.Errorf("unsupported snapshot file version %d", v)
+	}
+	numLightBlocks, err := binary.ReadUvarint(br)
+	if err != nil {
+		return err
+	}
+
+	f.r = protoio.NewDelimitedReader(br, snapshotFileMaxMsgSize)
+
+	var pbSnapshot ssproto.SnapshotsResponse
+	if _, err := f.r.ReadMsg(&pbSnapshot); err != nil {
+		return fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+	f.snapshot = &snapshot{
+		Height:   pbSnapshot.Height,
+		Format:   pbSnapshot.Format,
+		Chunks:   pbSnapshot.Chunks,
+		Hash:     pbSnapshot.Hash,
+		Metadata: pbSnapshot.Metadata,
+	}
+
+	var pbParams cmtproto.ConsensusParams
+	if _, err := f.r.ReadMsg(&pbParams); err != nil {
+		return fmt.Errorf("failed to read consensus parameters: %w", err)
+	}
+	f.params = types.ConsensusParamsFromProto(pbParams)
+
+	for i := uint64(0); i < numLightBlocks; i++ {
+		var pbLightBlock cmtproto.LightBlock
+		if _, err := f.r.ReadMsg(&pbLightBlock); err != nil {
+			return fmt.Errorf("failed to read light block: %w", err)
+		}
+		lb, err := types.LightBlockFromProto(&pbLightBlock)
+		if err != nil {
+			return fmt.Errorf("invalid light block: %w", err)
+		}
+		f.lightBlocks = append(f.lightBlocks, lb)
+	}
+	return nil
+}
+
+// readChunks reads all the chunks of the snapshot and adds them to queue.
+func (f *snapshotFile) readChunks(queue *chunkQueue) error {
+	for index := uint32(0); index < f.snapshot.Chunks; index++ {
+		var pbChunk ssproto.ChunkResponse
+		if _, err := f.r.ReadMsg(&pbChunk); err != nil {
+			return fmt.Errorf("failed to read chunk %d: %w", index, err)
+		}
+		if pbChunk.Index != index {
+			return fmt.Errorf("expected chunk %d, found chunk %d", index, pbChunk.Index)
+		}
+		if _, err := queue.Add(&chunk{
+			Height: pbChunk.Height,
+			Format: pbChunk.Format,
+			Index:  pbChunk.Index,
+			Chunk:  pbChunk.Chunk,
+		}); err != nil {
+			return fmt.Errorf("invalid chunk %d: %w", index, err)
+		}
+	}
+	return nil
+}
+
+func (f *snapshotFile) Close() error {
+	return f.file.Close()
+}
+
+// localStateProvider is a state provider serving the light blocks and
+// consensus parameters of a snapshot file, verified from a trusted header.
+type localStateProvider struct {
+	chainID       string
+	version       cmtstate.Version
+	initialHeight int64
+	lightBlocks   map[int64]*types.LightBlock
+	params        types.ConsensusParams
+}
+
+var _ StateProvider = (*localStateProvider)(nil)
+
+func newLocalStateProvider(
+	chainID string,
+	stateVersion cmtstate.Version,
+	initialHeight int64,
+	lightBlocks []*types.LightBlock,
+	params types.ConsensusParams,
+	trustOptions light.TrustOptions,
+	now time.Time,
+) (*localStateProvider, error) {
+	if err := verifyLightBlocks(chainID, lightBlocks, trustOptions, now); err != nil {
+		return nil, err
+	}
+	s := &localStateProvider{
+		chainID:       chainID,
+		version:       stateVersion,
+		initialHeight: initialHeight,
+		lightBlocks:   make(map[int64]*types.LightBlock, len(lightBlocks)),
+		params:        params,
+	}
+	for _, lb := range lightBlocks {
+		s.lightBlocks[lb.Height] = lb
+	}
+	return s, nil
+}
+
+func (s *localStateProvider) lightBlock(height uint64) (*types.LightBlock, error) {
+	lb, ok := s.lightBlocks[int64(height)]
+	if !ok {
+		return nil, fmt.Errorf("no light block at height %d in the snapshot file", height)
+	}
+	return lb, nil
+}
+
+// AppHash implements StateProvider.
+func (s *localStateProvider) AppHash(_ context.Context, height uint64) ([]byte, error) {
+	// The next height contains the app hash for the previous height. The
+	// light block at height+2 is needed to build the state.
+	lb, err := s.lightBlock(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.lightBlock(height + 2); err != nil {
+		return nil, err
+	}
+	return lb.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *localStateProvider) Commit(_ context.Context, height uint64) (*types.Commit, error) {
+	lb, err := s.lightBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return lb.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *localStateProvider) State(_ context.Context, height uint64) (sm.State, error) {
+	lastLightBlock, err := s.lightBlock(height)
+	if err != nil {
+		return sm.State{}, err
+	}
+	currentLightBlock, err := s.lightBlock(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextLightBlock, err := s.lightBlock(height + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+	state := stateFromLightBlocks(s.chainID, s.version, s.initialHeight,
+		lastLightBlock, currentLightBlock, nextLightBlock)
+
+	if !bytes.Equal(s.params.Hash(), currentLightBlock.ConsensusHash) {
+		return sm.State{}, fmt.Errorf("consensus parameters hash %X does not match the header at height %d (%X)",
+			s.params.Hash(), currentLightBlock.Height, currentLightBlock.ConsensusHash)
+	}
+	state.ConsensusParams = s.params
+	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+
+	return state, nil
+}
+
+// verifyLightBlocks verifies all the given light blocks from the trusted
+// header in trustOptions, which must be one of them. Light blocks above the
+// trusted height are verified in increasing height order, each from the
+// previous one, as the light client does. Light blocks below the trusted
+// height must be adjacent and are verified backwards through the hashes of
+// the headers. As these hashes do not cover the commits, the commits of the
+// trusted light block and of the light blocks below it are verified against
+// their validator sets, since the snapshot commit is stored as the seen commit
+// of the node.
+func verifyLightBlocks(chainID string, lightBlocks []*types.LightBlock, trustOptions light.TrustOptions, now time.Time) error {
+	if err := trustOptions.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid trust options: %w", err)
+	}
+
+	lightBlocks = append([]*types.LightBlock{}, lightBlocks...)
+	sort.Slice(lightBlocks, func(i, j int) bool { return lightBlocks[i].Height < lightBlocks[j].Height })
+
+	trusted := -1
+	for i, lb := range lightBlocks {
+		if err := lb.ValidateBasic(chainID); err != nil {
+			return fmt.Errorf("invalid light block at height %d: %w", lb.Height, err)
+		}
+		if i > 0 && lb.Height == lightBlocks[i-1].Height {
+			return fmt.Errorf("duplicate light block at height %d", lb.Height)
+		}
+		if lb.Height == trustOptions.Height {
+			trusted = i
+		}
+	}
+	if trusted < 0 {
+		return fmt.Errorf("no light block at the trusted height %d", trustOptions.Height)
+	}
+	if !bytes.Equal(lightBlocks[trusted].Hash(), trustOptions.Hash) {
+		return fmt.Errorf("expected header hash %X at the trusted height %d, got %X",
+			trustOptions.Hash, trustOptions.Height, lightBlocks[trusted].Hash())
+	}
+	if err := verifyLightBlockCommit(chainID, lightBlocks[trusted]); err != nil {
+		return err
+	}
+
+	for i := trusted + 1; i < len(lightBlocks); i++ {
+		trustedBlock, untrustedBlock := lightBlocks[i-1], lightBlocks[i]
+		var err error
+		if untrustedBlock.Height == trustedBlock.Height+1 {
+			err = light.VerifyAdjacent(trustedBlock.SignedHeader, untrustedBlock.SignedHeader,
+				untrustedBlock.ValidatorSet, trustOptions.Period, now, maxClockDrift)
+		} else {
+			err = light.VerifyNonAdjacent(trustedBlock.SignedHeader, trustedBlock.ValidatorSet,
+				untrustedBlock.SignedHeader, untrustedBlock.ValidatorSet, trustOptions.Period, now,
+				maxClockDrift, light.DefaultTrustLevel)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to verify light block at height %d: %w", untrustedBlock.Height, err)
+		}
+	}
+	for i := trusted - 1; i >= 0; i-- {
+		lb := lightBlocks[i]
+		if err := light.VerifyBackwards(lb.Header, lightBlocks[i+1].Header); err != nil {
+			return fmt.Errorf("failed to verify light block at height %d: %w", lb.Height, err)
+		}
+		if err := verifyLightBlockCommit(chainID, lb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyLightBlockCommit verifies the commit of a light block whose header is
+// trusted against its validator set.
+func verifyLightBlockCommit(chainID string, lb *types.LightBlock) error {
+	if err := lb.ValidatorSet.VerifyCommitLight(chainID, lb.Commit.BlockID, lb.Height, lb.Commit); err != nil {
+		return fmt.Errorf("invalid commit at height %d: %w", lb.Height, err)
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package statesync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
+	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/proxy"
+	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/cometbft/cometbft/version"
+)
+
+// makeLightBlocks returns a chain of light blocks from height 1 to the given
+// height, signed by a single validator and using the given consensus params.
+func makeLightBlocks(t *testing.T, height int64, params *types.ConsensusParams, start time.Time) []*types.LightBlock {
+	t.Helper()
+
+	valSet, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	lightBlocks := make([]*types.LightBlock, 0, height)
+	lastBlockID := test.MakeBlockID()
+	for h := int64(1); h <= height; h++ {
+		header := test.MakeHeader(t, &types.Header{
+			Version:            cmtversion.Consensus{Block: version.BlockProtocol, App: testAppVersion},
+			ChainID:            test.DefaultTestChainID,
+			Height:             h,
+			Time:               start.Add(time.Duration(h) * time.Second),
+			LastBlockID:        lastBlockID,
+			ValidatorsHash:     valSet.Hash(),
+			NextValidatorsHash: valSet.Hash(),
+			ConsensusHash:      params.Hash(),
+			ProposerAddress:    valSet.Validators[0].Address,
+		})
+		blockID := test.MakeBlockIDWithHash(header.Hash())
+		commit, err := test.MakeCommit(blockID, h, 0, valSet, privVals, test.DefaultTestChainID, header.Time)
+		require.NoError(t, err)
+		lightBlocks = append(lightBlocks, &types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+			ValidatorSet: valSet,
+		})
+		lastBlockID = blockID
+	}
+	return lightBlocks
+}
+
+func TestVerifyLightBlocks(t *testing.T) {
+	now := cmttime.Now()
+	params := test.ConsensusParams()
+	lightBlocks := makeLightBlocks(t, 10, params, now.Add(-time.Hour))
+	trustOptions := light.TrustOptions{
+		Period: 24 * time.Hour,
+		Height: 5,
+		Hash:   lightBlocks[4].Hash(),
+	}
+
+	tampered := *lightBlocks[7].Header
+	tampered.AppHash = test.RandomHash()
+
+	// The header hashes do not cover the commits of the trusted block and of
+	// the blocks below it.
+	forgeCommit := func(commit *types.Commit) *types.Commit {
+		forged := *commit
+		forged.Signatures = append([]types.CommitSig{}, forged.Signatures...)
+		forged.Signatures[0].Signature = make([]byte, len(forged.Signatures[0].Signature))
+		return &forged
+	}
+
+	testCases := map[string]struct {
+		lightBlocks  []*types.LightBlock
+		trustOptions light.TrustOptions
+		expectErr    bool
+	}{
+		"all blocks": {lightBlocks, trustOptions, false},
+		"unordered blocks": {
+			[]*types.LightBlock{lightBlocks[9], lightBlocks[4], lightBlocks[8], lightBlocks[3]},
+			trustOptions, false,
+		},
+		"skipping forward": {
+			[]*types.LightBlock{lightBlocks[4], lightBlocks[7], lightBlocks[8], lightBlocks[9]},
+			trustOptions, false,
+		},
+		"skipping backward": {
+			[]*types.LightBlock{lightBlocks[1], lightBlocks[4], lightBlocks[5]},
+			trustOptions, true,
+		},
+		"no trusted block": {lightBlocks[5:], trustOptions, true},
+		"wrong trusted hash": {
+			lightBlocks,
+			light.TrustOptions{Period: trustOptions.Period, Height: 5, Hash: lightBlocks[3].Hash()},
+			true,
+		},
+		"expired trusted block": {
+			lightBlocks,
+			light.TrustOptions{Period: time.Minute, Height: 5, Hash: lightBlocks[4].Hash()},
+			true,
+		},
+		"duplicate block": {
+			[]*types.LightBlock{lightBlocks[4], lightBlocks[5], lightBlocks[5]},
+			trustOptions, true,
+		},
+		"tampered block": {
+			[]*types.LightBlock{lightBlocks[4], lightBlocks[6], {
+				SignedHeader: &types.SignedHeader{Header: &tampered, Commit: lightBlocks[7].Commit},
+				ValidatorSet: lightBlocks[7].ValidatorSet,
+			}},
+			trustOptions, true,
+		},
+		"forged commit below the trusted block": {
+			[]*types.LightBlock{{
+				SignedHeader: &types.SignedHeader{Header: lightBlocks[3].Header, Commit: forgeCommit(lightBlocks[3].Commit)},
+				ValidatorSet: lightBlocks[3].ValidatorSet,
+			}, lightBlocks[4], lightBlocks[5]},
+			trustOptions, true,
+		},
+		"forged commit of the trusted block": {
+			[]*types.LightBlock{lightBlocks[3], {
+				SignedHeader: &types.SignedHeader{Header: lightBlocks[4].Header, Commit: forgeCommit(lightBlocks[4].Commit)},
+				ValidatorSet: lightBlocks[4].ValidatorSet,
+			}},
+			trustOptions, true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := verifyLightBlocks(test.DefaultTestChainID, tc.lightBlocks, tc.trustOptions, now)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func writeTestSnapshotFile(
+	t *testing.T,
+	snapshot *abci.Snapshot,
+	params types.ConsensusParams,
+	lightBlocks []*types.LightBlock,
+	chunks [][]byte,
+) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "snapshot.bin")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	err = WriteSnapshotFile(f, snapshot, params, lightBlocks, func(index uint32) ([]byte, error) {
+		return chunks[index], nil
+	})
+	require.NoError(t, err)
+	return path
+}
+
+func TestSnapshotFile_roundTrip(t *testing.T) {
+	params := test.ConsensusParams()
+	lightBlocks := makeLightBlocks(t, 4, params, cmttime.Now().Add(-time.Hour))
+	chunks := [][]byte{{1, 0}, {1, 1}, {1, 2}}
+	snapshot := &abci.Snapshot{Height: 2, Format: 1, Chunks: 3, Hash: []byte{1, 2, 3}, Metadata: []byte("meta")}
+
+	path := writeTestSnapshotFile(t, snapshot, *params, lightBlocks, chunks)
+	f, err := openSnapshotFile(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	assert.EqualValues(t, snapshot.Height, f.snapshot.Height)
+	assert.Equal(t, snapshot.Format, f.snapshot.Format)
+	assert.Equal(t, snapshot.Chunks, f.snapshot.Chunks)
+	assert.Equal(t, snapshot.Hash, f.snapshot.Hash)
+	assert.Equal(t, snapshot.Metadata, f.snapshot.Metadata)
+	assert.Equal(t, params.Hash(), f.params.Hash())
+	require.Len(t, f.lightBlocks, len(lightBlocks))
+	for i, lb := range f.lightBlocks {
+		assert.Equal(t, lightBlocks[i].Hash(), lb.Hash())
+	}
+
+	queue, err := newChunkQueue(f.snapshot, t.TempDir())
+	require.NoError(t, err)
+	defer queue.Close()
+	require.NoError(t, f.readChunks(queue))
+	for _, c := range chunks {
+		loaded, err := queue.Next()
+		require.NoError(t, err)
+		assert.Equal(t, c, loaded.Chunk)
+	}
+
+	// A truncated file is rejected.
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)-1], 0o600))
+	f, err = openSnapshotFile(path)
+	require.NoError(t, err)
+	defer f.Close()
+	queue, err = newChunkQueue(f.snapshot, t.TempDir())
+	require.NoError(t, err)
+	defer queue.Close()
+	require.Error(t, f.readChunks(queue))
+
+	// So is a file in another format.
+	require.NoError(t, os.WriteFile(path, []byte("not a snapshot file"), 0o600))
+	_, err = openSnapshotFile(path)
+	require.Error(t, err)
+}
+
+func TestRestoreSnapshotFile(t *testing.T) {
+	params := test.ConsensusParams()
+	lightBlocks := makeLightBlocks(t, 5, params, cmttime.Now().Add(-time.Hour))
+	chunks := [][]byte{{1, 0}, {1, 1}}
+	snapshot := &abci.Snapshot{Height: 3, Format: 1, Chunks: 2, Hash: []byte{1, 2, 3}}
+	trustOptions := light.TrustOptions{Period: 24 * time.Hour, Height: 1, Hash: lightBlocks[0].Hash()}
+	stateVersion := cmtstate.Version{Consensus: cmtversion.Consensus{Block: version.BlockProtocol}}
+
+	setupApp := func(lastBlockAppHash []byte) (*proxymocks.AppConnSnapshot, *proxymocks.AppConnQuery) {
+		connSnapshot := &proxymocks.AppConnSnapshot{}
+		connQuery := &proxymocks.AppConnQuery{}
+		connSnapshot.On("OfferSnapshot", mock.Anything, &abci.OfferSnapshotRequest{
+			Snapshot: snapshot,
+			AppHash:  lightBlocks[3].AppHash,
+		}).Return(&abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT}, nil)
+		for i, c := range chunks {
+			connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.ApplySnapshotChunkRequest{
+				Index: uint32(i), Chunk: c,
+			}).Return(&abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil)
+		}
+		connQuery.On("Info", mock.Anything, proxy.InfoRequest).Return(&abci.InfoResponse{
+			AppVersion:       testAppVersion,
+			LastBlockHeight:  int64(snapshot.Height),
+			LastBlockAppHash: lastBlockAppHash,
+		}, nil)
+		return connSnapshot, connQuery
+	}
+
+	t.Run("restored", func(t *testing.T) {
+		path := writeTestSnapshotFile(t, snapshot, *params, lightBlocks, chunks)
+		connSnapshot, connQuery := setupApp(lightBlocks[3].AppHash)
+
+		state, commit, err := RestoreSnapshotFile(path, *config.DefaultStateSyncConfig(), connSnapshot, connQuery,
+			test.DefaultTestChainID, stateVersion, 1, trustOptions, log.NewNopLogger())
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 3, state.LastBlockHeight)
+		assert.Equal(t, lightBlocks[2].Commit.BlockID, state.LastBlockID)
+		assert.EqualValues(t, lightBlocks[3].AppHash, state.AppHash)
+		assert.Equal(t, params.Hash(), state.ConsensusParams.Hash())
+		assert.EqualValues(t, testAppVersion, state.Version.Consensus.App)
+		assert.Equal(t, lightBlocks[2].Commit, commit)
+		connSnapshot.AssertExpectations(t)
+		connQuery.AssertExpectations(t)
+	})
+
+	t.Run("app hash mismatch", func(t *testing.T) {
+		path := writeTestSnapshotFile(t, snapshot, *params, lightBlocks, chunks)
+		connSnapshot, connQuery := setupApp(test.RandomHash())
+
+		_, _, err := RestoreSnapshotFile(path, *config.DefaultStateSyncConfig(), connSnapshot, connQuery,
+			test.DefaultTestChainID, stateVersion, 1, trustOptions, log.NewNopLogger())
+		require.ErrorIs(t, err, errVerifyFailed)
+	})
+
+	t.Run("missing light block", func(t *testing.T) {
+		path := writeTestSnapshotFile(t, snapshot, *params, lightBlocks[:4], chunks)
+		connSnapshot, connQuery := setupApp(lightBlocks[3].AppHash)
+
+		_, _, err := RestoreSnapshotFile(path, *config.DefaultStateSyncConfig(), connSnapshot, connQuery,
+			test.DefaultTestChainID, stateVersion, 1, trustOptions, log.NewNopLogger())
+		require.Error(t, err)
+		connSnapshot.AssertNotCalled(t, "OfferSnapshot", mock.Anything, mock.Anything)
+	})
+
+	t.Run("untrusted light blocks", func(t *testing.T) {
+		path := writeTestSnapshotFile(t, snapshot, *params, lightBlocks, chunks)
+		connSnapshot, connQuery := setupApp(lightBlocks[3].AppHash)
+		wrongTrust := light.TrustOptions{Period: trustOptions.Period, Height: 1, Hash: test.RandomHash()}
+
+		_, _, err := RestoreSnapshotFile(path, *config.DefaultStateSyncConfig(), connSnapshot, connQuery,
+			test.DefaultTestChainID, stateVersion, 1, wrongTrust, log.NewNopLogger())
+		require.Error(t, err)
+		connSnapshot.AssertNotCalled(t, "OfferSnapshot", mock.Anything, mock.Anything)
+	})
+}
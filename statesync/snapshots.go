@@ -8,6 +8,8 @@ import (
 
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
 )
 
 // snapshotKey is a snapshot key used for lookups.
@@ -22,6 +24,11 @@ type snapshot struct {
 	Metadata []byte
 
 	trustedAppHash []byte // populated by light client
+
+	// populated by light client; only set for a snapshot whose restoration
+	// is resumed after a restart
+	trustedState  *sm.State
+	trustedCommit *types.Commit
 }
 
 // Key generates a snapshot key, used for lookups. It takes into account not only the height and
@@ -1,10 +1,12 @@
 package statesync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
@@ -14,7 +16,9 @@ import (
 	lightprovider "github.com/cometbft/cometbft/light/provider"
 	lighthttp "github.com/cometbft/cometbft/light/provider/http"
 	lightrpc "github.com/cometbft/cometbft/light/rpc"
+	lightstore "github.com/cometbft/cometbft/light/store"
 	lightdb "github.com/cometbft/cometbft/light/store/db"
+	"github.com/cometbft/cometbft/p2p"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
@@ -140,23 +144,11 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	s.Lock()
 	defer s.Unlock()
 
-	state := sm.State{
-		ChainID:       s.lc.ChainID(),
-		Version:       s.version,
-		InitialHeight: s.initialHeight,
-	}
-	if state.InitialHeight == 0 {
-		state.InitialHeight = 1
-	}
-
 	// The snapshot height maps onto the state heights as follows:
 	//
 	// height: last block, i.e. the snapshotted height
 	// height+1: current block, i.e. the first block we'll process after the snapshot
 	// height+2: next block, i.e. the second block after the snapshot
-	//
-	// We need to fetch the NextValidators from height+2 because if the application changed
-	// the validator set at the snapshot height then this only takes effect at height+2.
 	lastLightBlock, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height), cmttime.Now())
 	if err != nil {
 		return sm.State{}, err
@@ -169,20 +161,8 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	if err != nil {
 		return sm.State{}, err
 	}
-
-	state.Version = cmtstate.Version{
-		Consensus: currentLightBlock.Version,
-		Software:  version.CMTSemVer,
-	}
-	state.LastBlockHeight = lastLightBlock.Height
-	state.LastBlockTime = lastLightBlock.Time
-	state.LastBlockID = lastLightBlock.Commit.BlockID
-	state.AppHash = currentLightBlock.AppHash
-	state.LastResultsHash = currentLightBlock.LastResultsHash
-	state.LastValidators = lastLightBlock.ValidatorSet
-	state.Validators = currentLightBlock.ValidatorSet
-	state.NextValidators = nextLightBlock.ValidatorSet
-	state.LastHeightValidatorsChanged = nextLightBlock.Height
+	state := stateFromLightBlocks(s.lc.ChainID(), s.version, s.initialHeight,
+		lastLightBlock, currentLightBlock, nextLightBlock)
 
 	// We'll also need to fetch consensus params via RPC, using light client verification.
 	primaryURL, ok := s.providers[s.lc.Primary()]
@@ -205,6 +185,268 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	return state, nil
 }
 
+// p2pStateProvider is a state provider using the light client, fetching light
+// blocks and consensus parameters from the peers connected to the state sync
+// reactor.
+type p2pStateProvider struct {
+	cmtsync.Mutex // light.Client is not concurrency-safe
+	lc            *light.Client
+	store         lightstore.Store // kept when the light client is set up again
+	dispatcher    *dispatcher
+	chainID       string
+	version       cmtstate.Version
+	initialHeight int64
+	trustOptions  light.TrustOptions
+	logger        log.Logger
+}
+
+// NewP2PStateProvider creates a new StateProvider using a light client which
+// fetches light blocks from the peers connected to the reactor, over the light
+// block channel. Unlike NewLightClientStateProvider, it does not need RPC
+// servers: only the trust options. The light client is set up once at least
+// two peers serving light blocks are connected, one being used as the primary
+// and the others as witnesses. It is set up again with the connected peers,
+// keeping the light blocks verified so far, when a verification fails after
+// some of its peers disconnected or it ran out of witnesses.
+func NewP2PStateProvider(
+	r *Reactor,
+	chainID string,
+	version cmtstate.Version,
+	initialHeight int64,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+	dbKeyLayoutVersion string,
+) (StateProvider, error) {
+	if err := trustOptions.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &p2pStateProvider{
+		store:         lightdb.NewWithDBVersion(dbm.NewMemDB(), "", dbKeyLayoutVersion),
+		dispatcher:    r.dispatcher,
+		chainID:       chainID,
+		version:       version,
+		initialHeight: initialHeight,
+		trustOptions:  trustOptions,
+		logger:        logger,
+	}, nil
+}
+
+// lightClient returns the light client, setting it up with the connected
+// peers if needed. The caller must hold the mutex lock.
+func (s *p2pStateProvider) lightClient(ctx context.Context) (*light.Client, error) {
+	if s.lc != nil {
+		return s.lc, nil
+	}
+
+	// Wait for a primary and at least one witness.
+	var peers []p2p.Peer
+	for {
+		peers = s.dispatcher.Peers()
+		if len(peers) >= 2 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %d peer(s) serving light blocks", light.ErrNoWitnesses, len(peers))
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	providers := make([]lightprovider.Provider, 0, len(peers))
+	for _, peer := range peers {
+		providers = append(providers, newBlockProvider(peer, s.chainID, s.dispatcher))
+	}
+	options := []light.Option{light.Logger(s.logger), light.MaxRetryAttempts(5)}
+	var (
+		lc  *light.Client
+		err error
+	)
+	if lastHeight, _ := s.store.LastLightBlockHeight(); lastHeight > 0 {
+		// The light client is set up again: resume from the light blocks
+		// verified before.
+		lc, err = light.NewClientFromTrustedStore(s.chainID, s.trustOptions.Period,
+			providers[0], providers[1:], s.store, options...)
+	} else {
+		lc, err = light.NewClient(ctx, s.chainID, s.trustOptions, providers[0], providers[1:],
+			s.store, options...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.lc = lc
+	return lc, nil
+}
+
+// verifyLightBlockAtHeight verifies the light block at the given height with
+// the light client. If the verification fails while the light client has no
+// witnesses left or uses peers which disconnected, the light client is set up
+// again with the connected peers and the verification is retried. The caller
+// must hold the mutex lock.
+func (s *p2pStateProvider) verifyLightBlockAtHeight(ctx context.Context, height int64) (*types.LightBlock, error) {
+	lc, err := s.lightClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lb, err := lc.VerifyLightBlockAtHeight(ctx, height, cmttime.Now())
+	if err == nil || ctx.Err() != nil || !s.stale(lc) {
+		return lb, err
+	}
+
+	s.logger.Info("Setting up the light client again with the connected peers", "err", err)
+	s.lc = nil
+	lc, err = s.lightClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lc.VerifyLightBlockAtHeight(ctx, height, cmttime.Now())
+}
+
+// stale returns whether the light client has no witnesses left, or uses peers
+// which are no longer connected.
+func (s *p2pStateProvider) stale(lc *light.Client) bool {
+	witnesses := lc.Witnesses()
+	if len(witnesses) == 0 {
+		return true
+	}
+	connected := make(map[p2p.ID]bool)
+	for _, peer := range s.dispatcher.Peers() {
+		connected[peer.ID()] = true
+	}
+	providers := append([]lightprovider.Provider{lc.Primary()}, witnesses...)
+	for _, provider := range providers {
+		if bp, ok := provider.(*blockProvider); ok && !connected[bp.peer.ID()] {
+			return true
+		}
+	}
+	return false
+}
+
+// AppHash implements StateProvider.
+func (s *p2pStateProvider) AppHash(ctx context.Context, height uint64) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// We have to fetch the next height, which contains the app hash for the previous height.
+	header, err := s.verifyLightBlockAtHeight(ctx, int64(height+1))
+	if err != nil {
+		return nil, err
+	}
+	// Also verify the block at H+2, needed to build the state (see
+	// lightClientStateProvider.AppHash).
+	_, err = s.verifyLightBlockAtHeight(ctx, int64(height+2))
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *p2pStateProvider) Commit(ctx context.Context, height uint64) (*types.Commit, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	header, err := s.verifyLightBlockAtHeight(ctx, int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return header.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *p2pStateProvider) State(ctx context.Context, height uint64) (sm.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	lastLightBlock, err := s.verifyLightBlockAtHeight(ctx, int64(height))
+	if err != nil {
+		return sm.State{}, err
+	}
+	currentLightBlock, err := s.verifyLightBlockAtHeight(ctx, int64(height+1))
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextLightBlock, err := s.verifyLightBlockAtHeight(ctx, int64(height+2))
+	if err != nil {
+		return sm.State{}, err
+	}
+	state := stateFromLightBlocks(s.chainID, s.version, s.initialHeight,
+		lastLightBlock, currentLightBlock, nextLightBlock)
+
+	params, err := s.consensusParams(ctx, currentLightBlock)
+	if err != nil {
+		return sm.State{}, err
+	}
+	state.ConsensusParams = params
+	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+
+	return state, nil
+}
+
+// consensusParams fetches the consensus parameters at the height of a
+// verified light block from the peers, until a peer returns parameters
+// matching the consensus hash of the light block.
+func (s *p2pStateProvider) consensusParams(ctx context.Context, lightBlock *types.LightBlock) (types.ConsensusParams, error) {
+	for _, peer := range s.dispatcher.Peers() {
+		params, err := s.dispatcher.ConsensusParams(ctx, peer, uint64(lightBlock.Height))
+		if ctx.Err() != nil {
+			return types.ConsensusParams{}, ctx.Err()
+		}
+		if err != nil {
+			s.logger.Debug("Failed to fetch consensus parameters", "peer", peer.ID(), "err", err)
+			continue
+		}
+		if params == nil {
+			s.logger.Debug("Peer has no consensus parameters", "peer", peer.ID(), "height", lightBlock.Height)
+			continue
+		}
+		if !bytes.Equal(params.Hash(), lightBlock.ConsensusHash) {
+			s.logger.Info("Peer sent consensus parameters not matching the light block",
+				"peer", peer.ID(), "height", lightBlock.Height)
+			continue
+		}
+		return *params, nil
+	}
+	return types.ConsensusParams{}, fmt.Errorf("unable to fetch consensus parameters for height %v from peers",
+		lightBlock.Height)
+}
+
+// stateFromLightBlocks builds the state after the block at the snapshot height
+// from the verified light blocks at the snapshot height (last), and the next
+// two heights (current and next). The consensus parameters must be set by the
+// caller.
+func stateFromLightBlocks(
+	chainID string,
+	stateVersion cmtstate.Version,
+	initialHeight int64,
+	lastLightBlock, currentLightBlock, nextLightBlock *types.LightBlock,
+) sm.State {
+	state := sm.State{
+		ChainID:       chainID,
+		Version:       stateVersion,
+		InitialHeight: initialHeight,
+	}
+	if state.InitialHeight == 0 {
+		state.InitialHeight = 1
+	}
+
+	// We need to use the NextValidators from height+2 because if the application changed
+	// the validator set at the snapshot height then this only takes effect at height+2.
+	state.Version = cmtstate.Version{
+		Consensus: currentLightBlock.Version,
+		Software:  version.CMTSemVer,
+	}
+	state.LastBlockHeight = lastLightBlock.Height
+	state.LastBlockTime = lastLightBlock.Time
+	state.LastBlockID = lastLightBlock.Commit.BlockID
+	state.AppHash = currentLightBlock.AppHash
+	state.LastResultsHash = currentLightBlock.LastResultsHash
+	state.LastValidators = lastLightBlock.ValidatorSet
+	state.Validators = currentLightBlock.ValidatorSet
+	state.NextValidators = nextLightBlock.ValidatorSet
+	state.LastHeightValidatorsChanged = nextLightBlock.Height
+	return state
+}
+
 // rpcClient sets up a new RPC client.
 func rpcClient(server string) (*rpchttp.HTTP, error) {
 	if !strings.Contains(server, "://") {
@@ -55,6 +55,10 @@ type syncer struct {
 	tempDir       string
 	chunkFetchers int32
 	retryTimeout  time.Duration
+	progress      *progressStore // nil if the progress is not persisted
+	peers         *peerScorer
+	fetchLimit    *fetchLimit
+	metrics       *Metrics
 
 	mtx    cmtsync.RWMutex
 	chunks *chunkQueue
@@ -78,6 +82,9 @@ func newSyncer(
 		tempDir:       tempDir,
 		chunkFetchers: cfg.ChunkFetchers,
 		retryTimeout:  cfg.ChunkRequestTimeout,
+		peers:         newPeerScorer(),
+		fetchLimit:    newFetchLimit(cfg.ChunkFetchers),
+		metrics:       NopMetrics(),
 	}
 }
 
@@ -89,6 +96,11 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	if s.chunks == nil {
 		return false, errors.New("no state sync in progress")
 	}
+	if s.peers.IsBanned(chunk.Sender) {
+		s.logger.Debug("Ignoring chunk from banned peer", "height", chunk.Height, "format", chunk.Format,
+			"chunk", chunk.Index, "peer", chunk.Sender)
+		return false, nil
+	}
 	added, err := s.chunks.Add(chunk)
 	if err != nil {
 		return false, err
@@ -96,6 +108,10 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	if added {
 		s.logger.Debug("Added chunk to queue", "height", chunk.Height, "format", chunk.Format,
 			"chunk", chunk.Index)
+		if throughput, ok := s.peers.Received(chunk.Index, chunk.Sender, len(chunk.Chunk)); ok {
+			s.metrics.PeerChunkThroughput.With("peer_id", string(chunk.Sender)).Set(throughput)
+			s.metrics.ChunkFetchers.Set(float64(s.fetchLimit.Increase()))
+		}
 	} else {
 		s.logger.Debug("Ignoring duplicate chunk in queue", "height", chunk.Height, "format", chunk.Format,
 			"chunk", chunk.Index)
@@ -132,6 +148,17 @@ func (s *syncer) AddPeer(peer p2p.Peer) {
 func (s *syncer) RemovePeer(peer p2p.Peer) {
 	s.logger.Debug("Removing peer from sync", "peer", peer.ID())
 	s.snapshots.RemovePeer(peer.ID())
+	s.peers.RemovePeer(peer.ID())
+}
+
+// banPeer bans a peer whose chunks were rejected by the app: it is no longer
+// used for any snapshot, and the chunks it sends are ignored.
+func (s *syncer) banPeer(id p2p.ID) {
+	s.snapshots.RejectPeer(id)
+	if s.peers.Ban(id) {
+		s.metrics.BannedPeers.Add(1)
+		s.logger.Info("Banned peer whose chunks were rejected by the app", "peer", id)
+	}
 }
 
 // SyncAny tries to sync any of the snapshots in the snapshot pool, waiting to
@@ -154,6 +181,15 @@ func (s *syncer) SyncAny(discoveryTime, maxDiscoveryTime time.Duration, retryHoo
 		chunks   *chunkQueue
 		err      error
 	)
+	if s.progress != nil {
+		snapshot, chunks, err = s.resume()
+		if err != nil {
+			return sm.State{}, nil, err
+		}
+		if chunks != nil {
+			defer chunks.Close()
+		}
+	}
 	for {
 		// If not nil, we're going to retry restoration of the same snapshot.
 		if snapshot == nil {
@@ -170,7 +206,7 @@ func (s *syncer) SyncAny(discoveryTime, maxDiscoveryTime time.Duration, retryHoo
 			continue
 		}
 		if chunks == nil {
-			chunks, err = newChunkQueue(snapshot, s.tempDir)
+			chunks, err = s.newChunkQueue(snapshot)
 			if err != nil {
 				return sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %w", err)
 			}
@@ -180,9 +216,11 @@ func (s *syncer) SyncAny(discoveryTime, maxDiscoveryTime time.Duration, retryHoo
 		newState, commit, err := s.Sync(snapshot, chunks)
 		switch {
 		case err == nil:
+			s.clearProgress()
 			return newState, commit, nil
 
 		case errors.Is(err, errAbort):
+			s.clearProgress()
 			return sm.State{}, nil, err
 
 		case errors.Is(err, errRetrySnapshot):
@@ -218,6 +256,8 @@ func (s *syncer) SyncAny(discoveryTime, maxDiscoveryTime time.Duration, retryHoo
 			s.snapshots.Reject(snapshot)
 
 		default:
+			// The progress is kept, so that the restoration can be resumed
+			// once the cause of the error, e.g. the app crashing, is fixed.
 			return sm.State{}, nil, fmt.Errorf("snapshot restoration failed: %w", err)
 		}
 
@@ -226,11 +266,66 @@ func (s *syncer) SyncAny(discoveryTime, maxDiscoveryTime time.Duration, retryHoo
 		if err != nil {
 			s.logger.Error("Failed to clean up chunk queue", "err", err)
 		}
+		s.clearProgress()
 		snapshot = nil
 		chunks = nil
 	}
 }
 
+// resume returns the snapshot whose restoration was in progress when the node
+// stopped, and its chunk queue with the chunks already fetched. It returns nil
+// if there is no restoration to resume.
+func (s *syncer) resume() (*snapshot, *chunkQueue, error) {
+	progress, err := s.progress.Load()
+	if err != nil {
+		s.logger.Error("Failed to load state sync progress, starting over", "err", err)
+		return nil, nil, s.progress.Clear()
+	}
+	if progress == nil {
+		return nil, nil, nil
+	}
+
+	snapshot, err := progress.snapshot()
+	if err != nil {
+		return nil, nil, err
+	}
+	chunks, err := s.progress.ChunkQueue(snapshot)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create chunk queue: %w", err)
+	}
+	// The app is offered the snapshot again before any chunk is applied. If it
+	// does not keep the chunks applied before the restart, it must ask to retry
+	// the snapshot, which applies all the chunks again.
+	chunks.MarkReturned(progress.AppliedChunks)
+	s.logger.Info("Resuming snapshot restoration", "height", snapshot.Height, "format", snapshot.Format,
+		"hash", log.NewLazySprintf("%X", snapshot.Hash), "applied", len(progress.AppliedChunks),
+		"total", snapshot.Chunks)
+	return snapshot, chunks, nil
+}
+
+// newChunkQueue creates the chunk queue for a snapshot about to be restored.
+func (s *syncer) newChunkQueue(snapshot *snapshot) (*chunkQueue, error) {
+	if s.progress == nil {
+		return newChunkQueue(snapshot, s.tempDir)
+	}
+	// Drop the chunks of any snapshot restored previously.
+	if err := s.progress.Clear(); err != nil {
+		return nil, err
+	}
+	return s.progress.ChunkQueue(snapshot)
+}
+
+// clearProgress removes the persisted progress, if any, once the snapshot
+// being restored is done with.
+func (s *syncer) clearProgress() {
+	if s.progress == nil {
+		return
+	}
+	if err := s.progress.Clear(); err != nil {
+		s.logger.Error("Failed to clean up state sync progress", "err", err)
+	}
+}
+
 // Sync executes a sync for a specific snapshot, returning the latest state and block commit which
 // the caller must use to bootstrap the node.
 func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.Commit, error) {
@@ -250,49 +345,53 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 	hctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
 	defer cancel()
 
-	appHash, err := s.stateProvider.AppHash(hctx, snapshot.Height)
-	if err != nil {
-		s.logger.Info("failed to fetch and verify app hash", "err", err)
-		if errors.Is(err, light.ErrNoWitnesses) {
-			return sm.State{}, nil, err
+	// A snapshot resumed after a restart carries the app hash verified before.
+	if len(snapshot.trustedAppHash) == 0 {
+		appHash, err := s.stateProvider.AppHash(hctx, snapshot.Height)
+		if err != nil {
+			s.logger.Info("failed to fetch and verify app hash", "err", err)
+			if errors.Is(err, light.ErrNoWitnesses) {
+				return sm.State{}, nil, err
+			}
+			return sm.State{}, nil, errRejectSnapshot
 		}
-		return sm.State{}, nil, errRejectSnapshot
+		snapshot.trustedAppHash = appHash
 	}
-	snapshot.trustedAppHash = appHash
 
 	// Offer snapshot to ABCI app.
-	err = s.offerSnapshot(snapshot)
+	err := s.offerSnapshot(snapshot)
 	if err != nil {
 		return sm.State{}, nil, err
 	}
+	if s.progress != nil {
+		if err := s.progress.Start(snapshot, chunks.Returned()); err != nil {
+			return sm.State{}, nil, fmt.Errorf("failed to persist state sync progress: %w", err)
+		}
+	}
 
 	// Spawn chunk fetchers. They will terminate when the chunk queue is closed or context canceled.
 	fetchCtx, cancel := context.WithCancel(context.TODO())
 	defer cancel()
+	s.metrics.ChunkFetchers.Set(float64(s.fetchLimit.Limit()))
 	for i := int32(0); i < s.chunkFetchers; i++ {
 		go s.fetchChunks(fetchCtx, snapshot, chunks)
 	}
 
-	pctx, pcancel := context.WithTimeout(context.TODO(), 30*time.Second)
-	defer pcancel()
-
 	// Optimistically build new state, so we don't discover any light client failures at the end.
-	state, err := s.stateProvider.State(pctx, snapshot.Height)
-	if err != nil {
-		s.logger.Info("failed to fetch and verify CometBFT state", "err", err)
-		if errors.Is(err, light.ErrNoWitnesses) {
+	// A snapshot resumed after a restart carries the state and commit verified before.
+	if snapshot.trustedState == nil || snapshot.trustedCommit == nil {
+		state, commit, err := s.fetchStateAndCommit(snapshot.Height)
+		if err != nil {
 			return sm.State{}, nil, err
 		}
-		return sm.State{}, nil, errRejectSnapshot
-	}
-	commit, err := s.stateProvider.Commit(pctx, snapshot.Height)
-	if err != nil {
-		s.logger.Info("failed to fetch and verify commit", "err", err)
-		if errors.Is(err, light.ErrNoWitnesses) {
-			return sm.State{}, nil, err
+		snapshot.trustedState, snapshot.trustedCommit = &state, commit
+		if s.progress != nil {
+			if err := s.progress.Verified(state, commit); err != nil {
+				return sm.State{}, nil, fmt.Errorf("failed to persist state sync progress: %w", err)
+			}
 		}
-		return sm.State{}, nil, errRejectSnapshot
 	}
+	state, commit := *snapshot.trustedState, snapshot.trustedCommit
 
 	// Restore snapshot
 	err = s.applyChunks(chunks)
@@ -312,6 +411,31 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 	return state, commit, nil
 }
 
+// fetchStateAndCommit fetches and verifies the state and commit at the height
+// of a snapshot with the state provider.
+func (s *syncer) fetchStateAndCommit(height uint64) (sm.State, *types.Commit, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	state, err := s.stateProvider.State(ctx, height)
+	if err != nil {
+		s.logger.Info("failed to fetch and verify CometBFT state", "err", err)
+		if errors.Is(err, light.ErrNoWitnesses) {
+			return sm.State{}, nil, err
+		}
+		return sm.State{}, nil, errRejectSnapshot
+	}
+	commit, err := s.stateProvider.Commit(ctx, height)
+	if err != nil {
+		s.logger.Info("failed to fetch and verify commit", "err", err)
+		if errors.Is(err, light.ErrNoWitnesses) {
+			return sm.State{}, nil, err
+		}
+		return sm.State{}, nil, errRejectSnapshot
+	}
+	return state, commit, nil
+}
+
 // offerSnapshot offers a snapshot to the app. It returns various errors depending on the app's
 // response, or nil if the snapshot was accepted.
 func (s *syncer) offerSnapshot(snapshot *snapshot) error {
@@ -376,12 +500,17 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 			if err != nil {
 				return fmt.Errorf("failed to discard chunk %v: %w", index, err)
 			}
+			if s.progress != nil {
+				if err := s.progress.ChunkDiscarded(index); err != nil {
+					return fmt.Errorf("failed to persist state sync progress: %w", err)
+				}
+			}
 		}
 
 		// Reject any senders as requested by the app
 		for _, sender := range resp.RejectSenders {
 			if sender != "" {
-				s.snapshots.RejectPeer(p2p.ID(sender))
+				s.banPeer(p2p.ID(sender))
 				err := chunks.DiscardSender(p2p.ID(sender))
 				if err != nil {
 					return fmt.Errorf("failed to reject sender: %w", err)
@@ -391,6 +520,11 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 
 		switch resp.Result {
 		case abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT:
+			if s.progress != nil {
+				if err := s.progress.ChunkApplied(chunk.Index); err != nil {
+					return fmt.Errorf("failed to persist state sync progress: %w", err)
+				}
+			}
 		case abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT:
 			return errAbort
 		case abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY:
@@ -433,6 +567,19 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 				return
 			}
 		}
+
+		// Wait for a request slot, as the number of concurrent requests is
+		// reduced when requests time out.
+		if !s.fetchLimit.TryAcquire() {
+			next = false
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			continue
+		}
+
 		s.logger.Info("Fetching snapshot chunk", "height", snapshot.Height,
 			"format", snapshot.Format, "chunk", index, "total", chunks.Size())
 
@@ -444,16 +591,23 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 
 		case <-time.After(s.retryTimeout):
 			next = false
+			s.metrics.ChunkRequestTimeouts.Add(1)
+			if peer := s.peers.TimedOut(index); peer != "" {
+				s.logger.Debug("Chunk request timed out", "chunk", index, "peer", peer)
+			}
+			s.metrics.ChunkFetchers.Set(float64(s.fetchLimit.Decrease()))
 
 		case <-ctx.Done():
+			s.fetchLimit.Release()
 			return
 		}
+		s.fetchLimit.Release()
 	}
 }
 
-// requestChunk requests a chunk from a peer.
+// requestChunk requests a chunk from the best peer for the snapshot.
 func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
-	peer := s.snapshots.GetPeer(snapshot)
+	peer := s.peers.Pick(s.snapshots.GetPeers(snapshot))
 	if peer == nil {
 		s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
 			"format", snapshot.Format, "hash", log.NewLazySprintf("%X", snapshot.Hash))
@@ -461,6 +615,7 @@ func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 	}
 	s.logger.Debug("Requesting snapshot chunk", "height", snapshot.Height,
 		"format", snapshot.Format, "chunk", chunk, "peer", peer.ID())
+	s.peers.Requested(chunk, peer.ID())
 	_ = peer.Send(p2p.Envelope{
 		ChannelID: ChunkChannel,
 		Message: &ssproto.ChunkRequest{
@@ -1,7 +1,9 @@
 package statesync
 
 import (
+	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
@@ -368,6 +371,123 @@ func TestSyncer_SyncAny_abciError(t *testing.T) {
 	connSnapshot.AssertExpectations(t)
 }
 
+func TestSyncer_SyncAny_resume(t *testing.T) {
+	// The state and commit are persisted, so they must be complete.
+	valSet, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	state := sm.State{
+		ChainID: "chain",
+		Version: cmtstate.Version{
+			Consensus: cmtversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   testAppVersion,
+			},
+		},
+		LastBlockHeight: 1,
+		LastValidators:  valSet,
+		Validators:      valSet,
+		NextValidators:  valSet,
+		ConsensusParams: *test.ConsensusParams(),
+		AppHash:         []byte("app_hash"),
+	}
+	commit, err := test.MakeCommit(test.MakeBlockID(), 1, 0, valSet, privVals, "chain", cmttime.Now())
+	require.NoError(t, err)
+	s := &snapshot{Height: 1, Format: 1, Chunks: 3, Hash: []byte{1, 2, 3}}
+	chunks := []*chunk{
+		{Height: 1, Format: 1, Index: 0, Chunk: []byte{1, 1, 0}},
+		{Height: 1, Format: 1, Index: 1, Chunk: []byte{1, 1, 1}},
+		{Height: 1, Format: 1, Index: 2, Chunk: []byte{1, 1, 2}},
+	}
+	dir := t.TempDir()
+
+	setupSyncer := func() (*syncer, *proxymocks.AppConnSnapshot, *proxymocks.AppConnQuery, *mocks.StateProvider) {
+		stateProvider := &mocks.StateProvider{}
+		stateProvider.On("AppHash", mock.Anything, uint64(1)).Return(state.AppHash, nil)
+		stateProvider.On("State", mock.Anything, uint64(1)).Return(state, nil)
+		stateProvider.On("Commit", mock.Anything, uint64(1)).Return(commit, nil)
+		connSnapshot := &proxymocks.AppConnSnapshot{}
+		connQuery := &proxymocks.AppConnQuery{}
+		connSnapshot.On("OfferSnapshot", mock.Anything, &abci.OfferSnapshotRequest{
+			Snapshot: toABCI(s), AppHash: state.AppHash,
+		}).Once().Return(&abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT}, nil)
+
+		cfg := config.DefaultStateSyncConfig()
+		syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+		progress, err := newProgressStore(dir)
+		require.NoError(t, err)
+		syncer.progress = progress
+		return syncer, connSnapshot, connQuery, stateProvider
+	}
+
+	// The app fails while applying chunk 1, after all the chunks have been fetched.
+	syncer, connSnapshot, _, _ := setupSyncer()
+	peer := simplePeer("a")
+	peer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		e := args[0].(p2p.Envelope)
+		if msg, ok := e.Message.(*ssproto.ChunkRequest); ok {
+			_, err := syncer.AddChunk(chunks[msg.Index])
+			require.NoError(t, err)
+		}
+	}).Return(nil)
+	_, err = syncer.AddSnapshot(peer, s)
+	require.NoError(t, err)
+
+	errBoom := errors.New("boom")
+	connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.ApplySnapshotChunkRequest{
+		Index: 0, Chunk: []byte{1, 1, 0},
+	}).Once().Return(&abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil)
+	connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.ApplySnapshotChunkRequest{
+		Index: 1, Chunk: []byte{1, 1, 1},
+	}).Once().Run(func(_ mock.Arguments) {
+		// Wait for all the chunks to be fetched.
+		require.Eventually(t, func() bool {
+			return syncer.chunks.Has(2)
+		}, time.Second, 10*time.Millisecond)
+	}).Return(nil, errBoom)
+
+	_, _, err = syncer.SyncAny(0, maxDiscoveryTime, func() {})
+	require.ErrorIs(t, err, errBoom)
+	connSnapshot.AssertExpectations(t)
+
+	progress, err := syncer.progress.Load()
+	require.NoError(t, err)
+	require.NotNil(t, progress)
+	assert.Equal(t, []uint32{0}, progress.AppliedChunks)
+	assert.Equal(t, state.AppHash, progress.TrustedAppHash)
+	assert.NotEmpty(t, progress.TrustedState)
+	assert.NotEmpty(t, progress.TrustedCommit)
+
+	// After a restart, the snapshot is offered again and the restoration
+	// resumes from chunk 1, with the chunks fetched before the restart and
+	// without verifying the app hash, the state and the commit again.
+	syncer, connSnapshot, connQuery, stateProvider := setupSyncer()
+	connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.ApplySnapshotChunkRequest{
+		Index: 1, Chunk: []byte{1, 1, 1},
+	}).Once().Return(&abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil)
+	connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.ApplySnapshotChunkRequest{
+		Index: 2, Chunk: []byte{1, 1, 2},
+	}).Once().Return(&abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil)
+	connQuery.On("Info", mock.Anything, proxy.InfoRequest).Return(&abci.InfoResponse{
+		AppVersion:       testAppVersion,
+		LastBlockHeight:  1,
+		LastBlockAppHash: state.AppHash,
+	}, nil)
+
+	newState, lastCommit, err := syncer.SyncAny(0, maxDiscoveryTime, func() {})
+	require.NoError(t, err)
+	assert.Equal(t, state, newState)
+	assert.Equal(t, commit, lastCommit)
+	connSnapshot.AssertExpectations(t)
+	stateProvider.AssertNotCalled(t, "AppHash", mock.Anything, mock.Anything)
+	stateProvider.AssertNotCalled(t, "State", mock.Anything, mock.Anything)
+	stateProvider.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+
+	// The progress is removed once the snapshot is restored.
+	progress, err = syncer.progress.Load()
+	require.NoError(t, err)
+	assert.Nil(t, progress)
+	assert.NoDirExists(t, filepath.Join(dir, progressChunksDir))
+}
+
 func TestSyncer_offerSnapshot(t *testing.T) {
 	unknownErr := errors.New("unknown error")
 	boom := errors.New("boom")
@@ -2,9 +2,14 @@ package store
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/google/orderedcode"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/internal/keymigrate"
 )
 
 type BlockKeyLayout interface {
@@ -211,3 +216,58 @@ func (*v2Layout) CalcSeenCommitKey(height int64) []byte {
 }
 
 var _ BlockKeyLayout = (*v2Layout)(nil)
+
+// MigrateKeyLayoutToV2 rewrites the keys of a block store database from the
+// v1 to the v2 layout. It returns the number of keys rewritten, and reports
+// the progress to progress if it is not nil. The database must not be in use while
+// it is migrated; an interrupted migration is resumed by calling
+// MigrateKeyLayoutToV2 again.
+func MigrateKeyLayoutToV2(db dbm.DB, progress func(migrated int64)) (int64, error) {
+	return keymigrate.MigrateV1ToV2(db, v1KeyToV2, keymigrate.WithProgress(progress))
+}
+
+// v1KeyToV2 translates a key of the v1 layout to the v2 layout. Keys which
+// do not depend on the layout are left untouched.
+func v1KeyToV2(key []byte) ([]byte, bool, error) {
+	var (
+		v2    v2Layout
+		skey  = string(key)
+		parse = func(prefix string) (int64, error) {
+			return strconv.ParseInt(strings.TrimPrefix(skey, prefix), 10, 64)
+		}
+	)
+
+	switch {
+	case strings.HasPrefix(skey, "H:"):
+		height, err := parse("H:")
+		return v2.CalcBlockMetaKey(height), err == nil, err
+	case strings.HasPrefix(skey, "C:"):
+		height, err := parse("C:")
+		return v2.CalcBlockCommitKey(height), err == nil, err
+	case strings.HasPrefix(skey, "SC:"):
+		height, err := parse("SC:")
+		return v2.CalcSeenCommitKey(height), err == nil, err
+	case strings.HasPrefix(skey, "EC:"):
+		height, err := parse("EC:")
+		return v2.CalcExtCommitKey(height), err == nil, err
+	case strings.HasPrefix(skey, "P:"):
+		heightStr, indexStr, found := strings.Cut(strings.TrimPrefix(skey, "P:"), ":")
+		if !found {
+			return nil, false, fmt.Errorf("invalid block part key %q", skey)
+		}
+		height, err := strconv.ParseInt(heightStr, 10, 64)
+		if err != nil {
+			return nil, false, err
+		}
+		index, err := strconv.Atoi(indexStr)
+		if err != nil {
+			return nil, false, err
+		}
+		return v2.CalcBlockPartKey(height, index), true, nil
+	case strings.HasPrefix(skey, "BH:"):
+		hash, err := hex.DecodeString(strings.TrimPrefix(skey, "BH:"))
+		return v2.CalcBlockHashKey(hash), err == nil, err
+	default:
+		return nil, false, nil
+	}
+}
@@ -61,6 +61,10 @@ type BlockStore struct {
 	height int64
 
 	dbKeyLayout BlockKeyLayout
+	// the key layout requested for an empty store, and whether the store is
+	// only read from, in which case the layout is never written
+	dbKeyLayoutVersion string
+	readOnly           bool
 
 	blocksDeleted      int64
 	compact            bool
@@ -87,9 +91,16 @@ func WithMetrics(metrics *Metrics) BlockStoreOption {
 	return func(bs *BlockStore) { bs.metrics = metrics }
 }
 
-// WithDBKeyLayout the metrics.
+// WithDBKeyLayout sets the key layout of an empty store. A non-empty store
+// keeps the layout recorded in its database.
 func WithDBKeyLayout(dbKeyLayout string) BlockStoreOption {
-	return func(bs *BlockStore) { setDBLayout(bs, dbKeyLayout) }
+	return func(bs *BlockStore) { bs.dbKeyLayoutVersion = dbKeyLayout }
+}
+
+// WithReadOnly opens the store for reading only, e.g. to read the blocks of
+// another node: the key layout recorded in its database is not written.
+func WithReadOnly() BlockStoreOption {
+	return func(bs *BlockStore) { bs.readOnly = true }
 }
 
 func setDBLayout(bStore *BlockStore, dbKeyLayoutVersion string) {
@@ -114,6 +125,9 @@ func setDBLayout(bStore *BlockStore, dbKeyLayoutVersion string) {
 	default:
 		panic("unknown key layout version")
 	}
+	if bStore.readOnly {
+		return
+	}
 	if err := bStore.db.SetSync([]byte("version"), []byte(dbKeyLayoutVersion)); err != nil {
 		panic(err)
 	}
@@ -138,9 +152,7 @@ func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
 		option(bStore)
 	}
 
-	if bStore.dbKeyLayout == nil {
-		setDBLayout(bStore, "v1")
-	}
+	setDBLayout(bStore, bStore.dbKeyLayoutVersion)
 
 	addTimeSample(bStore.metrics.BlockStoreAccessDurationSeconds.With("method", "new_block_store"), start)()
 	return bStore
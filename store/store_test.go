@@ -498,6 +498,57 @@ func TestLoadBaseMeta(t *testing.T) {
 	require.EqualValues(t, 9, bs.Height())
 }
 
+func TestMigrateKeyLayoutToV2(t *testing.T) {
+	config := test.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	state, err := sm.MakeGenesisStateFromFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	bs := NewBlockStore(db, WithDBKeyLayout("v1"))
+	blocks := make([]*types.Block, 0, 5)
+	for h := int64(1); h <= 5; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+		blocks = append(blocks, block)
+	}
+
+	migrated, err := MigrateKeyLayoutToV2(db, nil)
+	require.NoError(t, err)
+	require.Positive(t, migrated)
+
+	// Nothing is left in the v1 layout.
+	var v1 v1LegacyLayout
+	has, err := db.Has(v1.CalcBlockMetaKey(3))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	bs = NewBlockStore(db)
+	require.Equal(t, "v2", bs.GetVersion())
+	require.EqualValues(t, 1, bs.Base())
+	require.EqualValues(t, 5, bs.Height())
+	for _, block := range blocks {
+		loaded, _ := bs.LoadBlock(block.Height)
+		require.NotNil(t, loaded)
+		require.Equal(t, block.Hash(), loaded.Hash())
+
+		loaded, _ = bs.LoadBlockByHash(block.Hash())
+		require.NotNil(t, loaded)
+		require.Equal(t, block.Height, loaded.Height)
+
+		require.NotNil(t, bs.LoadSeenCommit(block.Height))
+		require.NotNil(t, bs.LoadBlockExtendedCommit(block.Height))
+	}
+	require.NotNil(t, bs.LoadBlockCommit(4))
+
+	// Migrating again does nothing.
+	migrated, err = MigrateKeyLayoutToV2(db, nil)
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+}
+
 func TestLoadBlockPart(t *testing.T) {
 	config := test.ResetTestRoot("blockchain_reactor_test")
 
@@ -245,6 +245,9 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 	cfg.GRPC.VersionService.Enabled = true
 	cfg.GRPC.BlockService.Enabled = true
 	cfg.GRPC.BlockResultsService.Enabled = true
+	cfg.GRPC.ConsensusTimelineService.Enabled = true
+	// The timeline served by the consensus timeline service is disabled by default.
+	cfg.Consensus.TimelineHeights = 100
 
 	cfg.P2P.ExternalAddress = fmt.Sprintf("tcp://%v", node.AddressP2P(false))
 	cfg.P2P.AddrBookStrict = false
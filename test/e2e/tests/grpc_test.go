@@ -94,6 +94,25 @@ func TestGRPC_GetBlockResults(t *testing.T) {
 	})
 }
 
+// Test the GRPC Consensus Timeline service. Invoke the GetConsensusTimeline method to retrieve
+// the timeline of the height the node is currently deciding.
+func TestGRPC_GetConsensusTimeline(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		timeline, err := gRPCClient.GetConsensusTimeline(ctx, 0)
+		require.NoError(t, err)
+		require.Positive(t, timeline.Height)
+		require.False(t, timeline.StartTime.IsZero())
+	})
+}
+
 // Test the GRPC Privileged Pruning Service methods to set and get the block retain height.
 func TestGRPC_BlockRetainHeight(t *testing.T) {
 	t.Helper()
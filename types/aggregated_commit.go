@@ -0,0 +1,379 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/internal/bits"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmterrors "github.com/cometbft/cometbft/types/errors"
+)
+
+// AggregatedCommitSig is the aggregate of the precommit signatures with the
+// same BlockIDFlag. The validators whose signatures were aggregated are set in
+// Validators, by index in the validator set of the commit.
+//
+// Aggregated precommits are signed without a timestamp, so that the
+// precommits for the same block (or nil) have the same sign bytes.
+type AggregatedCommitSig struct {
+	BlockIDFlag BlockIDFlag    `json:"block_id_flag"`
+	Validators  *bits.BitArray `json:"validators"`
+	Signature   []byte         `json:"signature"`
+}
+
+// String returns a string representation of AggregatedCommitSig.
+//
+// 1. first 6 bytes of signature
+// 2. validators bit array
+// 3. block ID flag.
+func (acs AggregatedCommitSig) String() string {
+	return fmt.Sprintf("AggregatedCommitSig{%X by %v on %v}",
+		cmtbytes.Fingerprint(acs.Signature),
+		acs.Validators,
+		acs.BlockIDFlag)
+}
+
+// ValidateBasic performs basic validation.
+func (acs AggregatedCommitSig) ValidateBasic() error {
+	switch acs.BlockIDFlag {
+	case BlockIDFlagCommit:
+	case BlockIDFlagNil:
+	default:
+		return fmt.Errorf("unsupported BlockIDFlag: %v", acs.BlockIDFlag)
+	}
+
+	if acs.Validators == nil || acs.Validators.IsEmpty() {
+		return errors.New("no validators")
+	}
+	if len(acs.Signature) != bls12381.SignatureLength {
+		return fmt.Errorf("expected signature size to be %d bytes, got %d bytes",
+			bls12381.SignatureLength,
+			len(acs.Signature),
+		)
+	}
+
+	return nil
+}
+
+// ToProto converts AggregatedCommitSig to protobuf.
+func (acs *AggregatedCommitSig) ToProto() *cmtproto.AggregatedCommitSig {
+	if acs == nil {
+		return nil
+	}
+
+	return &cmtproto.AggregatedCommitSig{
+		BlockIdFlag: cmtproto.BlockIDFlag(acs.BlockIDFlag),
+		Validators:  acs.Validators.ToProto(),
+		Signature:   acs.Signature,
+	}
+}
+
+// FromProto sets a protobuf AggregatedCommitSig to the given pointer.
+// It returns an error if the AggregatedCommitSig is invalid.
+func (acs *AggregatedCommitSig) FromProto(acsp cmtproto.AggregatedCommitSig) error {
+	acs.BlockIDFlag = BlockIDFlag(acsp.BlockIdFlag)
+	acs.Validators = nil
+	if acsp.Validators != nil {
+		acs.Validators = new(bits.BitArray)
+		acs.Validators.FromProto(acsp.Validators)
+	}
+	acs.Signature = acsp.Signature
+
+	return acs.ValidateBasic()
+}
+
+// UseAggregatedCommit returns true if the commit for the given height, signed
+// by vals, is aggregated. Commits are aggregated from the height set in
+// FeatureParams.AggregatedCommitsEnableHeight, as long as all the validators
+// have BLS12-381 keys.
+func UseAggregatedCommit(params FeatureParams, height int64, vals *ValidatorSet) bool {
+	if height < 1 || !params.AggregatedCommitsEnabled(height) {
+		return false
+	}
+	if vals == nil || vals.Size() == 0 || !vals.AllKeysHaveSameType() {
+		return false
+	}
+	pubKey := vals.Validators[0].PubKey
+	return pubKey != nil && pubKey.Type() == bls12381.KeyType
+}
+
+// VerifyProofsOfPossession returns an error if a BLS12-381 validator of the
+// set has no valid proof of possession of its key. The aggregated signatures
+// are only secure if each validator proved that it owns its key: otherwise, a
+// validator could pick a key that cancels out the keys of the others and
+// forge their signatures.
+func (vals *ValidatorSet) VerifyProofsOfPossession() error {
+	for _, val := range vals.Validators {
+		if val.PubKey.Type() != bls12381.KeyType {
+			continue
+		}
+		if !bls12381.VerifyProofOfPossession(val.PubKey, val.ProofOfPossession) {
+			return fmt.Errorf("validator %v has no valid proof of possession of its key", val.Address)
+		}
+	}
+	return nil
+}
+
+// ToAggregatedCommit converts an ExtendedCommit to a Commit in which the
+// signatures for the block and the signatures for nil are each aggregated into
+// a single signature. Vote extensions are dropped.
+//
+// All the precommits must have been signed without a timestamp, with BLS12-381
+// keys.
+func (ec *ExtendedCommit) ToAggregatedCommit() (*Commit, error) {
+	commit := &Commit{
+		Height:  ec.Height,
+		Round:   ec.Round,
+		BlockID: ec.BlockID,
+	}
+
+	for _, flag := range []BlockIDFlag{BlockIDFlagCommit, BlockIDFlagNil} {
+		var (
+			validators = bits.NewBitArray(len(ec.ExtendedSignatures))
+			sigs       [][]byte
+		)
+		for idx, ecs := range ec.ExtendedSignatures {
+			if ecs.BlockIDFlag != flag {
+				continue
+			}
+			if !ecs.Timestamp.IsZero() {
+				return nil, fmt.Errorf("precommit #%d has a timestamp and cannot be aggregated", idx)
+			}
+			validators.SetIndex(idx, true)
+			sigs = append(sigs, ecs.Signature)
+		}
+		if len(sigs) == 0 {
+			continue
+		}
+
+		sig, err := bls12381.AggregateSignatures(sigs)
+		if err != nil {
+			return nil, fmt.Errorf("aggregating %v signatures: %w", flag, err)
+		}
+		commit.AggregatedSignatures = append(commit.AggregatedSignatures, AggregatedCommitSig{
+			BlockIDFlag: flag,
+			Validators:  validators,
+			Signature:   sig,
+		})
+	}
+
+	return commit, nil
+}
+
+// IsAggregated returns true if the signatures of the commit are aggregated.
+func (commit *Commit) IsAggregated() bool {
+	return commit != nil && len(commit.AggregatedSignatures) > 0
+}
+
+// CommitSigs returns a CommitSig per validator of vals, the validator set that
+// signed the commit. For an aggregated commit, the CommitSigs are rebuilt from
+// the aggregated signatures and have neither a timestamp nor a signature.
+// Otherwise, the commit signatures are returned as they are.
+// Returns an error if the size of the commit doesn't match the size of vals.
+func (commit *Commit) CommitSigs(vals *ValidatorSet) ([]CommitSig, error) {
+	if commit.Size() != vals.Size() {
+		return nil, fmt.Errorf("commit has %d signatures, but the validator set has %d validators",
+			commit.Size(), vals.Size())
+	}
+	if !commit.IsAggregated() {
+		return commit.Signatures, nil
+	}
+
+	sigs := make([]CommitSig, commit.Size())
+	for idx := range sigs {
+		sigs[idx] = NewCommitSigAbsent()
+	}
+	for _, acs := range commit.AggregatedSignatures {
+		if acs.Validators.Size() != len(sigs) {
+			return nil, fmt.Errorf("aggregated signature has %d validators, but the validator set has %d",
+				acs.Validators.Size(), len(sigs))
+		}
+		for idx := range sigs {
+			if !acs.Validators.GetIndex(idx) {
+				continue
+			}
+			sigs[idx] = CommitSig{
+				BlockIDFlag:      acs.BlockIDFlag,
+				ValidatorAddress: vals.Validators[idx].Address,
+			}
+		}
+	}
+	return sigs, nil
+}
+
+// aggregatedVoteSignBytes returns the sign bytes of the precommits whose
+// signatures were aggregated for the given BlockIDFlag.
+func (commit *Commit) aggregatedVoteSignBytes(chainID string, flag BlockIDFlag) []byte {
+	vote := &Vote{
+		Type:      PrecommitType,
+		Height:    commit.Height,
+		Round:     commit.Round,
+		BlockID:   CommitSig{BlockIDFlag: flag}.BlockID(commit.BlockID),
+		Timestamp: time.Time{},
+	}
+	return VoteSignBytes(chainID, vote.ToProto())
+}
+
+// validateBasicAggregated performs the basic validation of the aggregated
+// signatures of the commit.
+func (commit *Commit) validateBasicAggregated() error {
+	if len(commit.Signatures) != 0 {
+		return errors.New("aggregated commit cannot have individual signatures")
+	}
+
+	var (
+		size  = commit.AggregatedSignatures[0].Validators.Size()
+		seen  = make(map[BlockIDFlag]bool, len(commit.AggregatedSignatures))
+		union = bits.NewBitArray(size)
+	)
+	for i, acs := range commit.AggregatedSignatures {
+		if err := acs.ValidateBasic(); err != nil {
+			return fmt.Errorf("wrong AggregatedCommitSig #%d: %w", i, err)
+		}
+		if seen[acs.BlockIDFlag] {
+			return fmt.Errorf("wrong AggregatedCommitSig #%d: duplicate %v", i, acs.BlockIDFlag)
+		}
+		seen[acs.BlockIDFlag] = true
+		if acs.Validators.Size() != size {
+			return fmt.Errorf("wrong AggregatedCommitSig #%d: expected %d validators, got %d",
+				i, size, acs.Validators.Size())
+		}
+		if !union.And(acs.Validators).IsEmpty() {
+			return fmt.Errorf("wrong AggregatedCommitSig #%d: validators signed more than once", i)
+		}
+		union = union.Or(acs.Validators)
+	}
+	if !seen[BlockIDFlagCommit] {
+		return errors.New("no signatures for the block in aggregated commit")
+	}
+	return nil
+}
+
+// verifyAggregatedCommit verifies the aggregated signatures of a commit signed
+// by vals, and that more than votingPowerNeeded signed for the block. The
+// signatures for nil are only verified if verifyNil is true.
+//
+// CONTRACT: both commit and validator set should have passed validate basic.
+func verifyAggregatedCommit(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	verifyNil bool,
+) error {
+	var talliedVotingPower int64
+	for _, acs := range commit.AggregatedSignatures {
+		if acs.BlockIDFlag == BlockIDFlagNil && !verifyNil {
+			continue
+		}
+		if acs.Validators.Size() != vals.Size() {
+			return cmterrors.NewErrInvalidCommitSignatures(vals.Size(), acs.Validators.Size())
+		}
+
+		pubKeys := make([]crypto.PubKey, 0, acs.Validators.Size())
+		for idx, val := range vals.Validators {
+			if !acs.Validators.GetIndex(idx) {
+				continue
+			}
+			if val.PubKey == nil {
+				return fmt.Errorf("validator %v has a nil PubKey at index %d", val, idx)
+			}
+			pubKeys = append(pubKeys, val.PubKey)
+			if acs.BlockIDFlag == BlockIDFlagCommit {
+				talliedVotingPower += val.VotingPower
+			}
+		}
+
+		signBytes := commit.aggregatedVoteSignBytes(chainID, acs.BlockIDFlag)
+		if !bls12381.VerifyAggregateSignature(pubKeys, signBytes, acs.Signature) {
+			return fmt.Errorf("wrong aggregated signature for %v: %X", acs.BlockIDFlag, acs.Signature)
+		}
+	}
+
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	return nil
+}
+
+// VerifyAggregatedCommitLightTrusting verifies that trustLevel of the trusted
+// validator set signed this aggregated commit. "Trusting" means that we trust
+// the validator set to be correct.
+//
+// Unlike VerifyCommitLightTrusting, the validator set that signed the commit,
+// commitVals, is needed to verify the aggregated signature. The voting power
+// is tallied from the validators of commitVals that are also in trustedVals.
+//
+// CONTRACT: must run ValidateBasic() on commit before verifying.
+func VerifyAggregatedCommitLightTrusting(
+	chainID string,
+	trustedVals *ValidatorSet,
+	commitVals *ValidatorSet,
+	commit *Commit,
+	trustLevel cmtmath.Fraction,
+) error {
+	// sanity checks
+	if trustedVals == nil || commitVals == nil {
+		return errors.New("nil validator set")
+	}
+	if trustLevel.Denominator == 0 {
+		return errors.New("trustLevel has zero Denominator")
+	}
+	if commit == nil {
+		return errors.New("nil commit")
+	}
+	if !commit.IsAggregated() {
+		return errors.New("commit is not aggregated")
+	}
+
+	// safely calculate voting power needed.
+	totalVotingPowerMulByNumerator, overflow := safeMul(trustedVals.TotalVotingPower(), int64(trustLevel.Numerator))
+	if overflow {
+		return errors.New("int64 overflow while calculating voting power needed. please provide smaller trustLevel numerator")
+	}
+	votingPowerNeeded := totalVotingPowerMulByNumerator / int64(trustLevel.Denominator)
+
+	for _, acs := range commit.AggregatedSignatures {
+		if acs.BlockIDFlag != BlockIDFlagCommit {
+			continue
+		}
+		if acs.Validators.Size() != commitVals.Size() {
+			return cmterrors.NewErrInvalidCommitSignatures(commitVals.Size(), acs.Validators.Size())
+		}
+
+		var talliedVotingPower int64
+		pubKeys := make([]crypto.PubKey, 0, acs.Validators.Size())
+		for idx, val := range commitVals.Validators {
+			if !acs.Validators.GetIndex(idx) {
+				continue
+			}
+			if val.PubKey == nil {
+				return fmt.Errorf("validator %v has a nil PubKey at index %d", val, idx)
+			}
+			pubKeys = append(pubKeys, val.PubKey)
+			// only the validators that are also in the trusted validator set
+			// count.
+			if _, trustedVal := trustedVals.GetByAddress(val.Address); trustedVal != nil {
+				talliedVotingPower += trustedVal.VotingPower
+			}
+		}
+
+		if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+			return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+		}
+
+		signBytes := commit.aggregatedVoteSignBytes(chainID, acs.BlockIDFlag)
+		if !bls12381.VerifyAggregateSignature(pubKeys, signBytes, acs.Signature) {
+			return fmt.Errorf("wrong aggregated signature for %v: %X", acs.BlockIDFlag, acs.Signature)
+		}
+		return nil
+	}
+
+	return ErrNotEnoughVotingPowerSigned{Got: 0, Needed: votingPowerNeeded}
+}
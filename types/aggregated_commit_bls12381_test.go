@@ -0,0 +1,130 @@
+//go:build bls12381
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+)
+
+func makeBLSValidatorSet(t *testing.T, n int) (*ValidatorSet, []PrivValidator) {
+	t.Helper()
+
+	vals := make([]*Validator, n)
+	privVals := make([]PrivValidator, n)
+	for i := 0; i < n; i++ {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		privVals[i] = NewMockPVWithParams(privKey, false, false)
+		vals[i] = NewValidator(privKey.PubKey(), 10)
+	}
+	valSet := NewValidatorSet(vals)
+	sortedPrivVals := make([]PrivValidator, n)
+	for _, pv := range privVals {
+		pubKey, err := pv.GetPubKey()
+		require.NoError(t, err)
+		idx, _ := valSet.GetByAddress(pubKey.Address())
+		sortedPrivVals[idx] = pv
+	}
+	return valSet, sortedPrivVals
+}
+
+// makeAggregatableExtCommit makes an extended commit in which the last
+// validator precommitted nil and the others the block.
+func makeAggregatableExtCommit(t *testing.T, chainID string, vals *ValidatorSet, privVals []PrivValidator) *ExtendedCommit {
+	t.Helper()
+
+	const height, round = 3, 1
+	blockID := makeBlockIDRandom()
+	voteSet := NewVoteSet(chainID, height, round, PrecommitType, vals)
+	for i, pv := range privVals {
+		pubKey, err := pv.GetPubKey()
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   int32(i),
+			Height:           height,
+			Round:            round,
+			Type:             PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        time.Time{},
+		}
+		if i == len(privVals)-1 {
+			vote.BlockID = BlockID{}
+		}
+		added, err := signAddVote(pv, vote, voteSet)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	return voteSet.MakeExtendedCommit(DefaultFeatureParams())
+}
+
+func TestAggregatedCommitVerify(t *testing.T) {
+	const chainID = "test_chain_id"
+	vals, privVals := makeBLSValidatorSet(t, 4)
+	extCommit := makeAggregatableExtCommit(t, chainID, vals, privVals)
+
+	commit, err := extCommit.ToAggregatedCommit()
+	require.NoError(t, err)
+	require.NoError(t, commit.ValidateBasic())
+	require.Len(t, commit.AggregatedSignatures, 2)
+	assert.Equal(t, BlockIDFlagCommit, commit.AggregatedSignatures[0].BlockIDFlag)
+	assert.Equal(t, BlockIDFlagNil, commit.AggregatedSignatures[1].BlockIDFlag)
+
+	require.NoError(t, VerifyCommit(chainID, vals, commit.BlockID, commit.Height, commit))
+	require.NoError(t, VerifyCommitLight(chainID, vals, commit.BlockID, commit.Height, commit))
+	require.NoError(t, VerifyAggregatedCommitLightTrusting(chainID, vals, vals, commit,
+		cmtmath.Fraction{Numerator: 1, Denominator: 3}))
+
+	// wrong chain ID
+	require.Error(t, VerifyCommit("other_chain_id", vals, commit.BlockID, commit.Height, commit))
+
+	// a validator claims to have signed without having signed
+	forged := commit.Clone()
+	forged.AggregatedSignatures[0].Validators.SetIndex(3, true)
+	forged.AggregatedSignatures[1].Validators.SetIndex(3, false)
+	forged.AggregatedSignatures = forged.AggregatedSignatures[:1]
+	require.Error(t, VerifyCommit(chainID, vals, forged.BlockID, forged.Height, forged))
+
+	// the signatures for nil are verified by VerifyCommit only
+	forged = commit.Clone()
+	forged.AggregatedSignatures[1].Signature = commit.AggregatedSignatures[0].Signature
+	require.Error(t, VerifyCommit(chainID, vals, forged.BlockID, forged.Height, forged))
+	require.NoError(t, VerifyCommitLight(chainID, vals, forged.BlockID, forged.Height, forged))
+
+	// not enough voting power for the block
+	notEnough := commit.Clone()
+	notEnough.AggregatedSignatures[0].Validators.SetIndex(2, false)
+	err = VerifyCommit(chainID, vals, notEnough.BlockID, notEnough.Height, notEnough)
+	require.Error(t, err)
+
+	// trusting verification with a validator set that doesn't overlap enough
+	otherVals, _ := makeBLSValidatorSet(t, 4)
+	err = VerifyAggregatedCommitLightTrusting(chainID, otherVals, vals, commit,
+		cmtmath.Fraction{Numerator: 1, Denominator: 3})
+	require.True(t, IsErrNotEnoughVotingPowerSigned(err), err)
+}
+
+func TestToAggregatedCommitWithTimestamp(t *testing.T) {
+	const chainID = "test_chain_id"
+	vals, privVals := makeBLSValidatorSet(t, 2)
+	extCommit := makeAggregatableExtCommit(t, chainID, vals, privVals)
+	extCommit.ExtendedSignatures[0].Timestamp = time.Now()
+
+	_, err := extCommit.ToAggregatedCommit()
+	require.Error(t, err)
+}
+
+func TestUseAggregatedCommitBLS(t *testing.T) {
+	vals, _ := makeBLSValidatorSet(t, 2)
+	params := FeatureParams{PbtsEnableHeight: 1, AggregatedCommitsEnableHeight: 5}
+
+	assert.False(t, UseAggregatedCommit(params, 4, vals))
+	assert.True(t, UseAggregatedCommit(params, 5, vals))
+}
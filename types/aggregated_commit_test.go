@@ -0,0 +1,185 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/internal/bits"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+func makeValidatorsBitArray(size int, indices ...int) *bits.BitArray {
+	bA := bits.NewBitArray(size)
+	for _, idx := range indices {
+		bA.SetIndex(idx, true)
+	}
+	return bA
+}
+
+func makeAggregatedCommit() *Commit {
+	return &Commit{
+		Height:  3,
+		Round:   1,
+		BlockID: makeBlockIDRandom(),
+		AggregatedSignatures: []AggregatedCommitSig{
+			{
+				BlockIDFlag: BlockIDFlagCommit,
+				Validators:  makeValidatorsBitArray(4, 0, 1, 2),
+				Signature:   cmtrand.Bytes(bls12381.SignatureLength),
+			},
+			{
+				BlockIDFlag: BlockIDFlagNil,
+				Validators:  makeValidatorsBitArray(4, 3),
+				Signature:   cmtrand.Bytes(bls12381.SignatureLength),
+			},
+		},
+	}
+}
+
+func TestAggregatedCommitValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name         string
+		malleate     func(*Commit)
+		expectErr    bool
+		errorMessage string
+	}{
+		{"valid", func(*Commit) {}, false, ""},
+		{"only for the block", func(c *Commit) {
+			c.AggregatedSignatures = c.AggregatedSignatures[:1]
+		}, false, ""},
+		{"only for nil", func(c *Commit) {
+			c.AggregatedSignatures = c.AggregatedSignatures[1:]
+		}, true, "no signatures for the block"},
+		{"absent flag", func(c *Commit) {
+			c.AggregatedSignatures[1].BlockIDFlag = BlockIDFlagAbsent
+		}, true, "unsupported BlockIDFlag"},
+		{"duplicate flag", func(c *Commit) {
+			c.AggregatedSignatures[1].BlockIDFlag = BlockIDFlagCommit
+		}, true, "duplicate"},
+		{"no validators", func(c *Commit) {
+			c.AggregatedSignatures[1].Validators = bits.NewBitArray(4)
+		}, true, "no validators"},
+		{"different sizes", func(c *Commit) {
+			c.AggregatedSignatures[1].Validators = makeValidatorsBitArray(5, 3)
+		}, true, "expected 4 validators, got 5"},
+		{"validator signed twice", func(c *Commit) {
+			c.AggregatedSignatures[1].Validators = makeValidatorsBitArray(4, 2, 3)
+		}, true, "validators signed more than once"},
+		{"wrong signature size", func(c *Commit) {
+			c.AggregatedSignatures[0].Signature = cmtrand.Bytes(64)
+		}, true, "expected signature size"},
+		{"individual signatures", func(c *Commit) {
+			c.Signatures = []CommitSig{NewCommitSigAbsent()}
+		}, true, "cannot have individual signatures"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commit := makeAggregatedCommit()
+			tc.malleate(commit)
+			err := commit.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAggregatedCommitProto(t *testing.T) {
+	commit := makeAggregatedCommit()
+	require.True(t, commit.IsAggregated())
+	assert.Equal(t, 4, commit.Size())
+
+	c, err := CommitFromProto(commit.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, commit.AggregatedSignatures, c.AggregatedSignatures)
+	assert.Equal(t, commit.Hash(), c.Hash())
+
+	// The aggregated signatures are part of the hash.
+	clone := commit.Clone()
+	clone.hash = nil
+	clone.AggregatedSignatures[1].Validators.SetIndex(3, false)
+	clone.AggregatedSignatures[1].Validators.SetIndex(0, true)
+	assert.True(t, commit.AggregatedSignatures[1].Validators.GetIndex(3))
+	assert.NotEqual(t, commit.Hash(), clone.Hash())
+}
+
+func TestAggregatedCommitCommitSigs(t *testing.T) {
+	vals, _ := RandValidatorSet(4, 10)
+	commit := makeAggregatedCommit()
+	commit.AggregatedSignatures[1].Validators = makeValidatorsBitArray(4, 3)
+	commit.AggregatedSignatures[0].Validators = makeValidatorsBitArray(4, 0, 2)
+
+	sigs, err := commit.CommitSigs(vals)
+	require.NoError(t, err)
+	require.Len(t, sigs, 4)
+	expectedFlags := []BlockIDFlag{BlockIDFlagCommit, BlockIDFlagAbsent, BlockIDFlagCommit, BlockIDFlagNil}
+	for i, sig := range sigs {
+		assert.Equal(t, expectedFlags[i], sig.BlockIDFlag, "#%d", i)
+		if sig.BlockIDFlag != BlockIDFlagAbsent {
+			assert.Equal(t, vals.Validators[i].Address, sig.ValidatorAddress, "#%d", i)
+		}
+		assert.True(t, sig.Timestamp.IsZero())
+		assert.Empty(t, sig.Signature)
+	}
+
+	// The signatures of a commit that is not aggregated are returned as is.
+	nonAggregated := randCommit(cmttime.Now())
+	nonAggregatedVals, _ := RandValidatorSet(nonAggregated.Size(), 10)
+	sigs, err = nonAggregated.CommitSigs(nonAggregatedVals)
+	require.NoError(t, err)
+	assert.Equal(t, nonAggregated.Signatures, sigs)
+
+	// The commit must have been signed by a validator set of the same size.
+	otherVals, _ := RandValidatorSet(5, 10)
+	_, err = commit.CommitSigs(otherVals)
+	require.Error(t, err)
+	_, err = nonAggregated.CommitSigs(vals)
+	require.Error(t, err)
+}
+
+func TestAggregatedCommitGetVote(t *testing.T) {
+	commit := makeAggregatedCommit()
+
+	// The precommits can't be recovered from the aggregated signatures.
+	_, err := commit.GetVote(0)
+	require.Error(t, err)
+	_, err = commit.VoteSignBytes("test_chain_id", 0)
+	require.Error(t, err)
+
+	nonAggregated := randCommit(cmttime.Now())
+	_, err = nonAggregated.GetVote(0)
+	require.NoError(t, err)
+	_, err = nonAggregated.GetVote(int32(nonAggregated.Size()))
+	require.Error(t, err)
+}
+
+func TestUseAggregatedCommit(t *testing.T) {
+	vals, _ := RandValidatorSet(4, 10)
+	params := FeatureParams{PbtsEnableHeight: 1, AggregatedCommitsEnableHeight: 5}
+
+	assert.False(t, UseAggregatedCommit(params, 4, vals))
+	// ed25519 signatures cannot be aggregated.
+	assert.False(t, UseAggregatedCommit(params, 5, vals))
+	assert.False(t, UseAggregatedCommit(params, 0, vals))
+}
+
+func TestVerifyAggregatedCommitNonBLS(t *testing.T) {
+	vals, _ := RandValidatorSet(4, 10)
+	commit := makeAggregatedCommit()
+
+	err := VerifyCommit("test_chain_id", vals, commit.BlockID, commit.Height, commit)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "wrong aggregated signature")
+
+	err = VerifyCommitLightTrusting("test_chain_id", vals, commit, cmtmath.Fraction{Numerator: 1, Denominator: 3})
+	require.Error(t, err)
+}
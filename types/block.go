@@ -874,6 +874,9 @@ type Commit struct {
 	Round      int32       `json:"round"`
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
+	// AggregatedSignatures replace Signatures once commits are aggregated
+	// (see FeatureParams.AggregatedCommitsEnableHeight).
+	AggregatedSignatures []AggregatedCommitSig `json:"aggregated_signatures,omitempty"`
 
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
@@ -887,15 +890,29 @@ func (commit *Commit) Clone() *Commit {
 	copy(sigs, commit.Signatures)
 	commCopy := *commit
 	commCopy.Signatures = sigs
+	if commit.AggregatedSignatures != nil {
+		aggSigs := make([]AggregatedCommitSig, len(commit.AggregatedSignatures))
+		for i, acs := range commit.AggregatedSignatures {
+			aggSigs[i] = acs
+			aggSigs[i].Validators = acs.Validators.Copy()
+		}
+		commCopy.AggregatedSignatures = aggSigs
+	}
 	return &commCopy
 }
 
 // GetVote converts the CommitSig for the given valIdx to a Vote. Commits do
 // not contain vote extensions, so the vote extension and vote extension
 // signature will not be present in the returned vote.
-// Returns nil if the precommit at valIdx is nil.
-// Panics if valIdx >= commit.Size().
-func (commit *Commit) GetVote(valIdx int32) *Vote {
+// Returns an error if valIdx is out of range or if the commit is aggregated,
+// since the precommits of an aggregated commit can't be recovered.
+func (commit *Commit) GetVote(valIdx int32) (*Vote, error) {
+	if commit.IsAggregated() {
+		return nil, errors.New("cannot get a vote from an aggregated commit")
+	}
+	if valIdx < 0 || int(valIdx) >= len(commit.Signatures) {
+		return nil, fmt.Errorf("validator index %d out of range [0, %d)", valIdx, len(commit.Signatures))
+	}
 	commitSig := commit.Signatures[valIdx]
 	return &Vote{
 		Type:             cmtproto.PrecommitType,
@@ -906,7 +923,7 @@ func (commit *Commit) GetVote(valIdx int32) *Vote {
 		ValidatorAddress: commitSig.ValidatorAddress,
 		ValidatorIndex:   valIdx,
 		Signature:        commitSig.Signature,
-	}
+	}, nil
 }
 
 // VoteSignBytes returns the bytes of the Vote corresponding to valIdx for
@@ -915,19 +932,26 @@ func (commit *Commit) GetVote(valIdx int32) *Vote {
 // The only unique part is the Timestamp - all other fields signed over are
 // otherwise the same for all validators.
 //
-// Panics if valIdx >= commit.Size().
+// Returns an error in the same cases as GetVote.
 //
 // See VoteSignBytes.
-func (commit *Commit) VoteSignBytes(chainID string, valIdx int32) []byte {
-	v := commit.GetVote(valIdx).ToProto()
-	return VoteSignBytes(chainID, v)
+func (commit *Commit) VoteSignBytes(chainID string, valIdx int32) ([]byte, error) {
+	vote, err := commit.GetVote(valIdx)
+	if err != nil {
+		return nil, err
+	}
+	return VoteSignBytes(chainID, vote.ToProto()), nil
 }
 
-// Size returns the number of signatures in the commit.
+// Size returns the number of signatures in the commit. For an aggregated
+// commit, it is the number of validators in the bit arrays.
 func (commit *Commit) Size() int {
 	if commit == nil {
 		return 0
 	}
+	if commit.IsAggregated() {
+		return commit.AggregatedSignatures[0].Validators.Size()
+	}
 	return len(commit.Signatures)
 }
 
@@ -946,6 +970,10 @@ func (commit *Commit) ValidateBasic() error {
 			return errors.New("commit cannot be for nil block")
 		}
 
+		if commit.IsAggregated() {
+			return commit.validateBasicAggregated()
+		}
+
 		if len(commit.Signatures) == 0 {
 			return errors.New("no signatures in commit")
 		}
@@ -989,6 +1017,18 @@ func (commit *Commit) Hash() cmtbytes.HexBytes {
 	if commit == nil {
 		return nil
 	}
+	if commit.hash == nil && commit.IsAggregated() {
+		bs := make([][]byte, len(commit.AggregatedSignatures))
+		for i, acs := range commit.AggregatedSignatures {
+			bz, err := acs.ToProto().Marshal()
+			if err != nil {
+				panic(err)
+			}
+
+			bs[i] = bz
+		}
+		commit.hash = merkle.HashFromByteSlices(bs)
+	}
 	if commit.hash == nil {
 		bs := make([][]byte, len(commit.Signatures))
 		for i, commitSig := range commit.Signatures {
@@ -1030,9 +1070,12 @@ func (commit *Commit) StringIndented(indent string) string {
 	if commit == nil {
 		return "nil-Commit"
 	}
-	commitSigStrings := make([]string, len(commit.Signatures))
-	for i, commitSig := range commit.Signatures {
-		commitSigStrings[i] = commitSig.String()
+	commitSigStrings := make([]string, 0, len(commit.Signatures)+len(commit.AggregatedSignatures))
+	for _, commitSig := range commit.Signatures {
+		commitSigStrings = append(commitSigStrings, commitSig.String())
+	}
+	for _, acs := range commit.AggregatedSignatures {
+		commitSigStrings = append(commitSigStrings, acs.String())
 	}
 	return fmt.Sprintf(`Commit{
 %s  Height:     %d
@@ -1061,6 +1104,13 @@ func (commit *Commit) ToProto() *cmtproto.Commit {
 		sigs[i] = *commit.Signatures[i].ToProto()
 	}
 	c.Signatures = sigs
+	if len(commit.AggregatedSignatures) > 0 {
+		aggSigs := make([]cmtproto.AggregatedCommitSig, len(commit.AggregatedSignatures))
+		for i := range commit.AggregatedSignatures {
+			aggSigs[i] = *commit.AggregatedSignatures[i].ToProto()
+		}
+		c.AggregatedSignatures = aggSigs
+	}
 
 	c.Height = commit.Height
 	c.Round = commit.Round
@@ -1090,6 +1140,15 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 		}
 	}
 	commit.Signatures = sigs
+	if len(cp.AggregatedSignatures) > 0 {
+		aggSigs := make([]AggregatedCommitSig, len(cp.AggregatedSignatures))
+		for i := range cp.AggregatedSignatures {
+			if err := aggSigs[i].FromProto(cp.AggregatedSignatures[i]); err != nil {
+				return nil, err
+			}
+		}
+		commit.AggregatedSignatures = aggSigs
+	}
 
 	commit.Height = cp.Height
 	commit.Round = cp.Round
@@ -1156,7 +1215,10 @@ func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
 		if cs.BlockIDFlag == BlockIDFlagAbsent {
 			continue // OK, some precommits can be missing.
 		}
-		vote := commit.GetVote(int32(idx))
+		vote, err := commit.GetVote(int32(idx))
+		if err != nil {
+			panic(fmt.Errorf("failed to reconstruct vote from commit: %w", err))
+		}
 		if err := vote.ValidateBasic(); err != nil {
 			panic(fmt.Errorf("failed to validate vote reconstructed from commit: %w", err))
 		}
@@ -264,7 +264,13 @@ func (l *LightClientAttackEvidence) GetByzantineValidators(commonVals *Validator
 	// First check if the header is invalid. This means that it is a lunatic attack and therefore we take the
 	// validators who are in the commonVals and voted for the lunatic header
 	if l.ConflictingHeaderIsInvalid(trusted.Header) {
-		for _, commitSig := range l.ConflictingBlock.Commit.Signatures {
+		conflictingSigs, err := l.ConflictingBlock.Commit.CommitSigs(l.ConflictingBlock.ValidatorSet)
+		if err != nil {
+			// the commit was not signed by the conflicting validator set, so
+			// no validator can be held responsible for it
+			return nil
+		}
+		for _, commitSig := range conflictingSigs {
 			if commitSig.BlockIDFlag != BlockIDFlagCommit {
 				continue
 			}
@@ -283,13 +289,21 @@ func (l *LightClientAttackEvidence) GetByzantineValidators(commonVals *Validator
 		// from the conflicting light block validator set that voted in both headers.
 		// Validator hashes are the same therefore the indexing order of validators are the same and thus we
 		// only need a single loop to find the validators that voted twice.
-		for i := 0; i < len(l.ConflictingBlock.Commit.Signatures); i++ {
-			sigA := l.ConflictingBlock.Commit.Signatures[i]
+		conflictingSigs, err := l.ConflictingBlock.Commit.CommitSigs(l.ConflictingBlock.ValidatorSet)
+		if err != nil {
+			return nil
+		}
+		trustedSigs, err := trusted.Commit.CommitSigs(l.ConflictingBlock.ValidatorSet)
+		if err != nil {
+			return nil
+		}
+		for i := 0; i < len(conflictingSigs); i++ {
+			sigA := conflictingSigs[i]
 			if sigA.BlockIDFlag != BlockIDFlagCommit {
 				continue
 			}
 
-			sigB := trusted.Commit.Signatures[i]
+			sigB := trustedSigs[i]
 			if sigB.BlockIDFlag != BlockIDFlagCommit {
 				continue
 			}
@@ -33,6 +33,10 @@ type GenesisValidator struct {
 	PubKey  crypto.PubKey `json:"pub_key"`
 	Power   int64         `json:"power"`
 	Name    string        `json:"name"`
+
+	// ProofOfPossession of the key of a BLS12-381 validator, required if
+	// aggregated commits are enabled (see Validator.ProofOfPossession).
+	ProofOfPossession []byte `json:"proof_of_possession,omitempty"`
 }
 
 // GenesisDoc defines the initial conditions for a CometBFT blockchain, in particular its validator set.
@@ -104,7 +104,7 @@ func TestBasicGenesisDoc(t *testing.T) {
 	// create a base gendoc from struct
 	baseGenDoc := &GenesisDoc{
 		ChainID:    "abc",
-		Validators: []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators: []GenesisValidator{{Address: pubkey.Address(), PubKey: pubkey, Power: 10, Name: "myval"}},
 	}
 	genDocBytes, err = cmtjson.Marshal(baseGenDoc)
 	require.NoError(t, err, "error marshaling genDoc")
@@ -181,7 +181,7 @@ func randomGenesisDoc() *GenesisDoc {
 		GenesisTime:     cmttime.Now(),
 		ChainID:         "abc",
 		InitialHeight:   1000,
-		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators:      []GenesisValidator{{Address: pubkey.Address(), PubKey: pubkey, Power: 10, Name: "myval"}},
 		ConsensusParams: DefaultConsensusParams(),
 		AppHash:         []byte{1, 2, 3},
 	}
@@ -87,8 +87,9 @@ type VersionParams struct {
 // A value of 0 means the feature is disabled. A value > 0 denotes
 // the height at which the feature will be (or has been) enabled.
 type FeatureParams struct {
-	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
-	PbtsEnableHeight           int64 `json:"pbts_enable_height"`
+	VoteExtensionsEnableHeight    int64 `json:"vote_extensions_enable_height"`
+	PbtsEnableHeight              int64 `json:"pbts_enable_height"`
+	AggregatedCommitsEnableHeight int64 `json:"aggregated_commits_enable_height"`
 }
 
 // VoteExtensionsEnabled returns true if vote extensions are enabled at height h
@@ -106,6 +107,14 @@ func (p FeatureParams) PbtsEnabled(h int64) bool {
 	return featureEnabled(enabledHeight, h, "PBTS")
 }
 
+// AggregatedCommitsEnabled returns true if the commits of BLS12-381 validator
+// sets are aggregated at height h and false otherwise.
+func (p FeatureParams) AggregatedCommitsEnabled(h int64) bool {
+	enabledHeight := p.AggregatedCommitsEnableHeight
+
+	return featureEnabled(enabledHeight, h, "Aggregated Commits")
+}
+
 // featureEnabled returns true if `enabledHeight` points to a height that is smaller than `currentHeight“.
 func featureEnabled(enableHeight int64, currentHeight int64, f string) bool {
 	if currentHeight < 1 {
@@ -194,8 +203,9 @@ func DefaultVersionParams() VersionParams {
 // Disabled by default.
 func DefaultFeatureParams() FeatureParams {
 	return FeatureParams{
-		VoteExtensionsEnableHeight: 0,
-		PbtsEnableHeight:           0,
+		VoteExtensionsEnableHeight:    0,
+		PbtsEnableHeight:              0,
+		AggregatedCommitsEnableHeight: 0,
 	}
 }
 
@@ -273,6 +283,29 @@ func (params ConsensusParams) ValidateBasic() error {
 		return fmt.Errorf("Feature.PbtsEnableHeight cannot be negative. Got: %d", params.Feature.PbtsEnableHeight)
 	}
 
+	if params.Feature.AggregatedCommitsEnableHeight < 0 {
+		return fmt.Errorf("Feature.AggregatedCommitsEnableHeight cannot be negative. Got: %d",
+			params.Feature.AggregatedCommitsEnableHeight)
+	}
+
+	// Precommits are signed without a timestamp once commits are aggregated,
+	// so BFT Time cannot be used anymore.
+	if params.Feature.AggregatedCommitsEnableHeight > 0 &&
+		(params.Feature.PbtsEnableHeight <= 0 ||
+			params.Feature.PbtsEnableHeight > params.Feature.AggregatedCommitsEnableHeight) {
+		return fmt.Errorf("Feature.AggregatedCommitsEnableHeight requires PBTS to be enabled at or before height %d. Got PbtsEnableHeight: %d",
+			params.Feature.AggregatedCommitsEnableHeight, params.Feature.PbtsEnableHeight)
+	}
+
+	// The precommits can't be recovered from an aggregated commit, so the
+	// last commit is reconstructed from the extended commit on restart.
+	if params.Feature.AggregatedCommitsEnableHeight > 0 &&
+		(params.Feature.VoteExtensionsEnableHeight <= 0 ||
+			params.Feature.VoteExtensionsEnableHeight > params.Feature.AggregatedCommitsEnableHeight) {
+		return fmt.Errorf("Feature.AggregatedCommitsEnableHeight requires vote extensions to be enabled at or before height %d. Got VoteExtensionsEnableHeight: %d",
+			params.Feature.AggregatedCommitsEnableHeight, params.Feature.VoteExtensionsEnableHeight)
+	}
+
 	// Synchrony params are only relevant when PBTS is enabled
 	if params.Feature.PbtsEnableHeight > 0 {
 		if params.Synchrony.MessageDelay <= 0 {
@@ -342,6 +375,14 @@ func validateUpdateFeatures(params FeatureParams, updated cmtproto.FeatureParams
 			return err
 		}
 	}
+
+	if updated.AggregatedCommitsEnableHeight != nil {
+		err := validateUpdateFeatureEnableHeight(params.AggregatedCommitsEnableHeight,
+			updated.AggregatedCommitsEnableHeight.Value, h, "Aggregated Commits")
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -448,6 +489,10 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 		if params2.Feature.PbtsEnableHeight != nil {
 			res.Feature.PbtsEnableHeight = params2.Feature.GetPbtsEnableHeight().Value
 		}
+
+		if params2.Feature.AggregatedCommitsEnableHeight != nil {
+			res.Feature.AggregatedCommitsEnableHeight = params2.Feature.GetAggregatedCommitsEnableHeight().Value
+		}
 	}
 	if params2.Synchrony != nil {
 		if params2.Synchrony.MessageDelay != nil {
@@ -479,8 +524,9 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 			App: params.Version.App,
 		},
 		Feature: &cmtproto.FeatureParams{
-			PbtsEnableHeight:           &gogo.Int64Value{Value: params.Feature.PbtsEnableHeight},
-			VoteExtensionsEnableHeight: &gogo.Int64Value{Value: params.Feature.VoteExtensionsEnableHeight},
+			PbtsEnableHeight:              &gogo.Int64Value{Value: params.Feature.PbtsEnableHeight},
+			VoteExtensionsEnableHeight:    &gogo.Int64Value{Value: params.Feature.VoteExtensionsEnableHeight},
+			AggregatedCommitsEnableHeight: &gogo.Int64Value{Value: params.Feature.AggregatedCommitsEnableHeight},
 		},
 		Synchrony: &cmtproto.SynchronyParams{
 			MessageDelay: &params.Synchrony.MessageDelay,
@@ -507,8 +553,9 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 			App: pbParams.Version.App,
 		},
 		Feature: FeatureParams{
-			VoteExtensionsEnableHeight: pbParams.GetFeature().GetVoteExtensionsEnableHeight().GetValue(),
-			PbtsEnableHeight:           pbParams.GetFeature().GetPbtsEnableHeight().GetValue(),
+			VoteExtensionsEnableHeight:    pbParams.GetFeature().GetVoteExtensionsEnableHeight().GetValue(),
+			PbtsEnableHeight:              pbParams.GetFeature().GetPbtsEnableHeight().GetValue(),
+			AggregatedCommitsEnableHeight: pbParams.GetFeature().GetAggregatedCommitsEnableHeight().GetValue(),
 		},
 	}
 	if pbParams.GetSynchrony().GetMessageDelay() != nil {
@@ -27,6 +27,7 @@ type makeParamsArgs struct {
 	pubkeyTypes         []string
 	voteExtensionHeight int64
 	pbtsHeight          int64
+	aggregatedHeight    int64
 	precision           time.Duration
 	messageDelay        time.Duration
 }
@@ -50,8 +51,9 @@ func makeParams(args makeParamsArgs) ConsensusParams {
 			MessageDelay: args.messageDelay,
 		},
 		Feature: FeatureParams{
-			VoteExtensionsEnableHeight: args.voteExtensionHeight,
-			PbtsEnableHeight:           args.pbtsHeight,
+			VoteExtensionsEnableHeight:    args.voteExtensionHeight,
+			PbtsEnableHeight:              args.pbtsHeight,
+			AggregatedCommitsEnableHeight: args.aggregatedHeight,
 		},
 	}
 }
@@ -353,6 +355,84 @@ func TestConsensusParamsValidation(t *testing.T) {
 				}),
 			valid: true,
 		},
+		// aggregated commits enable height
+		{
+			name: "aggregated commits height -1",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:       1,
+					evidenceAge:      2,
+					precision:        time.Nanosecond,
+					messageDelay:     time.Nanosecond,
+					pbtsHeight:       1,
+					aggregatedHeight: -1,
+				}),
+			valid: false,
+		},
+		{
+			name: "aggregated commits without pbts",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:       1,
+					evidenceAge:      2,
+					aggregatedHeight: 1,
+				}),
+			valid: false,
+		},
+		{
+			name: "aggregated commits before pbts",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:       1,
+					evidenceAge:      2,
+					precision:        time.Nanosecond,
+					messageDelay:     time.Nanosecond,
+					pbtsHeight:       100,
+					aggregatedHeight: 99,
+				}),
+			valid: false,
+		},
+		{
+			name: "aggregated commits without vote extensions",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:       1,
+					evidenceAge:      2,
+					precision:        time.Nanosecond,
+					messageDelay:     time.Nanosecond,
+					pbtsHeight:       100,
+					aggregatedHeight: 100,
+				}),
+			valid: false,
+		},
+		{
+			name: "aggregated commits before vote extensions",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:          1,
+					evidenceAge:         2,
+					precision:           time.Nanosecond,
+					messageDelay:        time.Nanosecond,
+					voteExtensionHeight: 101,
+					pbtsHeight:          100,
+					aggregatedHeight:    100,
+				}),
+			valid: false,
+		},
+		{
+			name: "aggregated commits with pbts and vote extensions",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:          1,
+					evidenceAge:         2,
+					precision:           time.Nanosecond,
+					messageDelay:        time.Nanosecond,
+					voteExtensionHeight: 100,
+					pbtsHeight:          100,
+					aggregatedHeight:    100,
+				}),
+			valid: true,
+		},
 	}
 	for _, tc := range testCases {
 		if tc.params.Validator.PubKeyTypes == nil {
@@ -656,6 +736,28 @@ func TestConsensusParamsUpdate_EnableHeight(t *testing.T) {
 		})
 	}
 
+	// Test Aggregated Commits enabling
+	for _, tc := range testCases {
+		t.Run(tc.name+" Aggregated Commits", func(*testing.T) {
+			initialParams := makeParams(makeParamsArgs{
+				aggregatedHeight: tc.from,
+			})
+			update := &cmtproto.ConsensusParams{Feature: &cmtproto.FeatureParams{}}
+			if tc.to == nilTest {
+				update.Feature.AggregatedCommitsEnableHeight = nil
+			} else {
+				update.Feature = &cmtproto.FeatureParams{
+					AggregatedCommitsEnableHeight: &types.Int64Value{Value: tc.to},
+				}
+			}
+			if tc.expectedErr {
+				require.Error(t, initialParams.ValidateUpdate(update, tc.current))
+			} else {
+				require.NoError(t, initialParams.ValidateUpdate(update, tc.current))
+			}
+		})
+	}
+
 	// Test PBTS and VE enabling
 	for _, tc := range testCases {
 		t.Run(tc.name+"VE PBTS", func(*testing.T) {
@@ -703,6 +805,7 @@ func consensusParamsForTestProto() []ConsensusParams {
 		makeParams(makeParamsArgs{voteExtensionHeight: 100}),
 		makeParams(makeParamsArgs{pbtsHeight: 100}),
 		makeParams(makeParamsArgs{voteExtensionHeight: 100, pbtsHeight: 42}),
+		makeParams(makeParamsArgs{pbtsHeight: 42, aggregatedHeight: 100}),
 		makeParams(makeParamsArgs{pbtsHeight: 100}),
 	}
 }
@@ -62,6 +62,7 @@ func (tm2pb) ValidatorUpdates(vals *ValidatorSet) []abci.ValidatorUpdate {
 	validators := make([]abci.ValidatorUpdate, vals.Size())
 	for i, val := range vals.Validators {
 		validators[i] = abci.NewValidatorUpdate(val.PubKey, val.VotingPower)
+		validators[i].ProofOfPossession = val.ProofOfPossession
 	}
 	return validators
 }
@@ -82,6 +83,7 @@ func (pb2tm) ValidatorUpdates(vals []abci.ValidatorUpdate) ([]*Validator, error)
 			return nil, err
 		}
 		cmtVals[i] = NewValidator(pubKey, v.Power)
+		cmtVals[i].ProofOfPossession = v.ProofOfPossession
 	}
 	return cmtVals, nil
 }
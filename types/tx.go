@@ -84,6 +84,31 @@ func (txs Txs) Proof(i int) TxProof {
 	}
 }
 
+// MultiProof returns a proof of the presence of the transactions at the given
+// indices, which must be in increasing order.
+func (txs Txs) MultiProof(indices []int) (TxMultiProof, error) {
+	hl := txs.hashList()
+	idxs := make([]int64, len(indices))
+	data := make(Txs, len(indices))
+	for i, index := range indices {
+		if index < 0 || index >= len(txs) {
+			return TxMultiProof{}, fmt.Errorf("index %d out of range [0, %d)", index, len(txs))
+		}
+		idxs[i] = int64(index)
+		data[i] = txs[index]
+	}
+	root, proof, err := merkle.MultiProofFromByteSlices(hl, idxs)
+	if err != nil {
+		return TxMultiProof{}, err
+	}
+
+	return TxMultiProof{
+		RootHash: root,
+		Data:     data,
+		Proof:    *proof,
+	}, nil
+}
+
 func (txs Txs) hashList() [][]byte {
 	hl := make([][]byte, len(txs))
 	for i := 0; i < len(txs); i++ {
@@ -192,6 +217,65 @@ func TxProofFromProto(pb cmtproto.TxProof) (TxProof, error) {
 	return pbtp, nil
 }
 
+// TxMultiProof represents a Merkle proof of the presence of several transactions in the Merkle tree.
+//
+// It's not served by the RPC yet: TxSearch still returns a TxProof with each
+// transaction when prove is set, which the light client RPC verifies.
+type TxMultiProof struct {
+	RootHash cmtbytes.HexBytes `json:"root_hash"`
+	Data     Txs               `json:"data"`
+	Proof    merkle.MultiProof `json:"proof"`
+}
+
+// Leaves returns the hash(tx) of every transaction, which are the leaves in
+// the merkle tree which this proof refers to.
+func (tmp TxMultiProof) Leaves() [][]byte {
+	return tmp.Data.hashList()
+}
+
+// Validate verifies the proof. It returns nil if the RootHash matches the dataHash argument,
+// and if the proof is internally consistent. Otherwise, it returns a sensible error.
+func (tmp TxMultiProof) Validate(dataHash []byte) error {
+	if !bytes.Equal(dataHash, tmp.RootHash) {
+		return errors.New("proof matches different data hash")
+	}
+	if len(tmp.Data) != len(tmp.Proof.Indices) {
+		return errors.New("proof does not match the number of transactions")
+	}
+	valid := tmp.Proof.Verify(tmp.RootHash, tmp.Leaves())
+	if valid != nil {
+		return errors.New("proof is not internally consistent")
+	}
+	return nil
+}
+
+func (tmp TxMultiProof) ToProto() cmtproto.TxMultiProof {
+	pbProof := tmp.Proof.ToProto()
+
+	pbtmp := cmtproto.TxMultiProof{
+		RootHash: tmp.RootHash,
+		Data:     tmp.Data.ToSliceOfBytes(),
+		Proof:    pbProof,
+	}
+
+	return pbtmp
+}
+
+func TxMultiProofFromProto(pb cmtproto.TxMultiProof) (TxMultiProof, error) {
+	pbProof, err := merkle.MultiProofFromProto(pb.Proof)
+	if err != nil {
+		return TxMultiProof{}, err
+	}
+
+	pbtmp := TxMultiProof{
+		RootHash: pb.RootHash,
+		Data:     ToTxs(pb.Data),
+		Proof:    *pbProof,
+	}
+
+	return pbtmp, nil
+}
+
 // ComputeProtoSizeForTxs wraps the transactions in cmtproto.Data{} and calculates the size.
 // https://developers.google.com/protocol-buffers/docs/encoding
 func ComputeProtoSizeForTxs(txs []Tx) int64 {
@@ -94,6 +94,47 @@ func TestValidTxProof(t *testing.T) {
 	}
 }
 
+func TestValidTxMultiProof(t *testing.T) {
+	txs := makeTxs(20, 5)
+	root := txs.Hash()
+
+	for _, indices := range [][]int{{0}, {19}, {1, 2, 3}, {0, 7, 8, 19}} {
+		proof, err := txs.MultiProof(indices)
+		require.NoError(t, err, "%v", indices)
+		assert.EqualValues(t, len(txs), proof.Proof.Total, "%v", indices)
+		assert.EqualValues(t, root, proof.RootHash, "%v", indices)
+		require.Len(t, proof.Data, len(indices))
+		for i, index := range indices {
+			assert.EqualValues(t, txs[index], proof.Data[i], "%v", indices)
+		}
+		require.NoError(t, proof.Validate(root), "%v", indices)
+		require.Error(t, proof.Validate([]byte("foobar")), "%v", indices)
+
+		// a transaction that is not part of the proof
+		bad := proof
+		bad.Data = append(Txs{}, proof.Data...)
+		bad.Data[0] = Tx("foobar")
+		require.Error(t, bad.Validate(root), "%v", indices)
+
+		// read-write must also work
+		var pb2 cmtproto.TxMultiProof
+		pbProof := proof.ToProto()
+		bin, err := pbProof.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, pb2.Unmarshal(bin))
+		p2, err := TxMultiProofFromProto(pb2)
+		require.NoError(t, err, "%v", indices)
+		require.NoError(t, p2.Validate(root), "%v", indices)
+	}
+
+	_, err := txs.MultiProof([]int{3, 2})
+	require.Error(t, err)
+	_, err = txs.MultiProof([]int{20})
+	require.Error(t, err)
+	_, err = txs.MultiProof(nil)
+	require.Error(t, err)
+}
+
 func TestTxProofUnchangable(t *testing.T) {
 	// run the other test a bunch...
 	for i := 0; i < 40; i++ {
@@ -39,6 +39,10 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// 1/8th of max int64 so this operation should never overflow
 	votingPowerNeeded := vals.TotalVotingPower() * 2 / 3
 
+	if commit.IsAggregated() {
+		return verifyAggregatedCommit(chainID, vals, commit, votingPowerNeeded, true)
+	}
+
 	// ignore all absent signatures
 	ignore := func(c CommitSig) bool { return c.BlockIDFlag == BlockIDFlagAbsent }
 
@@ -120,6 +124,12 @@ func verifyCommitLightInternal(
 	// calculate voting power needed
 	votingPowerNeeded := vals.TotalVotingPower() * 2 / 3
 
+	// the signatures for nil are never verified, even if countAllSignatures
+	// is true, like the individual signatures for nil below.
+	if commit.IsAggregated() {
+		return verifyAggregatedCommit(chainID, vals, commit, votingPowerNeeded, false)
+	}
+
 	// ignore all commit signatures that are not for the block
 	ignore := func(c CommitSig) bool { return c.BlockIDFlag != BlockIDFlagCommit }
 
@@ -215,6 +225,9 @@ func verifyCommitLightTrustingInternal(
 	if commit == nil {
 		return errors.New("nil commit")
 	}
+	if commit.IsAggregated() {
+		return errors.New("aggregated commits must be verified with VerifyAggregatedCommitLightTrusting")
+	}
 
 	// safely calculate voting power needed.
 	totalVotingPowerMulByNumerator, overflow := safeMul(vals.TotalVotingPower(), int64(trustLevel.Numerator))
@@ -321,7 +334,10 @@ func verifyCommitBatch(
 		}
 
 		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
+		voteSignBytes, err := commit.VoteSignBytes(chainID, int32(idx))
+		if err != nil {
+			return err
+		}
 
 		cacheHit := false
 		if verifiedSignatureCache != nil {
@@ -369,9 +385,13 @@ func verifyCommitBatch(
 			for i := range validSigs {
 				idx := batchSigIdxs[i]
 				sig := commit.Signatures[idx]
+				voteSignBytes, err := commit.VoteSignBytes(chainID, int32(idx))
+				if err != nil {
+					return err
+				}
 				verifiedSignatureCache.Add(string(sig.Signature), SignatureCacheValue{
 					ValidatorAddress: sig.ValidatorAddress,
-					VoteSignBytes:    commit.VoteSignBytes(chainID, int32(idx)),
+					VoteSignBytes:    voteSignBytes,
 				})
 			}
 		}
@@ -389,9 +409,13 @@ func verifyCommitBatch(
 			return fmt.Errorf("wrong signature (#%d): %X", idx, sig)
 		}
 		if verifiedSignatureCache != nil {
+			voteSignBytes, err := commit.VoteSignBytes(chainID, int32(idx))
+			if err != nil {
+				return err
+			}
 			verifiedSignatureCache.Add(string(sig.Signature), SignatureCacheValue{
 				ValidatorAddress: sig.ValidatorAddress,
-				VoteSignBytes:    commit.VoteSignBytes(chainID, int32(idx)),
+				VoteSignBytes:    voteSignBytes,
 			})
 		}
 	}
@@ -427,6 +451,7 @@ func verifyCommitSingle(
 		seenVals           = make(map[int32]int, len(commit.Signatures))
 		talliedVotingPower int64
 		voteSignBytes      []byte
+		err                error
 	)
 	for idx, commitSig := range commit.Signatures {
 		if ignoreSig(commitSig) {
@@ -463,7 +488,10 @@ func verifyCommitSingle(
 			return fmt.Errorf("validator %v has a nil PubKey at index %d", val, idx)
 		}
 
-		voteSignBytes = commit.VoteSignBytes(chainID, int32(idx))
+		voteSignBytes, err = commit.VoteSignBytes(chainID, int32(idx))
+		if err != nil {
+			return err
+		}
 
 		cacheKey, cacheHit := "", false
 		if verifiedSignatureCache != nil {
@@ -512,8 +540,8 @@ func verifyBasicValsAndCommit(vals *ValidatorSet, commit *Commit, height int64,
 		return errors.New("nil commit")
 	}
 
-	if vals.Size() != len(commit.Signatures) {
-		return cmterrors.NewErrInvalidCommitSignatures(vals.Size(), len(commit.Signatures))
+	if vals.Size() != commit.Size() {
+		return cmterrors.NewErrInvalidCommitSignatures(vals.Size(), commit.Size())
 	}
 
 	// Validate Height and BlockID.
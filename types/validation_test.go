@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	cryptomocks "github.com/cometbft/cometbft/crypto/mocks"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmttime "github.com/cometbft/cometbft/types/time"
 )
@@ -181,6 +183,42 @@ func TestValidatorSet_VerifyCommit_CheckAllSignatures(t *testing.T) {
 	}
 }
 
+func TestValidatorSet_VerifyCommit_BatchSecp256k1(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	vals := make([]*Validator, 4)
+	privVals := make([]PrivValidator, 4)
+	for i := range vals {
+		privKey := secp256k1.GenPrivKey()
+		privVals[i] = NewMockPVWithParams(privKey, false, false)
+		vals[i] = NewValidator(privKey.PubKey(), 10)
+	}
+	valSet := NewValidatorSet(vals)
+	sort.Sort(PrivValidatorsByAddress(privVals))
+
+	voteSet := NewVoteSet(chainID, h, 0, PrecommitType, valSet)
+	extCommit, err := MakeExtCommit(blockID, h, 0, voteSet, privVals, cmttime.Now(), false)
+	require.NoError(t, err)
+	commit := extCommit.ToCommit()
+	require.True(t, shouldBatchVerify(valSet, commit))
+	require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, commit))
+
+	// malleate 4th signature
+	vote := voteSet.GetByIndex(3)
+	v := vote.ToProto()
+	err = privVals[3].SignVote("CentaurusA", v, false)
+	require.NoError(t, err)
+	commit.Signatures[3].Signature = v.Signature
+
+	err = valSet.VerifyCommit(chainID, blockID, h, commit)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "wrong signature (#3)")
+}
+
 func TestValidatorSet_VerifyCommitLight_ReturnsAsSoonAsMajOfVotingPowerSignedIffNotAllSigs(t *testing.T) {
 	var (
 		chainID = "test_chain_id"
@@ -312,17 +350,17 @@ func TestValidatorSet_VerifyCommitLightTrustingWithCache_UpdatesCache(t *testing
 	cacheVal, ok := cache.Get(string(commit.Signatures[0].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[0].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 0), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 0), cacheVal.VoteSignBytes)
 
 	cacheVal, ok = cache.Get(string(commit.Signatures[1].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[1].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 1), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 1), cacheVal.VoteSignBytes)
 
 	cacheVal, ok = cache.Get(string(commit.Signatures[2].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[2].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 2), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 2), cacheVal.VoteSignBytes)
 }
 
 func TestValidatorSet_VerifyCommitLightTrustingWithCache_UsesCache(t *testing.T) {
@@ -340,15 +378,15 @@ func TestValidatorSet_VerifyCommitLightTrustingWithCache_UsesCache(t *testing.T)
 	cache := NewSignatureCache()
 	cache.Add(string(commit.Signatures[0].Signature), SignatureCacheValue{
 		ValidatorAddress: valSet.Validators[0].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 0),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 0),
 	})
 	cache.Add(string(commit.Signatures[1].Signature), SignatureCacheValue{
 		ValidatorAddress: valSet.Validators[1].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 1),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 1),
 	})
 	cache.Add(string(commit.Signatures[2].Signature), SignatureCacheValue{
 		ValidatorAddress: valSet.Validators[2].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 2),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 2),
 	})
 
 	err = valSet.VerifyCommitLightTrustingWithCache("test_chain_id", commit, cmtmath.Fraction{Numerator: 1, Denominator: 3}, cache)
@@ -374,27 +412,27 @@ func TestValidatorSet_VerifyCommitLightWithCache_UpdatesCache(t *testing.T) {
 	cacheVal, ok := cache.Get(string(commit.Signatures[0].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[0].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 0), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 0), cacheVal.VoteSignBytes)
 
 	cacheVal, ok = cache.Get(string(commit.Signatures[1].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[1].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 1), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 1), cacheVal.VoteSignBytes)
 
 	cacheVal, ok = cache.Get(string(commit.Signatures[2].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[2].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 2), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 2), cacheVal.VoteSignBytes)
 
 	cacheVal, ok = cache.Get(string(commit.Signatures[3].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[3].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 3), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 3), cacheVal.VoteSignBytes)
 
 	cacheVal, ok = cache.Get(string(commit.Signatures[4].Signature))
 	require.True(t, ok)
 	require.Equal(t, originalValset.Validators[4].PubKey.Address().Bytes(), cacheVal.ValidatorAddress)
-	require.Equal(t, commit.VoteSignBytes("test_chain_id", 4), cacheVal.VoteSignBytes)
+	require.Equal(t, commitVoteSignBytes(t, commit, "test_chain_id", 4), cacheVal.VoteSignBytes)
 }
 
 func TestValidatorSet_VerifyCommitLightWithCache_UsesCache(t *testing.T) {
@@ -409,23 +447,23 @@ func TestValidatorSet_VerifyCommitLightWithCache_UsesCache(t *testing.T) {
 	cache := NewSignatureCache()
 	cache.Add(string(commit.Signatures[0].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[0].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 0),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 0),
 	})
 	cache.Add(string(commit.Signatures[1].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[1].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 1),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 1),
 	})
 	cache.Add(string(commit.Signatures[2].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[2].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 2),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 2),
 	})
 	cache.Add(string(commit.Signatures[3].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[3].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 3),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 3),
 	})
 	cache.Add(string(commit.Signatures[4].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[4].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 4),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 4),
 	})
 
 	err = originalValset.VerifyCommitLightWithCache("test_chain_id", blockID, 1, commit, cache)
@@ -460,23 +498,23 @@ func TestValidation_verifyCommitBatch_UsesCache(t *testing.T) {
 	cache := NewSignatureCache()
 	cache.Add(string(commit.Signatures[0].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[0].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 0),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 0),
 	})
 	cache.Add(string(commit.Signatures[1].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[1].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 1),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 1),
 	})
 	cache.Add(string(commit.Signatures[2].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[2].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 2),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 2),
 	})
 	cache.Add(string(commit.Signatures[3].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[3].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 3),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 3),
 	})
 	cache.Add(string(commit.Signatures[4].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[4].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 4),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 4),
 	})
 
 	// ignore all commit signatures that are not for the block
@@ -505,23 +543,23 @@ func TestValidation_verifyCommitSingle_UsesCache(t *testing.T) {
 	cache := NewSignatureCache()
 	cache.Add(string(commit.Signatures[0].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[0].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 0),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 0),
 	})
 	cache.Add(string(commit.Signatures[1].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[1].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 1),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 1),
 	})
 	cache.Add(string(commit.Signatures[2].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[2].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 2),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 2),
 	})
 	cache.Add(string(commit.Signatures[3].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[3].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 3),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 3),
 	})
 	cache.Add(string(commit.Signatures[4].Signature), SignatureCacheValue{
 		ValidatorAddress: originalValset.Validators[4].PubKey.Address(),
-		VoteSignBytes:    commit.VoteSignBytes("test_chain_id", 4),
+		VoteSignBytes:    commitVoteSignBytes(t, commit, "test_chain_id", 4),
 	})
 
 	// ignore all commit signatures that are not for the block
@@ -565,3 +603,11 @@ func TestValidation_verifyCommitSingle_UsesCache(t *testing.T) {
 	mockValPubkeys[3].AssertNotCalled(t, "VerifySignature")
 	mockValPubkeys[4].AssertNotCalled(t, "VerifySignature")
 }
+
+func commitVoteSignBytes(t *testing.T, commit *Commit, chainID string, valIdx int32) []byte {
+	t.Helper()
+
+	signBytes, err := commit.VoteSignBytes(chainID, valIdx)
+	require.NoError(t, err)
+	return signBytes
+}
@@ -36,6 +36,11 @@ type Validator struct {
 	VotingPower int64         `json:"voting_power"`
 
 	ProposerPriority int64 `json:"proposer_priority"`
+
+	// ProofOfPossession proves that a BLS12-381 validator owns its private
+	// key, which is required to aggregate its signatures (see
+	// ValidatorSet.VerifyProofsOfPossession). It's not part of the hash.
+	ProofOfPossession []byte `json:"proof_of_possession,omitempty"`
 }
 
 // NewValidator returns a new validator with the given pubkey and voting power.
@@ -168,11 +173,12 @@ func (v *Validator) ToProto() (*cmtproto.Validator, error) {
 	}
 
 	vp := cmtproto.Validator{
-		Address:          v.Address,
-		PubKeyType:       v.PubKey.Type(),
-		PubKeyBytes:      v.PubKey.Bytes(),
-		VotingPower:      v.VotingPower,
-		ProposerPriority: v.ProposerPriority,
+		Address:           v.Address,
+		PubKeyType:        v.PubKey.Type(),
+		PubKeyBytes:       v.PubKey.Bytes(),
+		VotingPower:       v.VotingPower,
+		ProposerPriority:  v.ProposerPriority,
+		ProofOfPossession: v.ProofOfPossession,
 	}
 
 	return &vp, nil
@@ -197,6 +203,7 @@ func ValidatorFromProto(vp *cmtproto.Validator) (*Validator, error) {
 	v.PubKey = pk
 	v.VotingPower = vp.GetVotingPower()
 	v.ProposerPriority = vp.GetProposerPriority()
+	v.ProofOfPossession = vp.GetProofOfPossession()
 
 	return v, nil
 }
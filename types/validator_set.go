@@ -570,7 +570,11 @@ func (vals *ValidatorSet) applyUpdates(updates []*Validator) {
 			// Apply add or update.
 			merged[i] = updates[0]
 			if bytes.Equal(existing[0].Address, updates[0].Address) {
-				// Validator is present in both, advance existing.
+				// Validator is present in both: keep its proof of possession
+				// if the update has none, and advance existing.
+				if len(updates[0].ProofOfPossession) == 0 {
+					merged[i].ProofOfPossession = existing[0].ProofOfPossession
+				}
 				existing = existing[1:]
 			}
 			updates = updates[1:]
@@ -1109,6 +1109,30 @@ func TestValSetUpdatesBasicTestsExecute(t *testing.T) {
 	}
 }
 
+func TestValSetUpdatesKeepProofOfPossession(t *testing.T) {
+	valSet := createNewValidatorSet([]testVal{{"v2", 20}, {"v1", 10}})
+	for _, val := range valSet.Validators {
+		val.ProofOfPossession = []byte("proof of " + string(val.Address))
+	}
+
+	// v1 changes its voting power without a proof, v2 with a new proof, and
+	// v3 joins with a proof.
+	updates := createNewValidatorList([]testVal{{"v1", 11}, {"v2", 22}, {"v3", 30}})
+	updates[1].ProofOfPossession = []byte("new proof of v2")
+	updates[2].ProofOfPossession = []byte("proof of v3")
+	require.NoError(t, valSet.UpdateWithChangeSet(updates))
+
+	for name, proof := range map[string]string{
+		"v1": "proof of v1",
+		"v2": "new proof of v2",
+		"v3": "proof of v3",
+	} {
+		_, val := valSet.GetByAddress([]byte(name))
+		require.NotNil(t, val, name)
+		assert.Equal(t, []byte(proof), val.ProofOfPossession, name)
+	}
+}
+
 // Test that different permutations of an update give the same result.
 func TestValSetUpdatesOrderIndependenceTestsExecute(t *testing.T) {
 	// startVals - initial validators to create the set with
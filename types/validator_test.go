@@ -12,6 +12,8 @@ import (
 
 func TestValidatorProtoBuf(t *testing.T) {
 	val, _ := RandValidator(true, 100)
+	valWithProof := val.Copy()
+	valWithProof.ProofOfPossession = []byte("proof")
 	testCases := []struct {
 		msg      string
 		v1       *Validator
@@ -19,6 +21,7 @@ func TestValidatorProtoBuf(t *testing.T) {
 		expPass2 bool
 	}{
 		{"success validator", val, true, true},
+		{"success validator with proof of possession", valWithProof, true, true},
 		{"failure empty", &Validator{}, false, false},
 		{"failure nil", nil, false, false},
 	}